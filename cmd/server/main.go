@@ -3,8 +3,8 @@ package main
 import (
 	"context"
 	"errors"
-	"log"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -15,9 +15,12 @@ import (
 
 	"github.com/alecthomas/kong"
 	"github.com/kataras/jwt"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
 	"xelbot.com/auto-notes/server/internal/application"
 	"xelbot.com/auto-notes/server/internal/middlewares"
 	"xelbot.com/auto-notes/server/internal/services/auth"
+	"xelbot.com/auto-notes/server/internal/services/scheduler"
 	"xelbot.com/auto-notes/server/internal/services/server"
 	pbAuth "xelbot.com/auto-notes/server/rpc/auth"
 	pbServer "xelbot.com/auto-notes/server/rpc/server"
@@ -29,7 +32,7 @@ func init() {
 
 func main() {
 	var err error
-	errorLog := log.New(os.Stderr, "ERROR\t", log.Ldate|log.Ltime|log.Lshortfile)
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
 
 	var cli struct {
 		ConfigFile string `default:"config/config.toml" help:"Path to config file"`
@@ -37,37 +40,45 @@ func main() {
 
 	kong.Parse(&cli, kong.Description("gRPC server for autonotes app."))
 
-	handleError(application.LoadConfig(cli.ConfigFile), errorLog)
+	handleError(application.LoadConfig(cli.ConfigFile), logger)
 	cnf := application.GetConfig()
 
 	var logLevel = new(slog.LevelVar)
-	switch cnf.LogLevel {
-	case "debug":
-		logLevel.Set(slog.LevelDebug)
-	}
-
-	infoLog := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel}))
-	infoLog.Debug("Loading configuration", "log_level", cnf.LogLevel, "time_zone", cnf.TimeZone)
+	logger = application.NewLogger(logLevel)
+	logger.Debug("Loading configuration", "log_level", logLevel.Level(), "log_format", cnf.Log.Format, "time_zone", cnf.TimeZone)
 
 	appContainer := application.Container{}
-	appContainer.SetLogger(infoLog)
+	appContainer.SetLogger(logger)
 
-	handleError(appContainer.SetupDatabase(), errorLog)
+	handleError(appContainer.SetupTracing(), logger)
+	handleError(appContainer.SetupDatabase(), logger)
+
+	hooks := twirp.WithServerHooks(middlewares.TracingHooks())
+	localDates := twirp.WithServerInterceptors(middlewares.LocalDates(appContainer))
 
 	authImpl := auth.NewAuthService(appContainer)
-	authHandler := pbAuth.NewAuthServer(authImpl)
+	authHandler := pbAuth.NewAuthServer(authImpl, hooks)
 
 	userRepoImpl := server.NewUserRepositoryService(appContainer)
-	userRepoHandler := pbServer.NewUserRepositoryServer(userRepoImpl)
+	userRepoHandler := pbServer.NewUserRepositoryServer(userRepoImpl, hooks, localDates)
 
 	fuelRepoImpl := server.NewFuelRepositoryService(appContainer)
-	fuelRepoHandler := pbServer.NewFuelRepositoryServer(fuelRepoImpl)
+	fuelRepoHandler := pbServer.NewFuelRepositoryServer(fuelRepoImpl, hooks, localDates)
 
 	orderRepoImpl := server.NewOrderRepositoryService(appContainer)
-	orderRepoHandler := pbServer.NewOrderRepositoryServer(orderRepoImpl)
+	orderRepoHandler := pbServer.NewOrderRepositoryServer(orderRepoImpl, hooks, localDates)
 
 	carRepoImpl := server.NewCarRepositoryService(appContainer)
-	carRepoHandler := pbServer.NewCarRepositoryServer(carRepoImpl)
+	carRepoHandler := pbServer.NewCarRepositoryServer(carRepoImpl, hooks, localDates)
+
+	syncRepoImpl := server.NewSyncRepositoryService(appContainer)
+	syncRepoHandler := pbServer.NewSyncRepositoryServer(syncRepoImpl, hooks, localDates)
+
+	attachmentRepoImpl := server.NewAttachmentRepositoryService(appContainer)
+	attachmentRepoHandler := pbServer.NewAttachmentRepositoryServer(attachmentRepoImpl, hooks, localDates)
+
+	tagRepoImpl := server.NewTagRepositoryService(appContainer)
+	tagRepoHandler := pbServer.NewTagRepositoryServer(tagRepoImpl, hooks, localDates)
 
 	mux := http.NewServeMux()
 	mux.Handle(authHandler.PathPrefix(), authHandler)
@@ -75,8 +86,18 @@ func main() {
 	mux.Handle(fuelRepoHandler.PathPrefix(), middlewares.WithAuthorization(appContainer, fuelRepoHandler))
 	mux.Handle(orderRepoHandler.PathPrefix(), middlewares.WithAuthorization(appContainer, orderRepoHandler))
 	mux.Handle(carRepoHandler.PathPrefix(), middlewares.WithAuthorization(appContainer, carRepoHandler))
+	mux.Handle(syncRepoHandler.PathPrefix(), middlewares.WithAuthorization(appContainer, syncRepoHandler))
+	// the JSON requests carry the file in base64, leave room for the other fields
+	attachmentBodySize := int64(cnf.Attachments.MaxBytes())*4/3 + 1<<20
+	mux.Handle(
+		attachmentRepoHandler.PathPrefix(),
+		middlewares.WithAuthorization(appContainer, middlewares.MaxBodySize(attachmentBodySize, attachmentRepoHandler)),
+	)
+	mux.Handle(tagRepoHandler.PathPrefix(), middlewares.WithAuthorization(appContainer, tagRepoHandler))
 
 	handler := middlewares.Clacks().Middleware(mux)
+	handler = middlewares.IdempotencyKey(handler)
+	handler = middlewares.Tracing(handler)
 	handler = middlewares.RequestID(handler)
 
 	server := &http.Server{
@@ -84,13 +105,66 @@ func main() {
 		Addr:         ":" + strconv.Itoa(cnf.Port),
 		WriteTimeout: 10 * time.Second,
 		ReadTimeout:  10 * time.Second,
+		ErrorLog:     slog.NewLogLogger(logger.Handler(), slog.LevelError),
 	}
 
 	go func() {
-		infoLog.Info("Starting server", "port", cnf.Port)
+		logger.Info("Starting server", "port", cnf.Port)
 		err = server.ListenAndServe()
 		if !errors.Is(err, http.ErrServerClosed) {
-			handleError(err, errorLog)
+			handleError(err, logger)
+		}
+	}()
+
+	var grpcServer *grpc.Server
+	if cnf.GrpcPort > 0 {
+		grpcOptions := append(
+			middlewares.GrpcInterceptors(appContainer),
+			// uploads are sent in one message, leave room for the other fields
+			grpc.MaxRecvMsgSize(cnf.Attachments.MaxBytes()+1<<20),
+		)
+		grpcServer = grpc.NewServer(grpcOptions...)
+		pbAuth.RegisterAuthServer(grpcServer, authImpl)
+		pbServer.RegisterUserRepositoryServer(grpcServer, userRepoImpl)
+		pbServer.RegisterFuelRepositoryServer(grpcServer, fuelRepoImpl)
+		pbServer.RegisterOrderRepositoryServer(grpcServer, orderRepoImpl)
+		pbServer.RegisterCarRepositoryServer(grpcServer, carRepoImpl)
+		pbServer.RegisterSyncRepositoryServer(grpcServer, syncRepoImpl)
+		pbServer.RegisterSyncStreamServer(grpcServer, syncRepoImpl)
+		pbServer.RegisterAttachmentRepositoryServer(grpcServer, attachmentRepoImpl)
+		pbServer.RegisterTagRepositoryServer(grpcServer, tagRepoImpl)
+
+		listener, err := net.Listen("tcp", ":"+strconv.Itoa(cnf.GrpcPort))
+		handleError(err, logger)
+
+		go func() {
+			logger.Info("Starting gRPC server", "port", cnf.GrpcPort)
+			handleError(grpcServer.Serve(listener), logger)
+		}()
+	}
+
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		scheduler.NewRecurringExpenses(appContainer).Run(schedulerCtx, cnf.RecurringInterval)
+	}()
+
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	go func() {
+		for range reload {
+			changes, err := application.ReloadConfig(cli.ConfigFile)
+			if err != nil {
+				logger.Error("Reload configuration failed", "err", err.Error())
+				continue
+			}
+
+			logLevel.Set(application.GetConfig().Log.SlogLevel())
+			appContainer.ReloadDatabasePool()
+
+			logger.Info("Configuration reloaded", "changes", changes)
 		}
 	}()
 
@@ -99,23 +173,31 @@ func main() {
 
 	<-exit
 
-	infoLog.Info("Shutting down...")
-	err = server.Shutdown(context.Background())
-	if err != nil {
-		errorLog.Fatal(err)
+	logger.Info("Shutting down...")
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+		logger.Info("gRPC server stopped")
 	}
-	infoLog.Info("Server stopped")
+
+	err = server.Shutdown(context.Background())
+	handleError(err, logger)
+	logger.Info("Server stopped")
+
+	stopScheduler()
+	<-schedulerDone
+	logger.Info("Scheduler stopped")
 
 	err = appContainer.Stop()
-	if err != nil {
-		errorLog.Fatal(err)
-	}
-	infoLog.Info("Application stopped")
+	handleError(err, logger)
+	logger.Info("Application stopped")
+
+	_ = application.CloseLogger()
 }
 
-func handleError(err error, logger *log.Logger) {
+func handleError(err error, logger *slog.Logger) {
 	if err != nil {
-		logger.Printf("%s\n", debug.Stack())
-		logger.Fatal(err)
+		logger.Error(err.Error(), "trace", string(debug.Stack()))
+		_ = application.CloseLogger()
+		os.Exit(1)
 	}
 }
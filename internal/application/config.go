@@ -3,18 +3,42 @@ package application
 import (
 	"encoding/base64"
 	"errors"
+	"log/slog"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
 
-var cfg Config
+var (
+	cfg   Config
+	cfgMu sync.RWMutex
+)
 
 type Config struct {
-	Database `toml:"database"`
-	Port     int    `toml:"port"`
-	LogLevel string `toml:"log_level"`
-	Secret   string `toml:"secret_key"`
-	TimeZone string `toml:"timezone"`
+	Database    `toml:"database"`
+	Tracing     `toml:"tracing"`
+	Log         `toml:"log"`
+	Attachments `toml:"attachments"`
+	Port        int    `toml:"port"`
+	GrpcPort    int    `toml:"grpc_port"`
+	LogLevel    string `toml:"log_level"`
+	Secret      string `toml:"secret_key"`
+	TimeZone    string `toml:"timezone"`
+
+	SecretFile string `toml:"secret_key_file"`
+
+	IdempotencyRetention time.Duration `toml:"idempotency_retention"`
+	// how often the recurring expenses are checked
+	RecurringInterval time.Duration `toml:"recurring_interval"`
+
+	// usernames allowed to manage the shared reference data
+	Admins []string `toml:"admins"`
+
+	// TimeZone resolved once on validation
+	location *time.Location
 }
 
 type Database struct {
@@ -22,23 +46,100 @@ type Database struct {
 	User     string `toml:"user"`
 	Password string `toml:"password"`
 	Host     string `toml:"host"`
+	Port     int    `toml:"port"`
+	Socket   string `toml:"socket"`
+
+	PasswordFile string `toml:"password_file"`
+
+	MaxOpenConns    int           `toml:"max_open_conns"`
+	MaxIdleConns    int           `toml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `toml:"conn_max_lifetime"`
+}
+
+type Tracing struct {
+	Exporter string `toml:"exporter"`
+	Endpoint string `toml:"endpoint"`
+	File     string `toml:"file"`
+}
+
+type Attachments struct {
+	// directory of the uploaded files
+	Root string `toml:"root"`
+	// max file size in MB
+	MaxSize   int      `toml:"max_size"`
+	MimeTypes []string `toml:"mime_types"`
+}
+
+func (a Attachments) MaxBytes() int {
+	return a.MaxSize << 20
+}
+
+type Log struct {
+	Level      string `toml:"level"`
+	Format     string `toml:"format"`
+	File       string `toml:"file"`
+	MaxSize    int    `toml:"max_size"`
+	MaxAge     int    `toml:"max_age"`
+	MaxBackups int    `toml:"max_backups"`
+
+	level slog.Level
+}
+
+func (l Log) SlogLevel() slog.Level {
+	return l.level
 }
 
 func LoadConfig(configPath string) error {
-	_, err := toml.DecodeFile(configPath, &cfg)
+	newCfg, err := readConfig(configPath)
 	if err != nil {
 		return err
 	}
 
-	return validate()
+	cfgMu.Lock()
+	cfg = newCfg
+	cfgMu.Unlock()
+
+	return nil
 }
 
 func GetConfig() Config {
+	cfgMu.RLock()
+	defer cfgMu.RUnlock()
+
 	return cfg
 }
 
+func IsAdmin(username string) bool {
+	return slices.Contains(GetConfig().Admins, username)
+}
+
+// Location returns the timezone of the config, the dates of the records
+// are read from the database in it
+func Location() *time.Location {
+	cfgMu.RLock()
+	defer cfgMu.RUnlock()
+
+	if cfg.location == nil {
+		return time.Local
+	}
+
+	return cfg.location
+}
+
+// Today returns the current date in the timezone of the config
+func Today() time.Time {
+	return TodayIn(Location())
+}
+
+// TodayIn returns the current date in the location as the midnight in UTC
+func TodayIn(loc *time.Location) time.Time {
+	year, month, day := time.Now().In(loc).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
 func GetSecretKey() []byte {
-	key, err := base64.StdEncoding.DecodeString(cfg.Secret)
+	key, err := base64.StdEncoding.DecodeString(GetConfig().Secret)
 	if err != nil {
 		panic(err)
 	}
@@ -46,15 +147,121 @@ func GetSecretKey() []byte {
 	return key
 }
 
-func validate() error {
+func readConfig(configPath string) (Config, error) {
+	var c Config
+	_, err := toml.DecodeFile(configPath, &c)
+	if err != nil {
+		return c, err
+	}
+
+	// all the problems are reported at once, the invalid overrides
+	// together with the invalid values
+	overrideErr := errors.Join(
+		readSecretFiles(&c),
+		applyEnvironment(reflect.ValueOf(&c).Elem(), envPrefix),
+	)
+
+	setDefaults(&c)
+
+	return c, errors.Join(overrideErr, validate(&c))
+}
+
+func setDefaults(c *Config) {
+	if c.IdempotencyRetention == 0 {
+		c.IdempotencyRetention = 24 * time.Hour
+	}
+	if c.RecurringInterval == 0 {
+		c.RecurringInterval = time.Hour
+	}
+	if c.Attachments.Root == "" {
+		c.Attachments.Root = "var/attachments"
+	}
+	if c.Attachments.MaxSize == 0 {
+		c.Attachments.MaxSize = 10
+	}
+	if len(c.Attachments.MimeTypes) == 0 {
+		c.Attachments.MimeTypes = []string{"image/jpeg", "image/png", "image/webp", "application/pdf"}
+	}
+	if c.Database.Port == 0 {
+		c.Database.Port = 3306
+	}
+	if c.Database.MaxOpenConns == 0 {
+		c.Database.MaxOpenConns = 10
+	}
+	if c.Database.MaxIdleConns == 0 {
+		c.Database.MaxIdleConns = 10
+	}
+	if c.Database.ConnMaxLifetime == 0 {
+		c.Database.ConnMaxLifetime = 5 * time.Minute
+	}
+}
+
+func validate(cfg *Config) error {
+	var errs []error
+
 	secret, err := base64.StdEncoding.DecodeString(cfg.Secret)
 	if err != nil {
-		return errors.New("config: invalid secret key (illegal base64)")
+		errs = append(errs, errors.New("config: invalid secret key (illegal base64)"))
+	} else if len(secret) < 32 {
+		errs = append(errs, errors.New("config: weak secret key (too short)"))
 	}
 
-	if len(secret) < 32 {
-		return errors.New("config: weak secret key (too short)")
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		errs = append(errs, errors.New("config: invalid port"))
+	}
+	if cfg.GrpcPort < 0 || cfg.GrpcPort > 65535 || cfg.GrpcPort == cfg.Port {
+		errs = append(errs, errors.New("config: invalid gRPC port"))
 	}
 
-	return nil
+	if cfg.Attachments.MaxSize < 0 {
+		errs = append(errs, errors.New("config: invalid attachment size"))
+	}
+
+	if cfg.RecurringInterval < 0 {
+		errs = append(errs, errors.New("config: invalid recurring interval"))
+	}
+
+	if cfg.location, err = time.LoadLocation(cfg.TimeZone); err != nil {
+		errs = append(errs, errors.New("config: invalid timezone"))
+	}
+
+	if cfg.Database.Name == "" {
+		errs = append(errs, errors.New("config: database name is required"))
+	}
+	if cfg.Database.User == "" {
+		errs = append(errs, errors.New("config: database user is required"))
+	}
+	if cfg.Database.Host == "" && cfg.Database.Socket == "" {
+		errs = append(errs, errors.New("config: database host or socket is required"))
+	}
+	if cfg.Database.Port < 1 || cfg.Database.Port > 65535 {
+		errs = append(errs, errors.New("config: invalid database port"))
+	}
+
+	if cfg.Log.Level == "" {
+		cfg.Log.Level = cfg.LogLevel
+	}
+	if cfg.Log.Level != "" {
+		if err = cfg.Log.level.UnmarshalText([]byte(cfg.Log.Level)); err != nil {
+			errs = append(errs, errors.New("config: invalid log level"))
+		}
+	}
+
+	switch cfg.Log.Format {
+	case "", "text", "json":
+	default:
+		errs = append(errs, errors.New("config: unknown log format"))
+	}
+
+	switch cfg.Tracing.Exporter {
+	case "", "otlp":
+	case "file":
+		if cfg.Tracing.File == "" {
+			errs = append(errs, errors.New("config: tracing file is required for file exporter"))
+		}
+	default:
+		errs = append(errs, errors.New("config: unknown tracing exporter"))
+	}
+
+	return errors.Join(errs...)
 }
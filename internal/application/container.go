@@ -2,13 +2,18 @@ package application
 
 import (
 	"log/slog"
+	"os"
 
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"xelbot.com/auto-notes/server/internal/utils/database"
 )
 
 type Container struct {
 	DB     *database.DB
 	logger *slog.Logger
+
+	tracerProvider *sdktrace.TracerProvider
+	traceFile      *os.File
 }
 
 func (c *Container) SetupDatabase() error {
@@ -29,5 +34,5 @@ func (c *Container) Stop() error {
 	}
 	c.logger.Info("The database connection is closed")
 
-	return nil
+	return c.stopTracing()
 }
@@ -3,6 +3,8 @@ package application
 import (
 	"database/sql"
 	"log/slog"
+	"net"
+	"strconv"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -46,9 +48,9 @@ func openDB(dsn string) (*sql.DB, error) {
 		return nil, err
 	}
 
-	db.SetMaxOpenConns(10)
-	db.SetMaxIdleConns(10)
-	db.SetConnMaxLifetime(5 * time.Minute)
+	db.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
 
 	if err = db.Ping(); err != nil {
 		return nil, err
@@ -61,7 +63,12 @@ func createDSN(loc *time.Location) string {
 	dbConfig := mysql.NewConfig()
 	dbConfig.User = cfg.Database.User
 	dbConfig.Passwd = cfg.Database.Password
-	dbConfig.Addr = cfg.Database.Host + ":3306"
+	if cfg.Database.Socket != "" {
+		dbConfig.Net = "unix"
+		dbConfig.Addr = cfg.Database.Socket
+	} else {
+		dbConfig.Addr = net.JoinHostPort(cfg.Database.Host, strconv.Itoa(cfg.Database.Port))
+	}
 	dbConfig.DBName = cfg.Database.Name
 	dbConfig.Loc = loc
 	dbConfig.ParseTime = true
@@ -0,0 +1,147 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const envPrefix = "AUTONOTES"
+
+// applyEnvironment overrides config keys by AUTONOTES_* variables,
+// for example AUTONOTES_DATABASE_HOST for the "host" key of [database].
+// A variable with the _FILE suffix points to a file with the value
+func applyEnvironment(v reflect.Value, prefix string) error {
+	var errs []error
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key, _, _ := strings.Cut(field.Tag.Get("toml"), ",")
+		if key == "" {
+			continue
+		}
+
+		name := prefix + "_" + strings.ToUpper(key)
+		if field.Type.Kind() == reflect.Struct {
+			errs = append(errs, applyEnvironment(v.Field(i), name))
+			continue
+		}
+
+		value, found, err := lookupEnv(name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !found {
+			continue
+		}
+
+		if err = setValue(v.Field(i), value); err != nil {
+			errs = append(errs, fmt.Errorf("config: invalid value of %s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func lookupEnv(name string) (string, bool, error) {
+	if path, found := os.LookupEnv(name + "_FILE"); found {
+		value, err := readValueFile(path)
+		if err != nil {
+			return "", false, fmt.Errorf("config: %s_FILE: %w", name, err)
+		}
+
+		return value, true, nil
+	}
+
+	value, found := os.LookupEnv(name)
+
+	return value, found, nil
+}
+
+func readSecretFiles(c *Config) error {
+	var errs []error
+
+	if c.SecretFile != "" {
+		value, err := readValueFile(c.SecretFile)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("config: secret_key_file: %w", err))
+		} else {
+			c.Secret = value
+		}
+	}
+
+	if c.Database.PasswordFile != "" {
+		value, err := readValueFile(c.Database.PasswordFile)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("config: database.password_file: %w", err))
+		} else {
+			c.Database.Password = value
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func readValueFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func setValue(v reflect.Value, value string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(n))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return errors.New("unsupported type")
+		}
+
+		items := make([]string, 0)
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return errors.New("unsupported type")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApplyEnvironment(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(secretFile, []byte("pa$$w0rd\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("AUTONOTES_PORT", "9090")
+	t.Setenv("AUTONOTES_DATABASE_HOST", "db")
+	t.Setenv("AUTONOTES_DATABASE_PASSWORD_FILE", secretFile)
+	t.Setenv("AUTONOTES_DATABASE_CONN_MAX_LIFETIME", "1m")
+	t.Setenv("AUTONOTES_ADMINS", "admin, editor")
+
+	c := Config{Port: 8080}
+	c.Database.Host = "localhost"
+
+	if err := applyEnvironment(reflect.ValueOf(&c).Elem(), envPrefix); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Port != 9090 {
+		t.Errorf("port: got %d; want 9090", c.Port)
+	}
+	if c.Database.Host != "db" {
+		t.Errorf("host: got %q; want %q", c.Database.Host, "db")
+	}
+	if c.Database.Password != "pa$$w0rd" {
+		t.Errorf("password: got %q; want %q", c.Database.Password, "pa$$w0rd")
+	}
+	if c.Database.ConnMaxLifetime != time.Minute {
+		t.Errorf("conn_max_lifetime: got %s; want 1m", c.Database.ConnMaxLifetime)
+	}
+	if !reflect.DeepEqual(c.Admins, []string{"admin", "editor"}) {
+		t.Errorf("admins: got %q; want [admin editor]", c.Admins)
+	}
+}
+
+func TestApplyEnvironmentErrors(t *testing.T) {
+	t.Setenv("AUTONOTES_PORT", "http")
+	t.Setenv("AUTONOTES_DATABASE_MAX_OPEN_CONNS", "many")
+
+	c := Config{}
+	err := applyEnvironment(reflect.ValueOf(&c).Elem(), envPrefix)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 2 {
+		t.Errorf("got %d errors; want 2", n)
+	}
+}
+
+func TestReadConfigErrors(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(configFile, []byte("port = 0\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("AUTONOTES_DATABASE_MAX_OPEN_CONNS", "many")
+
+	_, err := readConfig(configFile)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	for _, want := range []string{"AUTONOTES_DATABASE_MAX_OPEN_CONNS", "config: invalid port"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("got %q; want it to mention %q", err, want)
+		}
+	}
+}
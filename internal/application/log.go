@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"runtime/debug"
 
+	"go.opentelemetry.io/otel/trace"
 	"xelbot.com/auto-notes/server/internal/constants"
 )
 
@@ -40,6 +41,9 @@ func logContext(ctx context.Context, args ...any) []any {
 	if reqID, ok := ctx.Value(constants.CtxKeyRequestID).(string); ok {
 		additional = append(additional, "request_id", reqID)
 	}
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.HasTraceID() {
+		additional = append(additional, "trace_id", spanCtx.TraceID().String())
+	}
 
 	if len(additional) > 0 {
 		args = append(args, additional...)
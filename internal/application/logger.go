@@ -0,0 +1,47 @@
+package application
+
+import (
+	"io"
+	"log/slog"
+	"os"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var logWriter io.WriteCloser
+
+func NewLogger(level *slog.LevelVar) *slog.Logger {
+	var out io.Writer = os.Stdout
+	if cfg.Log.File != "" {
+		logWriter = &lumberjack.Logger{
+			Filename:   cfg.Log.File,
+			MaxSize:    cfg.Log.MaxSize,
+			MaxAge:     cfg.Log.MaxAge,
+			MaxBackups: cfg.Log.MaxBackups,
+			LocalTime:  true,
+		}
+		out = logWriter
+	}
+
+	level.Set(cfg.Log.level)
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch cfg.Log.Format {
+	case "json":
+		handler = slog.NewJSONHandler(out, opts)
+	default:
+		handler = slog.NewTextHandler(out, opts)
+	}
+
+	return slog.New(handler)
+}
+
+func CloseLogger() error {
+	if logWriter == nil {
+		return nil
+	}
+
+	return logWriter.Close()
+}
@@ -0,0 +1,123 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var maskedKeys = map[string]bool{
+	"database.password": true,
+	"secret_key":        true,
+}
+
+var reloadableKeys = map[string]bool{
+	"log_level":                  true,
+	"log.level":                  true,
+	"database.max_open_conns":    true,
+	"database.max_idle_conns":    true,
+	"database.conn_max_lifetime": true,
+	"idempotency_retention":      true,
+	"admins":                     true,
+}
+
+// ReloadConfig re-reads the config file and applies the settings that
+// are safe to change at runtime. It returns the list of changed keys
+func ReloadConfig(configPath string) ([]string, error) {
+	newCfg, err := readConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cfgMu.Lock()
+	defer cfgMu.Unlock()
+
+	if newCfg.Port != cfg.Port {
+		return nil, errors.New("config: port cannot be changed without restart")
+	}
+	if newCfg.GrpcPort != cfg.GrpcPort {
+		return nil, errors.New("config: gRPC port cannot be changed without restart")
+	}
+	if newCfg.Secret != cfg.Secret {
+		return nil, errors.New("config: secret key cannot be changed without restart")
+	}
+
+	changes := diffConfig(cfg, newCfg)
+
+	// the timezone needs a restart, the resolved location is kept with it
+	reloaded := cfg
+	reloaded.LogLevel = newCfg.LogLevel
+	reloaded.Log.Level = newCfg.Log.Level
+	reloaded.Log.level = newCfg.Log.level
+	reloaded.Database.MaxOpenConns = newCfg.Database.MaxOpenConns
+	reloaded.Database.MaxIdleConns = newCfg.Database.MaxIdleConns
+	reloaded.Database.ConnMaxLifetime = newCfg.Database.ConnMaxLifetime
+	reloaded.IdempotencyRetention = newCfg.IdempotencyRetention
+	reloaded.Admins = newCfg.Admins
+
+	cfg = reloaded
+
+	return changes, nil
+}
+
+func (c *Container) ReloadDatabasePool() {
+	dbCfg := GetConfig().Database
+	c.DB.SetPool(dbCfg.MaxOpenConns, dbCfg.MaxIdleConns, dbCfg.ConnMaxLifetime)
+}
+
+func diffConfig(oldCfg, newCfg Config) []string {
+	oldValues := make(map[string]any)
+	newValues := make(map[string]any)
+	flattenConfig(reflect.ValueOf(oldCfg), "", oldValues)
+	flattenConfig(reflect.ValueOf(newCfg), "", newValues)
+
+	changes := make([]string, 0)
+	for key, oldValue := range oldValues {
+		newValue := newValues[key]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		var change string
+		if maskedKeys[key] {
+			change = key + ": changed"
+		} else {
+			change = fmt.Sprintf("%s: %v -> %v", key, oldValue, newValue)
+		}
+		if !reloadableKeys[key] {
+			change += " (restart required)"
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.Strings(changes)
+
+	return changes
+}
+
+func flattenConfig(v reflect.Value, prefix string, values map[string]any) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key, _, _ := strings.Cut(field.Tag.Get("toml"), ",")
+		if key == "" {
+			key = field.Name
+		}
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			flattenConfig(v.Field(i), key, values)
+		} else {
+			values[key] = v.Field(i).Interface()
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffConfig(t *testing.T) {
+	oldCfg := Config{Port: 8080}
+	oldCfg.Log.Level = "info"
+	oldCfg.Database.Password = "old"
+	oldCfg.Database.ConnMaxLifetime = 5 * time.Minute
+
+	newCfg := oldCfg
+	newCfg.Log.Level = "debug"
+	newCfg.Database.Password = "new"
+	newCfg.Database.Host = "db"
+
+	want := []string{
+		"database.host:  -> db (restart required)",
+		"database.password: changed (restart required)",
+		"log.level: info -> debug",
+	}
+
+	got := diffConfig(oldCfg, newCfg)
+	if len(got) != len(want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%d : got %q; want %q", i, got[i], want[i])
+		}
+	}
+}
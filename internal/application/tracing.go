@@ -0,0 +1,85 @@
+package application
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+const serviceName = "autonotes-server"
+
+func (c *Container) SetupTracing() error {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+
+	switch cfg.Tracing.Exporter {
+	case "otlp":
+		var opts []otlptracehttp.Option
+		if cfg.Tracing.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.Tracing.Endpoint))
+		}
+
+		exporter, err = otlptracehttp.New(context.Background(), opts...)
+	case "file":
+		c.traceFile, err = os.OpenFile(cfg.Tracing.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(c.traceFile))
+	default:
+		c.logger.Info("Tracing is disabled")
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c.tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+	)
+	otel.SetTracerProvider(c.tracerProvider)
+
+	c.logger.Info("Tracing is enabled", "exporter", cfg.Tracing.Exporter)
+
+	return nil
+}
+
+func (c *Container) stopTracing() error {
+	if c.tracerProvider == nil {
+		return nil
+	}
+
+	err := c.tracerProvider.Shutdown(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if c.traceFile != nil {
+		err = c.traceFile.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	c.logger.Info("The tracer provider is stopped")
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package application
+
+import (
+	"sync"
+	"time"
+)
+
+// the timezone of a user is needed on every call, a changed one
+// is dropped from the cache by ForgetUserLocation
+const userLocationTTL = 5 * time.Minute
+
+type cachedLocation struct {
+	loc     *time.Location
+	expires time.Time
+}
+
+var (
+	userLocations   = make(map[uint]cachedLocation)
+	userLocationsMu sync.RWMutex
+	// the expired entries are removed on a write once a TTL at most,
+	// so the cache holds only the users of the last minutes
+	userLocationsSweep time.Time
+)
+
+// UserLocation returns the timezone of the user, the one of the config
+// without it. The name of the timezone is loaded on a cache miss
+func UserLocation(userID uint, load func(userID uint) (string, error)) (*time.Location, error) {
+	userLocationsMu.RLock()
+	item, found := userLocations[userID]
+	userLocationsMu.RUnlock()
+
+	now := time.Now()
+	if found && now.Before(item.expires) {
+		return item.loc, nil
+	}
+
+	name, err := load(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := Location()
+	if name != "" {
+		if loc, err = time.LoadLocation(name); err != nil {
+			return nil, err
+		}
+	}
+
+	userLocationsMu.Lock()
+	if now.Sub(userLocationsSweep) >= userLocationTTL {
+		for id, cached := range userLocations {
+			if !now.Before(cached.expires) {
+				delete(userLocations, id)
+			}
+		}
+		userLocationsSweep = now
+	}
+	userLocations[userID] = cachedLocation{loc: loc, expires: now.Add(userLocationTTL)}
+	userLocationsMu.Unlock()
+
+	return loc, nil
+}
+
+// ForgetUserLocation drops the timezone of the user from the cache of this
+// server instance only, the other instances keep it until the TTL runs out
+func ForgetUserLocation(userID uint) {
+	userLocationsMu.Lock()
+	delete(userLocations, userID)
+	userLocationsMu.Unlock()
+}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUserLocationEvictsExpired(t *testing.T) {
+	load := func(uint) (string, error) {
+		return "Asia/Vladivostok", nil
+	}
+
+	if _, err := UserLocation(1, load); err != nil {
+		t.Fatal(err)
+	}
+
+	// the entry of the first user expires and the next sweep is due
+	userLocationsMu.Lock()
+	item := userLocations[1]
+	item.expires = time.Now().Add(-time.Second)
+	userLocations[1] = item
+	userLocationsSweep = time.Time{}
+	userLocationsMu.Unlock()
+
+	loc, err := UserLocation(2, load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loc.String() != "Asia/Vladivostok" {
+		t.Errorf("got %s; want Asia/Vladivostok", loc)
+	}
+
+	userLocationsMu.RLock()
+	_, found := userLocations[1]
+	size := len(userLocations)
+	userLocationsMu.RUnlock()
+
+	if found || size != 1 {
+		t.Errorf("got %d entries, expired one kept: %v; want 1 without it", size, found)
+	}
+}
@@ -1,6 +1,8 @@
 package constants
 
 const (
-	CtxKeyUser      = "user_ctx_key"
-	CtxKeyRequestID = "req_id_ctx_key"
+	CtxKeyUser        = "user_ctx_key"
+	CtxKeyRequestID   = "req_id_ctx_key"
+	CtxKeyIdempotency = "idempotency_ctx_key"
+	CtxKeyLocation    = "location_ctx_key"
 )
@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -12,49 +13,55 @@ import (
 	"xelbot.com/auto-notes/server/internal/security"
 )
 
-func WithAuthorization(app application.Container, next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+const forbiddenMessage = "Who are you?"
 
-		authHeader := r.Header.Get("Authorization")
-		if len(authHeader) == 0 {
-			app.Warn("Authorization: empty auth header", ctx)
+var errForbidden = errors.New("middlewares: forbidden")
 
+func WithAuthorization(app application.Container, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := authorize(app, r.Context(), r.Header.Get("Authorization"))
+		if err != nil {
 			forbidden(w)
 			return
 		}
 
-		token, found := strings.CutPrefix(authHeader, "Bearer ")
-		if !found {
-			app.Warn("Authorization: incorrect auth header", ctx)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
 
-			forbidden(w)
-			return
-		}
+func authorize(app application.Container, ctx context.Context, authHeader string) (context.Context, error) {
+	if len(authHeader) == 0 {
+		app.Warn("Authorization: empty auth header", ctx)
 
-		verifiedToken, err := jwt.Verify(jwt.HS256, application.GetSecretKey(), []byte(token))
-		if err != nil {
-			app.Warn("Authorization: invalid token", ctx, "err", err.Error())
+		return nil, errForbidden
+	}
 
-			forbidden(w)
-			return
-		}
+	token, found := strings.CutPrefix(authHeader, "Bearer ")
+	if !found {
+		app.Warn("Authorization: incorrect auth header", ctx)
 
-		var claims security.UserClaims
-		if err = verifiedToken.Claims(&claims); err != nil {
-			app.Warn("Authorization: invalid token claims", ctx, "err", err.Error())
+		return nil, errForbidden
+	}
 
-			forbidden(w)
-			return
-		}
+	verifiedToken, err := jwt.Verify(jwt.HS256, application.GetSecretKey(), []byte(token))
+	if err != nil {
+		app.Warn("Authorization: invalid token", ctx, "err", err.Error())
 
-		app.Info("Authorization: parsed claims", ctx, "claims", claims)
-		ctx = context.WithValue(ctx, constants.CtxKeyUser, claims)
+		return nil, errForbidden
+	}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	var claims security.UserClaims
+	if err = verifiedToken.Claims(&claims); err != nil {
+		app.Warn("Authorization: invalid token claims", ctx, "err", err.Error())
+
+		return nil, errForbidden
+	}
+
+	app.Info("Authorization: parsed claims", ctx, "claims", claims)
+
+	return context.WithValue(ctx, constants.CtxKeyUser, claims), nil
 }
 
 func forbidden(w http.ResponseWriter) {
-	twirp.WriteError(w, twirp.PermissionDenied.Error("Who are you?"))
+	twirp.WriteError(w, twirp.PermissionDenied.Error(forbiddenMessage))
 }
@@ -0,0 +1,14 @@
+package middlewares
+
+import (
+	"net/http"
+)
+
+// MaxBodySize fails reading the request body over the limit
+func MaxBodySize(limit int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,251 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/constants"
+	pbAuth "xelbot.com/auto-notes/server/rpc/auth"
+)
+
+var twirpToGrpcCodes = map[twirp.ErrorCode]codes.Code{
+	twirp.Canceled:           codes.Canceled,
+	twirp.Unknown:            codes.Unknown,
+	twirp.InvalidArgument:    codes.InvalidArgument,
+	twirp.Malformed:          codes.InvalidArgument,
+	twirp.DeadlineExceeded:   codes.DeadlineExceeded,
+	twirp.NotFound:           codes.NotFound,
+	twirp.BadRoute:           codes.Unimplemented,
+	twirp.AlreadyExists:      codes.AlreadyExists,
+	twirp.PermissionDenied:   codes.PermissionDenied,
+	twirp.Unauthenticated:    codes.Unauthenticated,
+	twirp.ResourceExhausted:  codes.ResourceExhausted,
+	twirp.FailedPrecondition: codes.FailedPrecondition,
+	twirp.Aborted:            codes.Aborted,
+	twirp.OutOfRange:         codes.OutOfRange,
+	twirp.Unimplemented:      codes.Unimplemented,
+	twirp.Internal:           codes.Internal,
+	twirp.Unavailable:        codes.Unavailable,
+	twirp.DataLoss:           codes.DataLoss,
+}
+
+type metadataCarrier metadata.MD
+
+func (mc metadataCarrier) Get(key string) string {
+	values := metadata.MD(mc).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func (mc metadataCarrier) Set(key, value string) {
+	metadata.MD(mc).Set(key, value)
+}
+
+func (mc metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for key := range mc {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *serverStream) Context() context.Context {
+	return ss.ctx
+}
+
+// GrpcInterceptors returns the gRPC counterparts of the HTTP middlewares:
+// panic recovery, request ID, tracing, authorization, local dates and
+// conversion of Twirp errors
+func GrpcInterceptors(app application.Container) []grpc.ServerOption {
+	auth := grpcAuthorization(app)
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+				defer grpcRecover(app, ctx, &err)
+
+				return handler(ctx, req)
+			},
+			func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				var resp any
+				err := grpcCall(ctx, info.FullMethod, auth, func(ctx context.Context) (err error) {
+					resp, err = withLocalDates(app, ctx, req, handler)
+
+					return err
+				})
+
+				return resp, err
+			},
+		),
+		grpc.ChainStreamInterceptor(
+			func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+				defer grpcRecover(app, ss.Context(), &err)
+
+				return handler(srv, ss)
+			},
+			func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				return grpcCall(ss.Context(), info.FullMethod, auth, func(ctx context.Context) error {
+					ctx, loc := withUserLocation(app, ctx)
+
+					return handler(srv, &localDatesStream{
+						ServerStream: &serverStream{ServerStream: ss, ctx: ctx},
+						loc:          loc,
+					})
+				})
+			},
+		),
+	}
+}
+
+// grpcRecover turns a panic of a handler into an Internal status, as Twirp
+// does, instead of stopping the whole server
+func grpcRecover(app application.Container, ctx context.Context, err *error) {
+	if p := recover(); p != nil {
+		app.ServerError(ctx, fmt.Errorf("panic: %v", p))
+		*err = status.Error(codes.Internal, "internal error")
+	}
+}
+
+func grpcCall(
+	ctx context.Context,
+	fullMethod string,
+	auth func(ctx context.Context, fullMethod string) (context.Context, error),
+	handler func(ctx context.Context) error,
+) error {
+	reqID := generateRequestId()
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", reqID))
+	ctx = context.WithValue(ctx, constants.CtxKeyRequestID, reqID)
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(IdempotencyHeader); len(values) > 0 && values[0] != "" {
+			ctx = context.WithValue(ctx, constants.CtxKeyIdempotency, values[0])
+		}
+	}
+
+	return grpcTracing(ctx, fullMethod, func(ctx context.Context) error {
+		ctx, err := auth(ctx, fullMethod)
+		if err == nil {
+			err = handler(ctx)
+		}
+
+		return grpcError(err)
+	})
+}
+
+func grpcTracing(ctx context.Context, fullMethod string, handler func(ctx context.Context) error) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	service, method := grpcRoute(fullMethod)
+	ctx, span := otel.Tracer(tracerName).Start(
+		ctx,
+		service+"/"+method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.RPCSystemGRPC,
+			semconv.RPCService(service),
+			semconv.RPCMethod(method),
+		),
+	)
+	defer span.End()
+
+	if reqID, ok := ctx.Value(constants.CtxKeyRequestID).(string); ok {
+		span.SetAttributes(attribute.String("request.id", reqID))
+	}
+
+	err := handler(ctx)
+	if err != nil {
+		st := status.Convert(err)
+		span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(st.Code())))
+		span.RecordError(err)
+		if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+			span.SetStatus(otelcodes.Error, st.Message())
+		}
+	}
+
+	return err
+}
+
+// grpcError converts errors of the Twirp services to gRPC statuses,
+// the error metadata is passed in the ErrorInfo details
+func grpcError(err error) error {
+	var twerr twirp.Error
+	if errors.As(err, &twerr) {
+		code, ok := twirpToGrpcCodes[twerr.Code()]
+		if !ok {
+			code = codes.Unknown
+		}
+
+		st := status.New(code, twerr.Msg())
+		if meta := twerr.MetaMap(); len(meta) > 0 {
+			info := &errdetails.ErrorInfo{
+				Reason:   string(twerr.Code()),
+				Domain:   "auto-notes",
+				Metadata: meta,
+			}
+			if withDetails, detailsErr := st.WithDetails(info); detailsErr == nil {
+				st = withDetails
+			}
+		}
+
+		return st.Err()
+	}
+
+	return err
+}
+
+func grpcAuthorization(app application.Container) func(ctx context.Context, fullMethod string) (context.Context, error) {
+	authPrefix := "/" + pbAuth.Auth_ServiceDesc.ServiceName + "/"
+
+	return func(ctx context.Context, fullMethod string) (context.Context, error) {
+		if strings.HasPrefix(fullMethod, authPrefix) {
+			return ctx, nil
+		}
+
+		var authHeader string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				authHeader = values[0]
+			}
+		}
+
+		ctx, err := authorize(app, ctx, authHeader)
+		if err != nil {
+			return nil, twirp.PermissionDenied.Error(forbiddenMessage)
+		}
+
+		return ctx, nil
+	}
+}
+
+func grpcRoute(fullMethod string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if idx := strings.LastIndex(service, "."); idx >= 0 {
+		service = service[idx+1:]
+	}
+
+	return service, method
+}
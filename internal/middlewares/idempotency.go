@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"xelbot.com/auto-notes/server/internal/constants"
+)
+
+const IdempotencyHeader = "Idempotency-Key"
+
+func IdempotencyKey(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), constants.CtxKeyIdempotency, key)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,89 @@
+package middlewares
+
+import (
+	"context"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/constants"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+)
+
+// LocalDates takes the dates of the requests in the timezone of the user
+// and returns the dates in it, the server timezone is used without one
+func LocalDates(app application.Container) twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req any) (any, error) {
+			return withLocalDates(app, ctx, req, next)
+		}
+	}
+}
+
+func withLocalDates(
+	app application.Container,
+	ctx context.Context,
+	req any,
+	handler func(ctx context.Context, req any) (any, error),
+) (any, error) {
+	ctx, loc := withUserLocation(app, ctx)
+
+	if msg, ok := req.(proto.Message); ok {
+		models.ToUTCDates(msg, loc)
+	}
+
+	resp, err := handler(ctx, req)
+	if msg, ok := resp.(proto.Message); ok && err == nil {
+		models.ToLocalDates(msg, application.Location(), loc)
+	}
+
+	return resp, err
+}
+
+// localDatesStream converts the dates of the streamed messages
+type localDatesStream struct {
+	grpc.ServerStream
+	loc *time.Location
+}
+
+func (lds *localDatesStream) SendMsg(m any) error {
+	if msg, ok := m.(proto.Message); ok {
+		models.ToLocalDates(msg, application.Location(), lds.loc)
+	}
+
+	return lds.ServerStream.SendMsg(m)
+}
+
+func (lds *localDatesStream) RecvMsg(m any) error {
+	if err := lds.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if msg, ok := m.(proto.Message); ok {
+		models.ToUTCDates(msg, lds.loc)
+	}
+
+	return nil
+}
+
+// withUserLocation puts the timezone of the authorized user into the context
+func withUserLocation(app application.Container, ctx context.Context) (context.Context, *time.Location) {
+	loc := application.Location()
+
+	if user, ok := ctx.Value(constants.CtxKeyUser).(security.UserClaims); ok {
+		repo := repository.UserSettingRepository{DB: app.DB.WithContext(ctx)}
+		userLoc, err := application.UserLocation(user.ID, repo.GetTimezone)
+		if err == nil {
+			loc = userLoc
+		} else {
+			// the dates are taken in the server timezone then
+			app.Error("LocalDates: user timezone failed", ctx, "user_id", user.ID, "err", err.Error())
+		}
+	}
+
+	return context.WithValue(ctx, constants.CtxKeyLocation, loc), loc
+}
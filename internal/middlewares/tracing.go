@@ -0,0 +1,87 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"xelbot.com/auto-notes/server/internal/constants"
+)
+
+const tracerName = "xelbot.com/auto-notes/server/internal/middlewares"
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Tracing starts a server span for each Twirp call and continues
+// the trace from an incoming W3C traceparent header
+func Tracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		service, method := twirpRoute(r.URL.Path)
+		ctx, span := otel.Tracer(tracerName).Start(
+			ctx,
+			service+"/"+method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.RPCSystemKey.String("twirp"),
+				semconv.RPCService(service),
+				semconv.RPCMethod(method),
+			),
+		)
+		defer span.End()
+
+		if reqID, ok := ctx.Value(constants.CtxKeyRequestID).(string); ok {
+			span.SetAttributes(attribute.String("request.id", reqID))
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(recorder.status))
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.status))
+		}
+	})
+}
+
+// TracingHooks records Twirp errors on the span of the current call
+func TracingHooks() *twirp.ServerHooks {
+	return &twirp.ServerHooks{
+		Error: func(ctx context.Context, err twirp.Error) context.Context {
+			span := trace.SpanFromContext(ctx)
+			span.SetAttributes(attribute.String("twirp.error_code", string(err.Code())))
+			span.RecordError(err)
+			if twirp.ServerHTTPStatusFromErrorCode(err.Code()) >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, err.Msg())
+			}
+
+			return ctx
+		},
+	}
+}
+
+func twirpRoute(path string) (string, string) {
+	path = strings.TrimPrefix(path, "/twirp/")
+	service, method, _ := strings.Cut(path, "/")
+	if idx := strings.LastIndex(service, "."); idx >= 0 {
+		service = service[idx+1:]
+	}
+
+	return service, method
+}
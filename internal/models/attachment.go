@@ -0,0 +1,35 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type Attachment struct {
+	ID            uint
+	UserID        uint
+	Entity        pb.SyncEntity
+	RecordID      uint
+	Name          string
+	MimeType      string
+	Size          int
+	Path          string
+	ThumbnailPath sql.NullString
+	CreatedAt     time.Time
+}
+
+func (a *Attachment) ToRpcMessage() *pb.Attachment {
+	return &pb.Attachment{
+		Id:           int32(a.ID),
+		Entity:       a.Entity,
+		RecordId:     int32(a.RecordID),
+		Name:         a.Name,
+		MimeType:     a.MimeType,
+		Size:         int32(a.Size),
+		HasThumbnail: a.ThumbnailPath.Valid,
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+	}
+}
@@ -3,6 +3,9 @@ package models
 import (
 	"database/sql"
 	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
 type Car struct {
@@ -16,3 +19,21 @@ type Car struct {
 	CreatedAt time.Time
 	UpdatedAt sql.NullTime
 }
+
+func (c *Car) ToRpcMessage() *pb.Car {
+	message := &pb.Car{
+		Id:        int32(c.ID),
+		Name:      c.Brand + " " + c.Model,
+		Default:   c.Default,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+
+	if c.Vin.Valid {
+		message.Vin = c.Vin.String
+	}
+	if c.Year.Valid {
+		message.Year = c.Year.Int32
+	}
+
+	return message
+}
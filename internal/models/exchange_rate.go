@@ -0,0 +1,49 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+// ExchangeRate is a price of one unit of the currency in units of the base currency
+type ExchangeRate struct {
+	ID             uint
+	CurrencyID     uint
+	CurrencyCode   string
+	BaseCurrencyID uint
+	BaseCode       string
+	Date           time.Time
+	Rate           float64
+}
+
+func (er *ExchangeRate) ToRpcMessage() *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		Currency: er.CurrencyCode,
+		Base:     er.BaseCode,
+		Date:     timestamppb.New(er.Date),
+		Rate:     er.Rate,
+	}
+}
+
+// RateHistory holds the rates of the currencies in one target currency,
+// ordered by date for every currency code
+type RateHistory map[string][]*ExchangeRate
+
+// Rate returns the latest rate of the currency on or before the date,
+// false without one
+func (h RateHistory) Rate(code string, date time.Time) (float64, bool) {
+	day := date.Format(time.DateOnly)
+	rates := h[code]
+
+	idx := sort.Search(len(rates), func(i int) bool {
+		return rates[i].Date.Format(time.DateOnly) > day
+	})
+	if idx == 0 {
+		return 0, false
+	}
+
+	return rates[idx-1].Rate, true
+}
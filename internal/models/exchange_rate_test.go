@@ -0,0 +1,39 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateHistory(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	history := RateHistory{
+		"EUR": {
+			{Date: day(1), Rate: 98},
+			{Date: day(10), Rate: 100},
+		},
+	}
+
+	tests := []struct {
+		code  string
+		date  time.Time
+		rate  float64
+		found bool
+	}{
+		{code: "EUR", date: day(1).Add(-time.Hour), rate: 0, found: false},
+		{code: "EUR", date: day(1), rate: 98, found: true},
+		{code: "EUR", date: day(9), rate: 98, found: true},
+		{code: "EUR", date: day(10).Add(20 * time.Hour), rate: 100, found: true},
+		{code: "USD", date: day(10), rate: 0, found: false},
+	}
+
+	for _, tt := range tests {
+		rate, found := history.Rate(tt.code, tt.date)
+		if rate != tt.rate || found != tt.found {
+			t.Errorf("%s on %s: got %v, %v; want %v, %v", tt.code, tt.date, rate, found, tt.rate, tt.found)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"database/sql"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -14,6 +15,9 @@ type Expense struct {
 	Date        time.Time
 	Car         *Car
 	Type        pb.ExpenseType
+	Category    *ExpenseCategory
+	RecurringID sql.NullInt32
+	Version     uint
 	CreatedAt   time.Time
 }
 
@@ -27,6 +31,7 @@ func (e *Expense) ToRpcMessage() *pb.Expense {
 		Description: e.Description,
 		Type:        e.Type,
 		Date:        timestamppb.New(e.Date),
+		Version:     int32(e.Version),
 		CreatedAt:   timestamppb.New(e.CreatedAt),
 	}
 
@@ -37,5 +42,13 @@ func (e *Expense) ToRpcMessage() *pb.Expense {
 		}
 	}
 
+	if e.RecurringID.Valid {
+		message.RecurringId = e.RecurringID.Int32
+	}
+
+	if e.Category != nil {
+		message.Category = e.Category.ToRpcMessage()
+	}
+
 	return message
 }
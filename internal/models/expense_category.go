@@ -0,0 +1,48 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type ExpenseCategory struct {
+	ID uint
+	// empty for the built-in categories
+	UserID   sql.NullInt32
+	ParentID sql.NullInt32
+	Name     string
+	// enum value reported to the clients without categories
+	Type      pb.ExpenseType
+	CreatedAt time.Time
+}
+
+func (ec *ExpenseCategory) IsSystem() bool {
+	return !ec.UserID.Valid
+}
+
+// VisibleTo reports whether the user may assign the category
+func (ec *ExpenseCategory) VisibleTo(userID uint) bool {
+	return ec.IsSystem() || uint(ec.UserID.Int32) == userID
+}
+
+func (ec *ExpenseCategory) ToRpcMessage() *pb.ExpenseCategory {
+	message := &pb.ExpenseCategory{
+		Id:     int32(ec.ID),
+		Name:   ec.Name,
+		Type:   ec.Type,
+		System: ec.IsSystem(),
+	}
+
+	if ec.ParentID.Valid {
+		message.ParentId = ec.ParentID.Int32
+	}
+
+	if !ec.CreatedAt.IsZero() {
+		message.CreatedAt = timestamppb.New(ec.CreatedAt)
+	}
+
+	return message
+}
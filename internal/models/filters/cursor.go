@@ -0,0 +1,60 @@
+package filters
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+var InvalidCursor = errors.New("filters: invalid cursor")
+
+type cursorPart interface {
+	GetCursor() string
+	GetSkipCount() bool
+}
+
+// EncodeCursor builds an opaque cursor from the sort values of the last row
+func EncodeCursor(values ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(values, ",")))
+}
+
+func (p *commonPart) HasCursor() bool {
+	if pf, ok := p.filter.(cursorPart); ok {
+		return pf.GetCursor() != ""
+	}
+
+	return false
+}
+
+// GetCursor returns the sort values of the row the page starts after
+func (p *commonPart) GetCursor() ([]string, error) {
+	if !p.HasCursor() {
+		return nil, nil
+	}
+
+	if !p.SupportsCursor() {
+		return nil, InvalidCursor
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(p.filter.(cursorPart).GetCursor())
+	if err != nil || len(data) == 0 {
+		return nil, InvalidCursor
+	}
+
+	return strings.Split(string(data), ","), nil
+}
+
+// SupportsCursor reports whether the list can be paged by cursor,
+// keyset pagination is built for the date sort only
+func (p *commonPart) SupportsCursor() bool {
+	return p.GetSort().Field == SortByDate
+}
+
+// NeedCount reports whether the total count is needed for page-based paging
+func (p *commonPart) NeedCount() bool {
+	if pf, ok := p.filter.(cursorPart); ok {
+		return !pf.GetSkipCount() && !p.HasCursor()
+	}
+
+	return true
+}
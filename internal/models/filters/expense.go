@@ -9,8 +9,11 @@ type ExpenseFilter struct {
 
 func NewExpenseFilter(f *pb.ExpenseFilter) *ExpenseFilter {
 	return &ExpenseFilter{
-		pbFilter:   f,
-		commonPart: commonPart{filter: f},
+		pbFilter: f,
+		commonPart: commonPart{
+			filter:     f,
+			sortFields: expenseSortFields,
+		},
 	}
 }
 
@@ -31,3 +34,11 @@ func (p *ExpenseFilter) GetType() pb.ExpenseType {
 
 	return pb.ExpenseType_EMPTY
 }
+
+func (p *ExpenseFilter) GetCategoryId() int32 {
+	if p != nil {
+		return p.pbFilter.GetCategoryId()
+	}
+
+	return 0
+}
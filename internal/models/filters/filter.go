@@ -1,10 +1,40 @@
 package filters
 
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
 type PaginationPart interface {
 	GetPage() int
 	GetLimit() int
 }
 
+// CommonPart is implemented by every list filter, the conditions
+// not supported by the underlying message are empty
+type CommonPart interface {
+	PaginationPart
+	HasCarId() bool
+	GetCarId() uint
+	HasDateFrom() bool
+	GetDateFrom() time.Time
+	HasDateTo() bool
+	GetDateTo() time.Time
+	GetMinCost() int32
+	GetMaxCost() int32
+	GetCurrency() string
+	GetSearch() string
+	GetTagId() uint
+	HasValidSort() bool
+	GetSort() Sort
+	HasCursor() bool
+	GetCursor() ([]string, error)
+	SupportsCursor() bool
+	NeedCount() bool
+}
+
 func GetLastPage(f PaginationPart, cntItems int) int {
 	var lastPage = 1
 	if f.GetLimit() > 0 {
@@ -23,8 +53,28 @@ type carPart interface {
 	GetCarId() int32
 }
 
+type datePart interface {
+	GetDateFrom() *timestamppb.Timestamp
+	GetDateTo() *timestamppb.Timestamp
+}
+
+type costPart interface {
+	GetMinCost() int32
+	GetMaxCost() int32
+	GetCurrency() string
+}
+
+type searchPart interface {
+	GetSearch() string
+}
+
+type tagPart interface {
+	GetTagId() int32
+}
+
 type commonPart struct {
-	filter any
+	filter     any
+	sortFields []string
 }
 
 func (p *commonPart) GetPage() int {
@@ -60,3 +110,75 @@ func (p *commonPart) GetCarId() uint {
 
 	return 0
 }
+
+func (p *commonPart) HasDateFrom() bool {
+	if pf, ok := p.filter.(datePart); ok {
+		return pf.GetDateFrom() != nil
+	}
+
+	return false
+}
+
+func (p *commonPart) GetDateFrom() time.Time {
+	if pf, ok := p.filter.(datePart); ok && pf.GetDateFrom() != nil {
+		return pf.GetDateFrom().AsTime()
+	}
+
+	return time.Time{}
+}
+
+func (p *commonPart) HasDateTo() bool {
+	if pf, ok := p.filter.(datePart); ok {
+		return pf.GetDateTo() != nil
+	}
+
+	return false
+}
+
+func (p *commonPart) GetDateTo() time.Time {
+	if pf, ok := p.filter.(datePart); ok && pf.GetDateTo() != nil {
+		return pf.GetDateTo().AsTime()
+	}
+
+	return time.Time{}
+}
+
+func (p *commonPart) GetMinCost() int32 {
+	if pf, ok := p.filter.(costPart); ok && pf.GetMinCost() > 0 {
+		return pf.GetMinCost()
+	}
+
+	return 0
+}
+
+func (p *commonPart) GetMaxCost() int32 {
+	if pf, ok := p.filter.(costPart); ok && pf.GetMaxCost() > 0 {
+		return pf.GetMaxCost()
+	}
+
+	return 0
+}
+
+func (p *commonPart) GetCurrency() string {
+	if pf, ok := p.filter.(costPart); ok {
+		return strings.ToUpper(strings.TrimSpace(pf.GetCurrency()))
+	}
+
+	return ""
+}
+
+func (p *commonPart) GetSearch() string {
+	if pf, ok := p.filter.(searchPart); ok {
+		return strings.TrimSpace(pf.GetSearch())
+	}
+
+	return ""
+}
+
+func (p *commonPart) GetTagId() uint {
+	if pf, ok := p.filter.(tagPart); ok && pf.GetTagId() > 0 {
+		return uint(pf.GetTagId())
+	}
+
+	return 0
+}
@@ -3,6 +3,10 @@ package filters
 import (
 	"strconv"
 	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
 type filterDumb struct{}
@@ -60,3 +64,98 @@ func TestBotFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestCommonPart(t *testing.T) {
+	dateFrom := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	expenseFilter := NewExpenseFilter(&pb.ExpenseFilter{
+		DateFrom: timestamppb.New(dateFrom),
+		MinCost:  10000,
+		MaxCost:  -1,
+		Currency: " rub ",
+		Search:   " oil ",
+	})
+
+	if !expenseFilter.HasDateFrom() || !expenseFilter.GetDateFrom().Equal(dateFrom) {
+		t.Errorf("date from: got %v", expenseFilter.GetDateFrom())
+	}
+	if expenseFilter.HasDateTo() {
+		t.Error("date to: got true; want false")
+	}
+	if expenseFilter.GetMinCost() != 10000 || expenseFilter.GetMaxCost() != 0 {
+		t.Errorf("cost: got %d-%d; want 10000-0", expenseFilter.GetMinCost(), expenseFilter.GetMaxCost())
+	}
+	if expenseFilter.GetCurrency() != "RUB" {
+		t.Errorf("currency: got %q; want RUB", expenseFilter.GetCurrency())
+	}
+	if expenseFilter.GetSearch() != "oil" {
+		t.Errorf("search: got %q; want oil", expenseFilter.GetSearch())
+	}
+
+	fuelFilter := NewFuelFilter(&pb.FuelFilter{})
+	if fuelFilter.GetSearch() != "" {
+		t.Errorf("fuel search: got %q; want empty", fuelFilter.GetSearch())
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		filter *FuelFilter
+		valid  bool
+		want   Sort
+	}{
+		{
+			filter: NewFuelFilter(&pb.FuelFilter{}),
+			valid:  true,
+			want:   Sort{Field: SortByDate, Desc: true},
+		},
+		{
+			filter: NewFuelFilter(&pb.FuelFilter{SortBy: SortByPrice, SortDirection: pb.SortDirection_SORT_ASC}),
+			valid:  true,
+			want:   Sort{Field: SortByPrice, Desc: false},
+		},
+		{
+			filter: NewFuelFilter(&pb.FuelFilter{SortBy: SortByDistance}),
+			valid:  false,
+			want:   Sort{Field: SortByDate, Desc: true},
+		},
+	}
+
+	for idx, item := range tests {
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			if item.filter.HasValidSort() != item.valid {
+				t.Errorf("valid: got %t; want %t", item.filter.HasValidSort(), item.valid)
+			}
+			if res := item.filter.GetSort(); res != item.want {
+				t.Errorf("got %+v; want %+v", res, item.want)
+			}
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	cursor := EncodeCursor("2024-03-01", "17")
+
+	filter := NewFuelFilter(&pb.FuelFilter{Cursor: cursor})
+	values, err := filter.GetCursor()
+	if err != nil || len(values) != 2 || values[0] != "2024-03-01" || values[1] != "17" {
+		t.Errorf("got %v, %v; want [2024-03-01 17]", values, err)
+	}
+	if filter.NeedCount() {
+		t.Error("count with cursor: got true; want false")
+	}
+
+	filter = NewFuelFilter(&pb.FuelFilter{Cursor: cursor, SortBy: SortByCost})
+	if _, err = filter.GetCursor(); err != InvalidCursor {
+		t.Errorf("cost sort: got %v; want %v", err, InvalidCursor)
+	}
+
+	filter = NewFuelFilter(&pb.FuelFilter{Cursor: "%%%"})
+	if _, err = filter.GetCursor(); err != InvalidCursor {
+		t.Errorf("malformed: got %v; want %v", err, InvalidCursor)
+	}
+
+	if !NewFuelFilter(&pb.FuelFilter{}).NeedCount() {
+		t.Error("count without cursor: got false; want true")
+	}
+}
@@ -9,8 +9,11 @@ type FuelFilter struct {
 
 func NewFuelFilter(f *pb.FuelFilter) *FuelFilter {
 	return &FuelFilter{
-		pbFilter:   f,
-		commonPart: commonPart{filter: f},
+		pbFilter: f,
+		commonPart: commonPart{
+			filter:     f,
+			sortFields: fuelSortFields,
+		},
 	}
 }
 
@@ -0,0 +1,31 @@
+package filters
+
+import pb "xelbot.com/auto-notes/server/rpc/server"
+
+type FuelPriceFilter struct {
+	pbFilter *pb.FuelPriceFilter
+	commonPart
+}
+
+func NewFuelPriceFilter(f *pb.FuelPriceFilter) *FuelPriceFilter {
+	return &FuelPriceFilter{
+		pbFilter:   f,
+		commonPart: commonPart{filter: f},
+	}
+}
+
+func (p *FuelPriceFilter) GetTypeId() int32 {
+	if p != nil {
+		return p.pbFilter.GetTypeId()
+	}
+
+	return 0
+}
+
+func (p *FuelPriceFilter) GetStationId() int32 {
+	if p != nil {
+		return p.pbFilter.GetStationId()
+	}
+
+	return 0
+}
@@ -9,7 +9,10 @@ type MileageFilter struct {
 
 func NewMileageFilter(f *pb.MileageFilter) *MileageFilter {
 	return &MileageFilter{
-		pbFilter:   f,
-		commonPart: commonPart{filter: f},
+		pbFilter: f,
+		commonPart: commonPart{
+			filter:     f,
+			sortFields: mileageSortFields,
+		},
 	}
 }
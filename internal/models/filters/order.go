@@ -9,8 +9,11 @@ type OrderFilter struct {
 
 func NewOrderFilter(f *pb.OrderFilter) *OrderFilter {
 	return &OrderFilter{
-		pbFilter:   f,
-		commonPart: commonPart{filter: f},
+		pbFilter: f,
+		commonPart: commonPart{
+			filter:     f,
+			sortFields: orderSortFields,
+		},
 	}
 }
 
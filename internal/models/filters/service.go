@@ -9,7 +9,10 @@ type ServiceFilter struct {
 
 func NewServiceFilter(f *pb.ServiceFilter) *ServiceFilter {
 	return &ServiceFilter{
-		pbFilter:   f,
-		commonPart: commonPart{filter: f},
+		pbFilter: f,
+		commonPart: commonPart{
+			filter:     f,
+			sortFields: serviceSortFields,
+		},
 	}
 }
@@ -0,0 +1,64 @@
+package filters
+
+import pb "xelbot.com/auto-notes/server/rpc/server"
+
+const (
+	SortByDate     = "date"
+	SortByCost     = "cost"
+	SortByValue    = "value"
+	SortByPrice    = "price"
+	SortByDistance = "distance"
+)
+
+// sort fields allowed for each entity, the first one is the default
+var (
+	fuelSortFields    = []string{SortByDate, SortByCost, SortByValue, SortByPrice}
+	orderSortFields   = []string{SortByDate, SortByCost}
+	expenseSortFields = []string{SortByDate, SortByCost}
+	serviceSortFields = []string{SortByDate, SortByCost, SortByDistance}
+	mileageSortFields = []string{SortByDate, SortByDistance}
+)
+
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+type sortPart interface {
+	GetSortBy() string
+	GetSortDirection() pb.SortDirection
+}
+
+// HasValidSort reports whether the requested sort field is allowed for the entity
+func (p *commonPart) HasValidSort() bool {
+	pf, ok := p.filter.(sortPart)
+	if !ok || pf.GetSortBy() == "" {
+		return true
+	}
+
+	for _, field := range p.sortFields {
+		if field == pf.GetSortBy() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetSort returns the requested order, or the default field in descending
+// order if the requested one is not allowed
+func (p *commonPart) GetSort() Sort {
+	sort := Sort{Desc: true}
+	if len(p.sortFields) > 0 {
+		sort.Field = p.sortFields[0]
+	}
+
+	if pf, ok := p.filter.(sortPart); ok {
+		if pf.GetSortBy() != "" && p.HasValidSort() {
+			sort.Field = pf.GetSortBy()
+		}
+		sort.Desc = pf.GetSortDirection() == pb.SortDirection_SORT_DESC
+	}
+
+	return sort
+}
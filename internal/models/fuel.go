@@ -15,21 +15,58 @@ type FillingStation struct {
 }
 
 type FuelType struct {
-	ID   uint
-	Name string
+	ID       uint
+	Name     string
+	ParentID sql.NullInt32
+}
+
+func (ft *FuelType) ToRpcMessage() *pb.FuelType {
+	message := &pb.FuelType{
+		Id:   int32(ft.ID),
+		Name: ft.Name,
+	}
+
+	if ft.ParentID.Valid {
+		message.ParentId = ft.ParentID.Int32
+	}
+
+	return message
+}
+
+// FuelTypeTree nests the child types into their parents, children of
+// a missing parent are returned as roots
+func FuelTypeTree(types []*FuelType) []*pb.FuelType {
+	nodes := make(map[int32]*pb.FuelType, len(types))
+	for _, obj := range types {
+		nodes[int32(obj.ID)] = obj.ToRpcMessage()
+	}
+
+	roots := make([]*pb.FuelType, 0)
+	for _, obj := range types {
+		node := nodes[int32(obj.ID)]
+		if parent, found := nodes[node.ParentId]; found && node.ParentId != node.Id {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	return roots
 }
 
 type Fuel struct {
-	ID        uint
-	Cost      Cost
-	Value     int32
-	Station   FillingStation
-	Date      time.Time
-	Distance  sql.NullInt32
-	Mileage   *Mileage
-	Car       *Car
-	Type      FuelType
-	CreatedAt time.Time
+	ID          uint
+	Cost        Cost
+	Value       int32
+	Description string
+	Station     FillingStation
+	Date        time.Time
+	Distance    sql.NullInt32
+	Mileage     *Mileage
+	Car         *Car
+	Type        FuelType
+	Version     uint
+	CreatedAt   time.Time
 }
 
 func (f *Fuel) ToRpcMessage() *pb.Fuel {
@@ -39,7 +76,8 @@ func (f *Fuel) ToRpcMessage() *pb.Fuel {
 			Value:    f.Cost.Value,
 			Currency: f.Cost.CurrencyCode,
 		},
-		Value: f.Value,
+		Value:       f.Value,
+		Description: f.Description,
 		Station: &pb.FillingStation{
 			Id:        int32(f.Station.ID),
 			Name:      f.Station.Name,
@@ -50,6 +88,7 @@ func (f *Fuel) ToRpcMessage() *pb.Fuel {
 			Name: f.Type.Name,
 		},
 		Date:      timestamppb.New(f.Date),
+		Version:   int32(f.Version),
 		CreatedAt: timestamppb.New(f.CreatedAt),
 	}
 
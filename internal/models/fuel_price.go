@@ -0,0 +1,199 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const (
+	// fill-ups within the window around a fill-up make the station average,
+	// the median is used so a single mistake does not shift it
+	fuelPriceWindow = 90 * 24 * time.Hour
+	// at least so many nearby fill-ups are needed to judge a price
+	fuelPriceMinNeighbours = 2
+	// relative deviation from the station median treated as a mistake
+	fuelPriceMaxDeviation = 0.25
+)
+
+// FuelPrice is a fill-up reduced to the price per litre
+type FuelPrice struct {
+	FuelID       uint
+	Date         time.Time
+	Station      FillingStation
+	Type         FuelType
+	CurrencyCode string
+	// cost and litres, both multiplied by 100
+	Cost  int32
+	Value int32
+}
+
+func (fp *FuelPrice) PerLitre() int32 {
+	if fp.Value <= 0 {
+		return 0
+	}
+
+	return int32(math.Round(float64(fp.Cost) * 100 / float64(fp.Value)))
+}
+
+type FuelPricePoint struct {
+	FuelID     uint
+	Date       time.Time
+	Price      int32
+	Deviation  float64
+	Suspicious bool
+}
+
+type FuelPriceHistory struct {
+	Station      FillingStation
+	Type         FuelType
+	CurrencyCode string
+	Points       []FuelPricePoint
+	Min          int32
+	Avg          int32
+	Max          int32
+}
+
+type fuelPriceKey struct {
+	stationID uint
+	typeID    uint
+	currency  string
+}
+
+// NewFuelPriceHistory groups the fill-ups by station, fuel type and currency
+// and compares every price with the median of the nearby fill-ups
+func NewFuelPriceHistory(prices []*FuelPrice) []*FuelPriceHistory {
+	groups := make(map[fuelPriceKey]*FuelPriceHistory)
+	items := make([]*FuelPriceHistory, 0)
+
+	for _, price := range prices {
+		perLitre := price.PerLitre()
+		if perLitre <= 0 {
+			continue
+		}
+
+		key := fuelPriceKey{
+			stationID: price.Station.ID,
+			typeID:    price.Type.ID,
+			currency:  price.CurrencyCode,
+		}
+
+		history, found := groups[key]
+		if !found {
+			history = &FuelPriceHistory{
+				Station:      price.Station,
+				Type:         price.Type,
+				CurrencyCode: price.CurrencyCode,
+			}
+			groups[key] = history
+			items = append(items, history)
+		}
+
+		history.Points = append(history.Points, FuelPricePoint{
+			FuelID: price.FuelID,
+			Date:   price.Date,
+			Price:  perLitre,
+		})
+	}
+
+	for _, history := range items {
+		history.calculate()
+	}
+
+	return items
+}
+
+func (fph *FuelPriceHistory) calculate() {
+	sort.SliceStable(fph.Points, func(i, j int) bool {
+		return fph.Points[i].Date.Before(fph.Points[j].Date)
+	})
+
+	var sum int64
+	for i := range fph.Points {
+		point := &fph.Points[i]
+		if i == 0 || point.Price < fph.Min {
+			fph.Min = point.Price
+		}
+		if point.Price > fph.Max {
+			fph.Max = point.Price
+		}
+		sum += int64(point.Price)
+
+		neighbours := make([]int32, 0, len(fph.Points))
+		for j, other := range fph.Points {
+			if j == i || absDuration(other.Date.Sub(point.Date)) > fuelPriceWindow {
+				continue
+			}
+			neighbours = append(neighbours, other.Price)
+		}
+
+		if len(neighbours) >= fuelPriceMinNeighbours {
+			typical := median(neighbours)
+			point.Deviation = (float64(point.Price) - typical) / typical
+			point.Suspicious = math.Abs(point.Deviation) > fuelPriceMaxDeviation
+		}
+	}
+
+	if len(fph.Points) > 0 {
+		fph.Avg = int32(math.Round(float64(sum) / float64(len(fph.Points))))
+	}
+}
+
+// ToRpcMessage gives the prices per volume unit of the user
+func (fph *FuelPriceHistory) ToRpcMessage(units Units) *pb.FuelPriceHistory {
+	perVolume := func(price int32) int32 {
+		return int32(math.Round(units.PerVolume(float64(price))))
+	}
+
+	points := make([]*pb.FuelPricePoint, 0, len(fph.Points))
+	for _, point := range fph.Points {
+		points = append(points, &pb.FuelPricePoint{
+			FuelId:     int32(point.FuelID),
+			Date:       timestamppb.New(point.Date),
+			Price:      perVolume(point.Price),
+			Deviation:  point.Deviation,
+			Suspicious: point.Suspicious,
+		})
+	}
+
+	return &pb.FuelPriceHistory{
+		Station: &pb.FillingStation{
+			Id:        int32(fph.Station.ID),
+			Name:      fph.Station.Name,
+			CreatedAt: timestamppb.New(fph.Station.CreatedAt),
+		},
+		Type: &pb.FuelType{
+			Id:   int32(fph.Type.ID),
+			Name: fph.Type.Name,
+		},
+		Currency: fph.CurrencyCode,
+		Points:   points,
+		MinPrice: perVolume(fph.Min),
+		AvgPrice: perVolume(fph.Avg),
+		MaxPrice: perVolume(fph.Max),
+	}
+}
+
+func median(values []int32) float64 {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (float64(values[mid-1]) + float64(values[mid])) / 2
+	}
+
+	return float64(values[mid])
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
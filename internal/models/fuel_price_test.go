@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFuelPriceHistory(t *testing.T) {
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	station := FillingStation{ID: 1, Name: "Lukoil"}
+	fuelType := FuelType{ID: 2, Name: "AI-95"}
+
+	prices := []*FuelPrice{
+		{FuelID: 1, Date: day, Station: station, Type: fuelType, CurrencyCode: "RUB", Cost: 250000, Value: 5000},
+		{FuelID: 2, Date: day.AddDate(0, 0, 10), Station: station, Type: fuelType, CurrencyCode: "RUB", Cost: 204000, Value: 4000},
+		{FuelID: 3, Date: day.AddDate(0, 0, 20), Station: station, Type: fuelType, CurrencyCode: "RUB", Cost: 250000, Value: 500},
+		{FuelID: 4, Date: day.AddDate(0, 0, 30), Station: station, Type: fuelType, CurrencyCode: "RUB", Cost: 153000, Value: 3000},
+		{FuelID: 5, Date: day, Station: station, Type: fuelType, CurrencyCode: "EUR", Cost: 9000, Value: 5000},
+	}
+
+	history := NewFuelPriceHistory(prices)
+	if len(history) != 2 {
+		t.Fatalf("got %d groups; want 2", len(history))
+	}
+
+	rub := history[0]
+	if rub.Min != 5000 || rub.Max != 50000 {
+		t.Errorf("got min %d, max %d; want 5000, 50000", rub.Min, rub.Max)
+	}
+
+	for _, point := range rub.Points {
+		if point.Suspicious != (point.FuelID == 3) {
+			t.Errorf("fuel %d: got suspicious %t (deviation %.2f)", point.FuelID, point.Suspicious, point.Deviation)
+		}
+	}
+
+	if eur := history[1]; eur.Points[0].Suspicious || eur.Avg != 180 {
+		t.Errorf("eur: got %+v", eur)
+	}
+}
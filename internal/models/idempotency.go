@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type IdempotencyKey struct {
+	ID          uint
+	UserID      uint
+	Key         string
+	Method      string
+	RequestHash []byte
+	Response    []byte
+	CreatedAt   time.Time
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+// dateFields are the Timestamp fields holding a calendar date of a record,
+// the rest (created_at, updated_at) are instants and are left as is
+var dateFields = map[protoreflect.Name]bool{
+	"date":       true,
+	"date_from":  true,
+	"date_to":    true,
+	"used_at":    true,
+	"start_date": true,
+	"end_date":   true,
+	"next_date":  true,
+}
+
+// ToUTCDates replaces the dates of a request by the midnight in UTC of their
+// day in the location of the user, the server formats the dates in UTC
+func ToUTCDates(msg proto.Message, loc *time.Location) {
+	walkDates(msg.ProtoReflect(), func(ts *timestamppb.Timestamp) {
+		year, month, day := ts.AsTime().In(loc).Date()
+		setTimestamp(ts, time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	})
+}
+
+// ToLocalDates replaces the dates of a response by the midnight of their day
+// in the location of the user. The dates from the database are the midnight
+// in the server location, the calculated ones are the midnight in UTC
+func ToLocalDates(msg proto.Message, server, loc *time.Location) {
+	walkDates(msg.ProtoReflect(), func(ts *timestamppb.Timestamp) {
+		t := ts.AsTime()
+		if local := t.In(server); local.Equal(dateOnlyIn(local, server)) {
+			t = local
+		}
+
+		year, month, day := t.Date()
+		setTimestamp(ts, time.Date(year, month, day, 0, 0, 0, 0, loc))
+	})
+}
+
+// sharedMessages are the same for all the users, their dates are left as is
+var sharedMessages = map[protoreflect.FullName]bool{
+	(&pb.ExchangeRate{}).ProtoReflect().Descriptor().FullName(): true,
+}
+
+func walkDates(msg protoreflect.Message, fn func(ts *timestamppb.Timestamp)) {
+	if sharedMessages[msg.Descriptor().FullName()] {
+		return
+	}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Message() == nil || fd.IsMap() {
+			return true
+		}
+
+		visit := func(item protoreflect.Message) {
+			if ts, ok := item.Interface().(*timestamppb.Timestamp); ok {
+				if dateFields[fd.Name()] {
+					fn(ts)
+				}
+			} else {
+				walkDates(item, fn)
+			}
+		}
+
+		if fd.IsList() {
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				visit(list.Get(i).Message())
+			}
+		} else {
+			visit(value.Message())
+		}
+
+		return true
+	})
+}
+
+func setTimestamp(ts *timestamppb.Timestamp, t time.Time) {
+	ts.Seconds = t.Unix()
+	ts.Nanos = int32(t.Nanosecond())
+}
+
+func dateOnlyIn(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+func TestLocalDates(t *testing.T) {
+	server := time.FixedZone("MSK", 3*3600)
+	user := time.FixedZone("VLAT", 10*3600)
+
+	// a late-evening fill-up in Vladivostok is still 2026-10-19 there
+	created := timestamppb.New(time.Date(2026, 10, 19, 23, 30, 0, 0, user))
+	request := &pb.Fuel{
+		Date:      timestamppb.New(time.Date(2026, 10, 19, 23, 30, 0, 0, user)),
+		CreatedAt: created,
+	}
+
+	ToUTCDates(request, user)
+	if got := request.GetDate().AsTime(); !got.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("got request date %s; want 2026-10-19 in UTC", got)
+	}
+	if request.GetCreatedAt() != created || !created.AsTime().Equal(time.Date(2026, 10, 19, 23, 30, 0, 0, user)) {
+		t.Errorf("got created_at %s; want it unchanged", request.GetCreatedAt().AsTime())
+	}
+
+	response := &pb.SyncPage{Changes: []*pb.SyncChange{
+		// from the database
+		{Record: &pb.SyncChange_Fuel{Fuel: &pb.Fuel{Date: timestamppb.New(time.Date(2026, 10, 19, 0, 0, 0, 0, server))}}},
+		// calculated by the server
+		{Record: &pb.SyncChange_Mileage{Mileage: &pb.Mileage{Date: timestamppb.New(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC))}}},
+	}}
+
+	ToLocalDates(response, server, user)
+
+	want := []time.Time{
+		time.Date(2026, 10, 19, 0, 0, 0, 0, user),
+		time.Date(2026, 10, 20, 0, 0, 0, 0, user),
+	}
+	got := []time.Time{
+		response.Changes[0].GetFuel().GetDate().AsTime(),
+		response.Changes[1].GetMileage().GetDate().AsTime(),
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("got response date %s; want %s", got[i].In(user), want[i])
+		}
+	}
+}
+
+func TestLocalDatesSharedMessages(t *testing.T) {
+	user := time.FixedZone("VLAT", 10*3600)
+	date := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	rates := &pb.ExchangeRateCollection{Rates: []*pb.ExchangeRate{{Date: timestamppb.New(date)}}}
+
+	ToLocalDates(rates, time.UTC, user)
+	if got := rates.Rates[0].GetDate().AsTime(); !got.Equal(date) {
+		t.Errorf("got rate date %s; want %s", got, date)
+	}
+}
@@ -15,6 +15,11 @@ type Mileage struct {
 	CreatedAt time.Time
 }
 
+// SameDay checks whether both readings are taken on one date
+func (m *Mileage) SameDay(other *Mileage) bool {
+	return dayNumber(m.Date) == dayNumber(other.Date)
+}
+
 func (m *Mileage) ToRpcMessage() *pb.Mileage {
 	message := &pb.Mileage{
 		Id:        int32(m.ID),
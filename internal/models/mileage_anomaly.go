@@ -0,0 +1,352 @@
+package models
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const (
+	// a car hardly drives more in a day
+	maxDailyDistance = 2000
+	// SET_DISTANCE fixes by the typos of one reading
+	maxTypoFixes = 3
+)
+
+type MileageFix struct {
+	Action pb.MileageFixAction
+	// for MILEAGE_FIX_SET_DISTANCE
+	Distance uint
+	// for MILEAGE_FIX_MERGE
+	Target *Mileage
+}
+
+type MileageAnomaly struct {
+	Mileage  *Mileage
+	Kind     pb.MileageAnomalyKind
+	Previous *Mileage
+	Next     *Mileage
+	Fixes    []MileageFix
+}
+
+func (a *MileageAnomaly) ToRpcMessage() *pb.MileageAnomaly {
+	message := &pb.MileageAnomaly{
+		Mileage: a.Mileage.ToRpcMessage(),
+		Kind:    a.Kind,
+		Fixes:   make([]*pb.MileageFix, 0, len(a.Fixes)),
+	}
+
+	if a.Previous != nil {
+		message.Previous = a.Previous.ToRpcMessage()
+	}
+	if a.Next != nil {
+		message.Next = a.Next.ToRpcMessage()
+	}
+
+	for _, fix := range a.Fixes {
+		item := &pb.MileageFix{
+			MileageId: int32(a.Mileage.ID),
+			Action:    fix.Action,
+			Distance:  int32(fix.Distance),
+		}
+		if fix.Target != nil {
+			item.TargetId = int32(fix.Target.ID)
+		}
+
+		message.Fixes = append(message.Fixes, item)
+	}
+
+	return message
+}
+
+// MileageHistory splits the readings of a car into the consistent ones
+// and the anomalies: the longest non-decreasing sequence of the readings
+// is consistent, a reading out of it breaks the growth. Then the readings
+// with an implausible daily distance and the lower readings of a date
+// are picked from the sequence
+type MileageHistory struct {
+	clean     []*Mileage
+	anomalies []*MileageAnomaly
+}
+
+// NewMileageHistory takes the readings ordered by date, distance and id
+func NewMileageHistory(readings []*Mileage) *MileageHistory {
+	kinds := make(map[int]pb.MileageAnomalyKind)
+
+	kept := growingReadings(readings)
+	inSequence := make(map[int]bool, len(kept))
+	for _, idx := range kept {
+		inSequence[idx] = true
+	}
+	for idx := range readings {
+		if !inSequence[idx] {
+			kinds[idx] = pb.MileageAnomalyKind_MILEAGE_NON_MONOTONIC
+		}
+	}
+
+	for j := 1; j < len(kept); j++ {
+		if plausible(readings[kept[j-1]], readings[kept[j]]) {
+			continue
+		}
+
+		// drop the reading whose removal joins its neighbours, the later one
+		// by default
+		drop := j
+		fixesLater := j+1 == len(kept) || plausible(readings[kept[j-1]], readings[kept[j+1]])
+		fixesEarlier := j == 1 || plausible(readings[kept[j-2]], readings[kept[j]])
+		if !fixesLater && fixesEarlier {
+			drop = j - 1
+		}
+
+		kinds[kept[drop]] = pb.MileageAnomalyKind_MILEAGE_IMPLAUSIBLE_DISTANCE
+		kept = append(kept[:drop], kept[drop+1:]...)
+		j = max(drop-1, 0)
+	}
+
+	h := &MileageHistory{clean: make([]*Mileage, 0, len(kept))}
+	for _, idx := range kept {
+		h.clean = append(h.clean, readings[idx])
+	}
+
+	// the highest reading of a date is kept as in Odometer
+	for i := 0; i+1 < len(kept); i++ {
+		if dayNumber(readings[kept[i]].Date) == dayNumber(readings[kept[i+1]].Date) {
+			kinds[kept[i]] = pb.MileageAnomalyKind_MILEAGE_DUPLICATE_DATE
+		}
+	}
+
+	odometer := NewOdometer(h.clean)
+	for idx, reading := range readings {
+		kind, ok := kinds[idx]
+		if !ok {
+			continue
+		}
+
+		anomaly := &MileageAnomaly{Mileage: reading, Kind: kind}
+		var sameDay *Mileage
+		anomaly.Previous, sameDay, anomaly.Next = h.neighbours(reading)
+
+		if kind != pb.MileageAnomalyKind_MILEAGE_DUPLICATE_DATE {
+			for _, distance := range h.distanceFixes(reading, odometer) {
+				anomaly.Fixes = append(anomaly.Fixes, MileageFix{
+					Action:   pb.MileageFixAction_MILEAGE_FIX_SET_DISTANCE,
+					Distance: distance,
+				})
+			}
+		}
+
+		if sameDay != nil {
+			anomaly.Fixes = append(anomaly.Fixes, MileageFix{
+				Action: pb.MileageFixAction_MILEAGE_FIX_MERGE,
+				Target: sameDay,
+			})
+		}
+
+		anomaly.Fixes = append(anomaly.Fixes, MileageFix{Action: pb.MileageFixAction_MILEAGE_FIX_DELETE})
+
+		h.anomalies = append(h.anomalies, anomaly)
+	}
+
+	return h
+}
+
+func (h *MileageHistory) Anomalies() []*MileageAnomaly {
+	return h.anomalies
+}
+
+// Fits checks the distance of the reading against the consistent readings
+// of the other dates
+func (h *MileageHistory) Fits(reading *Mileage, distance uint) bool {
+	day := dayNumber(reading.Date)
+	for _, item := range h.clean {
+		if item.ID == reading.ID {
+			continue
+		}
+
+		itemDay := dayNumber(item.Date)
+		if itemDay < day && item.Distance > distance || itemDay > day && item.Distance < distance {
+			return false
+		}
+	}
+
+	return true
+}
+
+// neighbours returns the consistent readings before, on and after
+// the date of the reading, the highest of the date
+func (h *MileageHistory) neighbours(reading *Mileage) (previous, sameDay, next *Mileage) {
+	day := dayNumber(reading.Date)
+	for _, item := range h.clean {
+		if item.ID == reading.ID {
+			continue
+		}
+
+		switch itemDay := dayNumber(item.Date); {
+		case itemDay < day:
+			previous = item
+		case itemDay == day:
+			sameDay = item
+		case next == nil:
+			next = item
+		}
+	}
+
+	return previous, sameDay, next
+}
+
+// distanceFixes returns the typo fixes of the distance which fit between
+// the neighbours, a repeated digit typed once or twice first, and the
+// estimate by the consistent readings
+func (h *MileageHistory) distanceFixes(reading *Mileage, odometer *Odometer) []uint {
+	previous, _, next := h.neighbours(reading)
+	fits := func(distance uint) bool {
+		candidate := &Mileage{Date: reading.Date, Distance: distance}
+		if previous != nil && (distance < previous.Distance || !plausible(previous, candidate)) {
+			return false
+		}
+		if next != nil && (distance > next.Distance || !plausible(candidate, next)) {
+			return false
+		}
+
+		return true
+	}
+
+	estimate, _ := odometer.Estimate(reading.Date)
+
+	type candidate struct {
+		distance uint
+		repeated bool
+	}
+
+	seen := map[uint]bool{reading.Distance: true}
+	candidates := make([]candidate, 0)
+	for distance, repeated := range typoFixes(reading.Distance) {
+		if distance > 0 && !seen[distance] && fits(distance) {
+			seen[distance] = true
+			candidates = append(candidates, candidate{distance: distance, repeated: repeated})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].repeated != candidates[j].repeated {
+			return candidates[i].repeated
+		}
+
+		di, dj := absDiff(candidates[i].distance, estimate), absDiff(candidates[j].distance, estimate)
+		if di != dj {
+			return di < dj
+		}
+
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	result := make([]uint, 0, maxTypoFixes+1)
+	for _, item := range candidates[:min(len(candidates), maxTypoFixes)] {
+		result = append(result, item.distance)
+	}
+
+	if odometer.HasReadings() && estimate != reading.Distance && !slices.Contains(result, estimate) && fits(estimate) {
+		result = append(result, estimate)
+	}
+
+	return result
+}
+
+// typoFixes returns the distances differing by one digit added or removed
+// or two adjacent digits swapped, true for a repeated digit added or removed
+func typoFixes(distance uint) map[uint]bool {
+	digits := strconv.FormatUint(uint64(distance), 10)
+	result := make(map[uint]bool)
+	add := func(value string, repeated bool) {
+		parsed, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || value[0] == '0' {
+			return
+		}
+
+		result[uint(parsed)] = result[uint(parsed)] || repeated
+	}
+
+	for i := 0; i <= len(digits); i++ {
+		for d := byte('0'); d <= '9'; d++ {
+			repeated := i > 0 && digits[i-1] == d || i < len(digits) && digits[i] == d
+			add(digits[:i]+string(d)+digits[i:], repeated)
+		}
+	}
+
+	for i := 0; i < len(digits) && len(digits) > 1; i++ {
+		repeated := i > 0 && digits[i-1] == digits[i] || i+1 < len(digits) && digits[i+1] == digits[i]
+		add(digits[:i]+digits[i+1:], repeated)
+	}
+
+	for i := 0; i+1 < len(digits); i++ {
+		swapped := []byte(digits)
+		swapped[i], swapped[i+1] = swapped[i+1], swapped[i]
+		add(string(swapped), false)
+	}
+
+	return result
+}
+
+// growingReadings returns the indexes of the longest non-decreasing sequence
+// of the distances, of the equal ones the one with the least excess over
+// the plausible daily distance
+func growingReadings(readings []*Mileage) []int {
+	if len(readings) == 0 {
+		return nil
+	}
+
+	length := make([]int, len(readings))
+	excess := make([]uint, len(readings))
+	prev := make([]int, len(readings))
+
+	best := 0
+	for i := range readings {
+		length[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if readings[j].Distance > readings[i].Distance {
+				continue
+			}
+
+			e := excess[j] + dailyExcess(readings[j], readings[i])
+			if length[j]+1 > length[i] || length[j]+1 == length[i] && e < excess[i] {
+				length[i], excess[i], prev[i] = length[j]+1, e, j
+			}
+		}
+
+		if length[i] > length[best] || length[i] == length[best] && excess[i] < excess[best] {
+			best = i
+		}
+	}
+
+	result := make([]int, length[best])
+	for i, idx := len(result)-1, best; idx >= 0; i, idx = i-1, prev[idx] {
+		result[i] = idx
+	}
+
+	return result
+}
+
+func plausible(from, to *Mileage) bool {
+	return dailyExcess(from, to) == 0
+}
+
+// dailyExcess returns the km over the plausible daily distance between
+// two readings, a day at least
+func dailyExcess(from, to *Mileage) uint {
+	days := uint(max(dayNumber(to.Date)-dayNumber(from.Date), 1))
+	if to.Distance <= from.Distance || to.Distance-from.Distance <= maxDailyDistance*days {
+		return 0
+	}
+
+	return to.Distance - from.Distance - maxDailyDistance*days
+}
+
+func absDiff(a, b uint) uint {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+func TestMileageHistory(t *testing.T) {
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	id := uint(0)
+	reading := func(days int, distance uint) *Mileage {
+		id++
+		return &Mileage{ID: id, Date: day.AddDate(0, 0, days), Distance: distance}
+	}
+
+	readings := []*Mileage{
+		reading(0, 120000),
+		reading(10, 122000),
+		// a typo of 125000
+		reading(20, 12500),
+		reading(30, 126500),
+		reading(30, 127000),
+		reading(40, 129000),
+		reading(41, 135000),
+	}
+
+	history := NewMileageHistory(readings)
+	anomalies := history.Anomalies()
+
+	expected := []struct {
+		id   uint
+		kind pb.MileageAnomalyKind
+		fix  MileageFix
+	}{
+		{3, pb.MileageAnomalyKind_MILEAGE_NON_MONOTONIC, MileageFix{Action: pb.MileageFixAction_MILEAGE_FIX_SET_DISTANCE, Distance: 125000}},
+		{4, pb.MileageAnomalyKind_MILEAGE_DUPLICATE_DATE, MileageFix{Action: pb.MileageFixAction_MILEAGE_FIX_MERGE, Target: readings[4]}},
+		{7, pb.MileageAnomalyKind_MILEAGE_IMPLAUSIBLE_DISTANCE, MileageFix{Action: pb.MileageFixAction_MILEAGE_FIX_SET_DISTANCE, Distance: 130500}},
+	}
+
+	if len(anomalies) != len(expected) {
+		t.Fatalf("got %d anomalies; want %d", len(anomalies), len(expected))
+	}
+
+	for i, want := range expected {
+		got := anomalies[i]
+		if got.Mileage.ID != want.id || got.Kind != want.kind {
+			t.Errorf("anomaly %d: got mileage %d (%s); want %d (%s)", i, got.Mileage.ID, got.Kind, want.id, want.kind)
+			continue
+		}
+
+		if fix := got.Fixes[0]; fix != want.fix {
+			t.Errorf("anomaly %d: got fix %v; want %v", i, fix, want.fix)
+		}
+
+		if last := got.Fixes[len(got.Fixes)-1]; last.Action != pb.MileageFixAction_MILEAGE_FIX_DELETE {
+			t.Errorf("anomaly %d: got last fix %s; want MILEAGE_FIX_DELETE", i, last.Action)
+		}
+	}
+
+	if !history.Fits(readings[2], 125000) {
+		t.Error("125000 does not fit on day 20")
+	}
+	if history.Fits(readings[2], 130000) {
+		t.Error("130000 fits on day 20")
+	}
+
+	// a typo in the first reading
+	id = 0
+	first := NewMileageHistory([]*Mileage{
+		reading(0, 10000),
+		reading(5, 100500),
+		reading(10, 101000),
+	}).Anomalies()
+	if len(first) != 1 || first[0].Mileage.ID != 1 || first[0].Kind != pb.MileageAnomalyKind_MILEAGE_IMPLAUSIBLE_DISTANCE {
+		t.Errorf("got %d anomalies; want the first reading with an implausible distance", len(first))
+	}
+
+	if len(NewMileageHistory(nil).Anomalies()) != 0 {
+		t.Error("got anomalies without readings")
+	}
+}
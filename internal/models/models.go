@@ -4,3 +4,4 @@ import "errors"
 
 var RecordNotFound = errors.New("models: no matching record found")
 var InvalidMileage = errors.New("models: invalid distance")
+var StaleRecord = errors.New("models: record was modified by another client")
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const (
+	daysPerYear = 365.25
+	// readings of the last year before the latest one make the trend
+	trendPeriodDays = 365
+)
+
+type odometerPoint struct {
+	day      float64
+	distance float64
+}
+
+// Odometer estimates the readings of a car from its mileage history:
+// linear interpolation between two readings and a robust trend
+// (Theil–Sen slope) beyond them
+type Odometer struct {
+	points []odometerPoint
+	// km per day
+	slope float64
+}
+
+func NewOdometer(readings []*Mileage) *Odometer {
+	// the highest reading of a day
+	byDay := make(map[int64]uint, len(readings))
+	for _, reading := range readings {
+		day := dayNumber(reading.Date)
+		byDay[day] = max(byDay[day], reading.Distance)
+	}
+
+	points := make([]odometerPoint, 0, len(byDay))
+	for day, distance := range byDay {
+		points = append(points, odometerPoint{day: float64(day), distance: float64(distance)})
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].day < points[j].day
+	})
+
+	odometer := &Odometer{points: points}
+	odometer.slope = odometer.trend()
+
+	return odometer
+}
+
+func (o *Odometer) HasReadings() bool {
+	return len(o.points) > 0
+}
+
+// Estimate returns the reading on the date, 0 without the readings
+func (o *Odometer) Estimate(date time.Time) (uint, pb.OdometerMethod) {
+	if len(o.points) == 0 {
+		return 0, pb.OdometerMethod_ODOMETER_UNKNOWN
+	}
+
+	day := float64(dayNumber(date))
+	idx := sort.Search(len(o.points), func(i int) bool {
+		return o.points[i].day >= day
+	})
+
+	if idx < len(o.points) && o.points[idx].day == day {
+		return uint(o.points[idx].distance), pb.OdometerMethod_ODOMETER_EXACT
+	}
+
+	var distance float64
+	switch idx {
+	case 0:
+		first := o.points[0]
+		distance = max(first.distance-o.slope*(first.day-day), 0)
+	case len(o.points):
+		last := o.points[len(o.points)-1]
+		distance = last.distance + o.slope*(day-last.day)
+	default:
+		prev, next := o.points[idx-1], o.points[idx]
+		distance = prev.distance + (next.distance-prev.distance)*(day-prev.day)/(next.day-prev.day)
+
+		return uint(math.Round(distance)), pb.OdometerMethod_ODOMETER_INTERPOLATED
+	}
+
+	return uint(math.Round(distance)), pb.OdometerMethod_ODOMETER_EXTRAPOLATED
+}
+
+// AnnualDistance returns the expected km per year by the trend
+func (o *Odometer) AnnualDistance() uint {
+	return uint(math.Round(o.slope * daysPerYear))
+}
+
+// trend is the median of the slopes between every two readings of the
+// last year, so a single typo does not skew it. A decreasing history
+// gives no trend
+func (o *Odometer) trend() float64 {
+	if len(o.points) < 2 {
+		return 0
+	}
+
+	from := o.points[len(o.points)-1].day - trendPeriodDays
+	points := o.points
+	for len(points) > 2 && points[0].day < from {
+		points = points[1:]
+	}
+
+	slopes := make([]float64, 0, len(points)*(len(points)-1)/2)
+	for i := 0; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			slopes = append(slopes, (points[j].distance-points[i].distance)/(points[j].day-points[i].day))
+		}
+	}
+
+	sort.Float64s(slopes)
+
+	var median float64
+	if n := len(slopes); n%2 == 1 {
+		median = slopes[n/2]
+	} else {
+		median = (slopes[n/2-1] + slopes[n/2]) / 2
+	}
+
+	return max(median, 0)
+}
+
+func dayNumber(t time.Time) int64 {
+	return dateOnly(t).Unix() / 86400
+}
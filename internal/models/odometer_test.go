@@ -0,0 +1,60 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+func TestOdometer(t *testing.T) {
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reading := func(days int, distance uint) *Mileage {
+		return &Mileage{Date: day.AddDate(0, 0, days), Distance: distance}
+	}
+
+	odometer := NewOdometer([]*Mileage{
+		reading(0, 100000),
+		reading(100, 103000),
+		// a typo does not change the median slope
+		reading(150, 10450),
+		reading(200, 106000),
+		reading(200, 105900),
+		reading(300, 109000),
+	})
+
+	cases := []struct {
+		date     time.Time
+		distance uint
+		method   pb.OdometerMethod
+	}{
+		{day.AddDate(0, 0, 100), 103000, pb.OdometerMethod_ODOMETER_EXACT},
+		{day.AddDate(0, 0, 200), 106000, pb.OdometerMethod_ODOMETER_EXACT},
+		{day.AddDate(0, 0, 50), 101500, pb.OdometerMethod_ODOMETER_INTERPOLATED},
+		{day.AddDate(0, 0, 400), 112000, pb.OdometerMethod_ODOMETER_EXTRAPOLATED},
+		{day.AddDate(0, 0, -100), 97000, pb.OdometerMethod_ODOMETER_EXTRAPOLATED},
+	}
+
+	for _, c := range cases {
+		distance, method := odometer.Estimate(c.date)
+		if distance != c.distance || method != c.method {
+			t.Errorf("%s: got %d (%s); want %d (%s)", c.date.Format(time.DateOnly), distance, method, c.distance, c.method)
+		}
+	}
+
+	if annual := odometer.AnnualDistance(); annual != 10958 {
+		t.Errorf("got annual distance %d; want 10958", annual)
+	}
+
+	single := NewOdometer([]*Mileage{reading(0, 5000)})
+	if distance, method := single.Estimate(day.AddDate(0, 0, 30)); distance != 5000 || method != pb.OdometerMethod_ODOMETER_EXTRAPOLATED {
+		t.Errorf("got %d (%s) by one reading; want 5000", distance, method)
+	}
+	if single.AnnualDistance() != 0 {
+		t.Errorf("got annual distance %d by one reading; want 0", single.AnnualDistance())
+	}
+
+	if _, method := NewOdometer(nil).Estimate(day); method != pb.OdometerMethod_ODOMETER_UNKNOWN {
+		t.Errorf("got %s without readings; want ODOMETER_UNKNOWN", method)
+	}
+}
@@ -24,6 +24,7 @@ type Order struct {
 	Mileage     *Mileage
 	Car         *Car
 	Type        *OrderType
+	Version     uint
 	CreatedAt   time.Time
 }
 
@@ -36,6 +37,7 @@ func (o *Order) ToRpcMessage() *pb.Order {
 		},
 		Description: o.Description,
 		Date:        timestamppb.New(o.Date),
+		Version:     int32(o.Version),
 		CreatedAt:   timestamppb.New(o.CreatedAt),
 	}
 
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"math"
+
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type orderGroupKey struct {
+	carID  uint
+	typeID uint
+}
+
+func newOrderGroupKey(o *Order) orderGroupKey {
+	var key orderGroupKey
+	if o.Car != nil {
+		key.carID = o.Car.ID
+	}
+	if o.Type != nil {
+		key.typeID = o.Type.ID
+	}
+
+	return key
+}
+
+type OrderInventoryGroup struct {
+	Car    *Car
+	Type   *OrderType
+	Orders []*Order
+}
+
+// NewOrderInventory groups the unused orders by car and type keeping
+// the order of the list
+func NewOrderInventory(orders []*Order) []*OrderInventoryGroup {
+	groups := make(map[orderGroupKey]*OrderInventoryGroup)
+	items := make([]*OrderInventoryGroup, 0)
+
+	for _, order := range orders {
+		key := newOrderGroupKey(order)
+		group, found := groups[key]
+		if !found {
+			group = &OrderInventoryGroup{
+				Car:  order.Car,
+				Type: order.Type,
+			}
+			groups[key] = group
+			items = append(items, group)
+		}
+
+		group.Orders = append(group.Orders, order)
+	}
+
+	return items
+}
+
+func (oig *OrderInventoryGroup) ToRpcMessage() *pb.OrderInventoryGroup {
+	message := &pb.OrderInventoryGroup{
+		Orders: make([]*pb.Order, 0, len(oig.Orders)),
+	}
+
+	if len(oig.Orders) > 0 {
+		first := oig.Orders[0].ToRpcMessage()
+		message.Car = first.Car
+		message.Type = first.Type
+	}
+
+	for _, order := range oig.Orders {
+		message.Orders = append(message.Orders, order.ToRpcMessage())
+	}
+
+	return message
+}
+
+type OrderServiceLife struct {
+	Car          *Car
+	Type         *OrderType
+	Installs     int
+	LastDistance int32
+	LastDays     int32
+	AvgDistance  int32
+	AvgDays      int32
+}
+
+// NewOrderServiceLife measures the intervals between consecutive installs
+// of the same order type on the same car, the orders must be sorted by
+// the install date. Orders without a car, a type or an install date are skipped
+func NewOrderServiceLife(orders []*Order) []*OrderServiceLife {
+	type accumulator struct {
+		life      *OrderServiceLife
+		previous  *Order
+		distances []int32
+		days      []int32
+	}
+
+	groups := make(map[orderGroupKey]*accumulator)
+	keys := make([]orderGroupKey, 0)
+
+	for _, order := range orders {
+		if order.Car == nil || order.Type == nil || !order.UsedAt.Valid {
+			continue
+		}
+
+		key := newOrderGroupKey(order)
+		acc, found := groups[key]
+		if !found {
+			acc = &accumulator{
+				life: &OrderServiceLife{
+					Car:  order.Car,
+					Type: order.Type,
+				},
+			}
+			groups[key] = acc
+			keys = append(keys, key)
+		}
+
+		acc.life.Installs++
+		if acc.previous != nil {
+			days := int32(order.UsedAt.Time.Sub(acc.previous.UsedAt.Time).Hours() / 24)
+			acc.days = append(acc.days, days)
+			acc.life.LastDays = days
+
+			acc.life.LastDistance = 0
+			if order.Distance.Valid && acc.previous.Distance.Valid {
+				distance := order.Distance.Int32 - acc.previous.Distance.Int32
+				acc.distances = append(acc.distances, distance)
+				acc.life.LastDistance = distance
+			}
+		}
+		acc.previous = order
+	}
+
+	items := make([]*OrderServiceLife, 0, len(keys))
+	for _, key := range keys {
+		acc := groups[key]
+		acc.life.AvgDistance = average(acc.distances)
+		acc.life.AvgDays = average(acc.days)
+
+		items = append(items, acc.life)
+	}
+
+	return items
+}
+
+func (osl *OrderServiceLife) ToRpcMessage() *pb.OrderServiceLife {
+	return &pb.OrderServiceLife{
+		Car: &pb.Car{
+			Id:   int32(osl.Car.ID),
+			Name: osl.Car.Brand + " " + osl.Car.Model,
+		},
+		Type: &pb.OrderType{
+			Id:   int32(osl.Type.ID),
+			Name: osl.Type.Name,
+		},
+		Installs:     int32(osl.Installs),
+		LastDistance: osl.LastDistance,
+		LastDays:     osl.LastDays,
+		AvgDistance:  osl.AvgDistance,
+		AvgDays:      osl.AvgDays,
+	}
+}
+
+func average(values []int32) int32 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var sum int64
+	for _, value := range values {
+		sum += int64(value)
+	}
+
+	return int32(math.Round(float64(sum) / float64(len(values))))
+}
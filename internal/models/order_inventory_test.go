@@ -0,0 +1,49 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestOrderServiceLife(t *testing.T) {
+	car := &Car{ID: 1}
+	pads := &OrderType{ID: 3, Name: "Brake pads"}
+	day := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	installed := func(id uint, date time.Time, distance int32) *Order {
+		order := &Order{
+			ID:     id,
+			Car:    car,
+			Type:   pads,
+			UsedAt: sql.NullTime{Time: date, Valid: true},
+		}
+		if distance > 0 {
+			order.Distance = sql.NullInt32{Int32: distance, Valid: true}
+		}
+
+		return order
+	}
+
+	items := NewOrderServiceLife([]*Order{
+		installed(1, day, 10000),
+		installed(2, day.AddDate(0, 0, 300), 40000),
+		{ID: 3, Car: car, Type: pads},
+		installed(4, day.AddDate(0, 0, 500), 60000),
+	})
+
+	if len(items) != 1 {
+		t.Fatalf("got %d groups; want 1", len(items))
+	}
+
+	life := items[0]
+	if life.Installs != 3 {
+		t.Errorf("installs: got %d; want 3", life.Installs)
+	}
+	if life.LastDistance != 20000 || life.LastDays != 200 {
+		t.Errorf("last: got %d km, %d days; want 20000 km, 200 days", life.LastDistance, life.LastDays)
+	}
+	if life.AvgDistance != 25000 || life.AvgDays != 250 {
+		t.Errorf("avg: got %d km, %d days; want 25000 km, 250 days", life.AvgDistance, life.AvgDays)
+	}
+}
@@ -0,0 +1,9 @@
+package models
+
+// ListPage describes a fetched page of a list
+type ListPage struct {
+	// total count of the items, -1 when it is not counted
+	Count      int
+	NextCursor string
+	HasMore    bool
+}
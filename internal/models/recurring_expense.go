@@ -0,0 +1,150 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type RecurringExpense struct {
+	ID          uint
+	UserID      uint
+	Cost        Cost
+	Description string
+	Car         *Car
+	Category    *ExpenseCategory
+	Frequency   pb.RecurrenceFrequency
+	Interval    int
+	StartDate   time.Time
+	EndDate     sql.NullTime
+	// number of the expenses created from the template
+	Occurrences int
+	CreatedAt   time.Time
+}
+
+// Occurrence returns the date of the n-th expense counting from zero.
+// Dates are calculated from the start date, so the 31st falls on the last
+// day of short months without shifting the following dates
+func (re *RecurringExpense) Occurrence(n int) time.Time {
+	months := n * re.interval()
+	if re.Frequency == pb.RecurrenceFrequency_RECURRENCE_YEARLY {
+		months *= 12
+	}
+
+	year, month, day := re.StartDate.Date()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+// NextDate returns the date of the next expense to create, false after
+// the end date
+func (re *RecurringExpense) NextDate() (time.Time, bool) {
+	date := re.Occurrence(re.Occurrences)
+	if re.EndDate.Valid && date.After(dateOnly(re.EndDate.Time)) {
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+// DueDates returns the dates of the expenses not created yet up to
+// the day inclusive
+func (re *RecurringExpense) DueDates(day time.Time) []time.Time {
+	day = dateOnly(day)
+
+	dates := make([]time.Time, 0)
+	for n := re.Occurrences; ; n++ {
+		date := re.Occurrence(n)
+		if date.After(day) || (re.EndDate.Valid && date.After(dateOnly(re.EndDate.Time))) {
+			break
+		}
+
+		dates = append(dates, date)
+	}
+
+	return dates
+}
+
+// SkipBefore marks the occurrences before the day as created, so a new or
+// rescheduled template does not fill the past. The occurrences on the dates
+// of the expenses created from the template before are skipped as well
+func (re *RecurringExpense) SkipBefore(day time.Time, created []time.Time) {
+	day = dateOnly(day)
+	exists := DateSet(created)
+	re.Occurrences = 0
+	for {
+		date := re.Occurrence(re.Occurrences)
+		if !date.Before(day) && !exists[date.Format(time.DateOnly)] {
+			break
+		}
+
+		re.Occurrences++
+	}
+}
+
+// DateSet indexes the dates by their day
+func DateSet(dates []time.Time) map[string]bool {
+	result := make(map[string]bool, len(dates))
+	for _, date := range dates {
+		result[date.Format(time.DateOnly)] = true
+	}
+
+	return result
+}
+
+func (re *RecurringExpense) ToRpcMessage() *pb.RecurringExpense {
+	message := &pb.RecurringExpense{
+		Id: int32(re.ID),
+		Cost: &pb.Cost{
+			Value:    re.Cost.Value,
+			Currency: re.Cost.CurrencyCode,
+		},
+		Description: re.Description,
+		Frequency:   re.Frequency,
+		Interval:    int32(re.interval()),
+		StartDate:   timestamppb.New(re.StartDate),
+		CreatedAt:   timestamppb.New(re.CreatedAt),
+	}
+
+	if re.Car != nil {
+		message.Car = &pb.Car{
+			Id:   int32(re.Car.ID),
+			Name: re.Car.Brand + " " + re.Car.Model,
+		}
+	}
+
+	if re.Category != nil {
+		message.Category = re.Category.ToRpcMessage()
+	}
+
+	if re.EndDate.Valid {
+		message.EndDate = timestamppb.New(re.EndDate.Time)
+	}
+
+	if next, ok := re.NextDate(); ok {
+		message.NextDate = timestamppb.New(next)
+	}
+
+	return message
+}
+
+func (re *RecurringExpense) interval() int {
+	if re.Interval < 1 {
+		return 1
+	}
+
+	return re.Interval
+}
+
+func dateOnly(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
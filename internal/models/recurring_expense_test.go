@@ -0,0 +1,98 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+func TestRecurringExpenseDueDates(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		obj  RecurringExpense
+		day  time.Time
+		want []time.Time
+	}{
+		{
+			name: "month end",
+			obj: RecurringExpense{
+				Frequency: pb.RecurrenceFrequency_RECURRENCE_MONTHLY,
+				StartDate: date(2024, 1, 31),
+			},
+			day:  date(2024, 4, 15),
+			want: []time.Time{date(2024, 1, 31), date(2024, 2, 29), date(2024, 3, 31)},
+		},
+		{
+			name: "every 3 months till the end date",
+			obj: RecurringExpense{
+				Frequency:   pb.RecurrenceFrequency_RECURRENCE_MONTHLY,
+				Interval:    3,
+				StartDate:   date(2024, 1, 10),
+				EndDate:     sql.NullTime{Time: date(2024, 10, 1), Valid: true},
+				Occurrences: 1,
+			},
+			day:  date(2025, 1, 1),
+			want: []time.Time{date(2024, 4, 10), date(2024, 7, 10)},
+		},
+		{
+			name: "yearly",
+			obj: RecurringExpense{
+				Frequency: pb.RecurrenceFrequency_RECURRENCE_YEARLY,
+				StartDate: date(2023, 5, 20),
+			},
+			day:  date(2024, 5, 20),
+			want: []time.Time{date(2023, 5, 20), date(2024, 5, 20)},
+		},
+	}
+
+	for _, item := range tests {
+		t.Run(item.name, func(t *testing.T) {
+			got := item.obj.DueDates(item.day)
+			if len(got) != len(item.want) {
+				t.Fatalf("got %v; want %v", got, item.want)
+			}
+
+			for i := range got {
+				if !got[i].Equal(item.want[i]) {
+					t.Errorf("%d: got %s; want %s", i, got[i], item.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRecurringExpenseSkipBefore(t *testing.T) {
+	obj := RecurringExpense{
+		Frequency: pb.RecurrenceFrequency_RECURRENCE_MONTHLY,
+		StartDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	obj.SkipBefore(time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC), nil)
+	if obj.Occurrences != 2 {
+		t.Errorf("got %d occurrences; want 2", obj.Occurrences)
+	}
+}
+
+func TestRecurringExpenseSkipBeforeCreated(t *testing.T) {
+	obj := RecurringExpense{
+		Frequency: pb.RecurrenceFrequency_RECURRENCE_MONTHLY,
+		StartDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	created := []time.Time{
+		time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	obj.SkipBefore(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), created)
+	if obj.Occurrences != 4 {
+		t.Errorf("got %d occurrences; want 4", obj.Occurrences)
+	}
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/doug-martin/goqu/v9"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type AttachmentRepository struct {
+	DB *database.DB
+}
+
+func (ar *AttachmentRepository) GetAttachments(entity pb.SyncEntity, recordID uint) ([]*models.Attachment, error) {
+	ds := attachmentQueryExpression().Where(goqu.Ex{
+		"a.entity":    int(entity),
+		"a.record_id": recordID,
+	}).Order(goqu.I("a.id").Asc())
+
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := ar.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.Attachment, 0)
+
+	for rows.Next() {
+		obj := models.Attachment{}
+		err = rows.Scan(
+			&obj.ID,
+			&obj.UserID,
+			&obj.Entity,
+			&obj.RecordID,
+			&obj.Name,
+			&obj.MimeType,
+			&obj.Size,
+			&obj.Path,
+			&obj.ThumbnailPath,
+			&obj.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, nil
+}
+
+func (ar *AttachmentRepository) Find(id uint) (*models.Attachment, error) {
+	ds := attachmentQueryExpression().Where(goqu.Ex{"a.id": id})
+	query, params, _ := ds.Prepared(true).ToSQL()
+
+	obj := models.Attachment{}
+	err := ar.DB.QueryRow(query, params...).Scan(
+		&obj.ID,
+		&obj.UserID,
+		&obj.Entity,
+		&obj.RecordID,
+		&obj.Name,
+		&obj.MimeType,
+		&obj.Size,
+		&obj.Path,
+		&obj.ThumbnailPath,
+		&obj.CreatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.RecordNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &obj, nil
+}
+
+func (ar *AttachmentRepository) SaveAttachment(obj *models.Attachment) (uint, error) {
+	data := goqu.Record{
+		"user_id":   obj.UserID,
+		"entity":    int(obj.Entity),
+		"record_id": obj.RecordID,
+		"name":      obj.Name,
+		"mime_type": obj.MimeType,
+		"size":      obj.Size,
+		"path":      obj.Path,
+	}
+
+	if obj.ThumbnailPath.Valid {
+		data["thumbnail_path"] = obj.ThumbnailPath.String
+	} else {
+		data["thumbnail_path"] = nil
+	}
+
+	query, _, err := goqu.Dialect("mysql8").Insert("attachments").Rows(data).ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := ar.DB.Exec(query)
+	if err != nil {
+		return 0, err
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint(lastID), nil
+}
+
+func (ar *AttachmentRepository) Delete(id uint) error {
+	_, err := ar.DB.Exec("DELETE FROM attachments WHERE id = ?", id)
+
+	return err
+}
+
+func attachmentQueryExpression() *goqu.SelectDataset {
+	return goqu.Dialect("mysql8").From(goqu.T("attachments").As("a")).Select(
+		"a.id",
+		"a.user_id",
+		"a.entity",
+		"a.record_id",
+		"a.name",
+		"a.mime_type",
+		"a.size",
+		"a.path",
+		"a.thumbnail_path",
+		"a.created_at",
+	)
+}
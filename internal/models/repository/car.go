@@ -68,6 +68,25 @@ func (cr *CarRepository) Find(id uint) (*models.Car, error) {
 		FROM cars AS c
 		WHERE c.id = ?`
 
+	return cr.findOne(query, id)
+}
+
+// FindForUpdate locks the car until the end of the transaction,
+// so concurrent requests do not open two trips of it
+func (cr *CarRepository) FindForUpdate(id uint) (*models.Car, error) {
+	query := `
+		SELECT
+			c.id,
+			c.user_id,
+			c.created_at
+		FROM cars AS c
+		WHERE c.id = ?
+		FOR UPDATE`
+
+	return cr.findOne(query, id)
+}
+
+func (cr *CarRepository) findOne(query string, id uint) (*models.Car, error) {
 	obj := models.Car{}
 
 	err := cr.DB.QueryRow(query, id).Scan(
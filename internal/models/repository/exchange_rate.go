@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+)
+
+type ExchangeRateRepository struct {
+	DB *database.DB
+}
+
+// SaveRate inserts the rate or replaces the one stored for the same date
+func (xr *ExchangeRateRepository) SaveRate(obj *models.ExchangeRate) error {
+	query := `
+		INSERT INTO exchange_rates (currency_id, base_currency_id, date, rate)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE rate = VALUES(rate)`
+
+	_, err := xr.DB.Exec(
+		query,
+		obj.CurrencyID,
+		obj.BaseCurrencyID,
+		obj.Date.Format(time.DateOnly),
+		strconv.FormatFloat(obj.Rate, 'f', 10, 64))
+
+	return err
+}
+
+// FindRate returns the latest rate of the currency in the target currency on
+// or before the date: the direct rate, the inverted one, or a cross rate
+// through a common base currency published on the same date
+func (xr *ExchangeRateRepository) FindRate(code, targetCode string, date time.Time) (float64, error) {
+	query := `
+		SELECT rates.rate
+		FROM (
+			SELECT r.rate, r.date
+			FROM exchange_rates AS r
+			INNER JOIN currencies AS c ON c.id = r.currency_id
+			INNER JOIN currencies AS b ON b.id = r.base_currency_id
+			WHERE c.code = ? AND b.code = ? AND r.date <= ?
+			UNION ALL
+			SELECT 1 / r.rate, r.date
+			FROM exchange_rates AS r
+			INNER JOIN currencies AS c ON c.id = r.currency_id
+			INNER JOIN currencies AS b ON b.id = r.base_currency_id
+			WHERE c.code = ? AND b.code = ? AND r.date <= ?
+			UNION ALL
+			SELECT r.rate / t.rate, r.date
+			FROM exchange_rates AS r
+			INNER JOIN exchange_rates AS t ON (t.base_currency_id = r.base_currency_id AND t.date = r.date)
+			INNER JOIN currencies AS c ON c.id = r.currency_id
+			INNER JOIN currencies AS tc ON tc.id = t.currency_id
+			WHERE c.code = ? AND tc.code = ? AND r.date <= ?
+		) AS rates
+		ORDER BY rates.date DESC
+		LIMIT 1`
+
+	day := date.Format(time.DateOnly)
+
+	var rate float64
+	err := xr.DB.QueryRow(query, code, targetCode, day, targetCode, code, day, code, targetCode, day).Scan(&rate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, models.RecordNotFound
+		} else {
+			return 0, err
+		}
+	}
+
+	return rate, nil
+}
+
+// GetRates returns the rates of the currencies in the target currency as
+// FindRate does, for the dates from the latest rate on or before the first
+// date up to the last one. Of the rates of one date the direct rate is kept,
+// then the inverted one, then a cross rate
+func (xr *ExchangeRateRepository) GetRates(codes []string, targetCode string, from, to time.Time) (models.RateHistory, error) {
+	history := make(models.RateHistory, len(codes))
+	if len(codes) == 0 {
+		return history, nil
+	}
+
+	in := strings.TrimSuffix(strings.Repeat("?, ", len(codes)), ", ")
+	query := `
+		SELECT rates.code, rates.date, rates.rate
+		FROM (
+			SELECT u.code, u.date, u.rate, u.kind,
+				MAX(CASE WHEN u.date <= ? THEN u.date END) OVER (PARTITION BY u.code) AS start_date
+			FROM (
+				SELECT c.code, r.date, r.rate, 0 AS kind
+				FROM exchange_rates AS r
+				INNER JOIN currencies AS c ON c.id = r.currency_id
+				INNER JOIN currencies AS b ON b.id = r.base_currency_id
+				WHERE c.code IN (` + in + `) AND b.code = ? AND r.date <= ?
+				UNION ALL
+				SELECT b.code, r.date, 1 / r.rate, 1 AS kind
+				FROM exchange_rates AS r
+				INNER JOIN currencies AS c ON c.id = r.currency_id
+				INNER JOIN currencies AS b ON b.id = r.base_currency_id
+				WHERE c.code = ? AND b.code IN (` + in + `) AND r.date <= ?
+				UNION ALL
+				SELECT c.code, r.date, r.rate / t.rate, 2 AS kind
+				FROM exchange_rates AS r
+				INNER JOIN exchange_rates AS t ON (t.base_currency_id = r.base_currency_id AND t.date = r.date)
+				INNER JOIN currencies AS c ON c.id = r.currency_id
+				INNER JOIN currencies AS tc ON tc.id = t.currency_id
+				WHERE c.code IN (` + in + `) AND tc.code = ? AND r.date <= ?
+			) AS u
+		) AS rates
+		WHERE rates.start_date IS NULL OR rates.date >= rates.start_date
+		ORDER BY rates.code, rates.date, rates.kind`
+
+	firstDay, lastDay := from.Format(time.DateOnly), to.Format(time.DateOnly)
+	codeParams := make([]any, 0, len(codes))
+	for _, code := range codes {
+		codeParams = append(codeParams, code)
+	}
+
+	params := make([]any, 0, 3*len(codes)+7)
+	params = append(params, firstDay)
+	params = append(append(params, codeParams...), targetCode, lastDay)
+	params = append(append(append(params, targetCode), codeParams...), lastDay)
+	params = append(append(params, codeParams...), targetCode, lastDay)
+
+	rows, err := xr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := models.ExchangeRate{BaseCode: targetCode}
+		if err = rows.Scan(&obj.CurrencyCode, &obj.Date, &obj.Rate); err != nil {
+			return nil, err
+		}
+
+		rates := history[obj.CurrencyCode]
+		if len(rates) > 0 && rates[len(rates)-1].Date.Equal(obj.Date) {
+			continue
+		}
+
+		history[obj.CurrencyCode] = append(rates, &obj)
+	}
+
+	return history, rows.Err()
+}
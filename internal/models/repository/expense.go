@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -11,37 +12,45 @@ import (
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/filters"
 	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
 type ExpenseRepository struct {
 	DB *database.DB
 }
 
-func (er *ExpenseRepository) GetExpensesByUser(userID uint, filter *filters.ExpenseFilter) ([]*models.Expense, int, error) {
-	cntDs := expenseListQueryExpression(userID, filter)
-	cntDs = cntDs.ClearSelect().Select(goqu.COUNT("e.id"))
+var expenseSortColumns = map[string][]exp.Orderable{
+	filters.SortByDate: {goqu.I("e.date"), goqu.I("e.id")},
+	filters.SortByCost: {goqu.I("e.cost"), goqu.I("e.date"), goqu.I("e.id")},
+}
 
-	var count int
-	cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
-	err := er.DB.QueryRow(cntQuery, cntParams...).Scan(&count)
-	if err != nil {
-		return nil, 0, err
-	}
+var expenseCursorColumns = []string{"e.date", "e.id"}
 
-	ds := expenseListQueryExpression(userID, filter)
-	ds = ds.Order(goqu.I("e.date").Desc(), goqu.I("e.id").Desc())
+func (er *ExpenseRepository) GetExpensesByUser(userID uint, filter *filters.ExpenseFilter) ([]*models.Expense, models.ListPage, error) {
+	page := models.ListPage{Count: -1}
+	if filter.NeedCount() {
+		cntDs := expenseListQueryExpression(userID, filter)
+		cntDs = cntDs.ClearSelect().Select(goqu.COUNT("e.id"))
 
-	if filter.GetLimit() > 0 {
-		ds = ds.Limit(uint(filter.GetLimit()))
-		if filter.GetPage() > 1 {
-			ds = ds.Offset(uint(filter.GetLimit() * (filter.GetPage() - 1)))
+		cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
+		err := er.DB.QueryRow(cntQuery, cntParams...).Scan(&page.Count)
+		if err != nil {
+			return nil, page, err
 		}
 	}
 
+	ds := expenseListQueryExpression(userID, filter)
+	ds = sortExpression(ds, filter.GetSort(), expenseSortColumns)
+	ds, err := cursorExpression(ds, filter, expenseCursorColumns)
+	if err != nil {
+		return nil, page, err
+	}
+	ds = limitExpression(ds, filter)
+
 	query, params, _ := ds.Prepared(true).ToSQL()
 	rows, err := er.DB.Query(query, params...)
 	if err != nil {
-		return nil, 0, err
+		return nil, page, err
 	}
 
 	defer rows.Close()
@@ -55,6 +64,12 @@ func (er *ExpenseRepository) GetExpensesByUser(userID uint, filter *filters.Expe
 			Brand sql.NullString
 			Model sql.NullString
 		}{}
+		categoryFields := struct {
+			ID       sql.NullInt32
+			UserID   sql.NullInt32
+			ParentID sql.NullInt32
+			Name     sql.NullString
+		}{}
 		err = rows.Scan(
 			&obj.ID,
 			&obj.Date,
@@ -65,10 +80,16 @@ func (er *ExpenseRepository) GetExpensesByUser(userID uint, filter *filters.Expe
 			&carFields.Brand,
 			&carFields.Model,
 			&obj.Type,
+			&categoryFields.ID,
+			&categoryFields.UserID,
+			&categoryFields.ParentID,
+			&categoryFields.Name,
+			&obj.RecurringID,
+			&obj.Version,
 			&obj.CreatedAt)
 
 		if err != nil {
-			return nil, 0, err
+			return nil, page, err
 		}
 
 		if carFields.ID.Valid {
@@ -79,11 +100,24 @@ func (er *ExpenseRepository) GetExpensesByUser(userID uint, filter *filters.Expe
 			}
 			obj.Car = &car
 		}
+		if categoryFields.ID.Valid {
+			obj.Category = &models.ExpenseCategory{
+				ID:       uint(categoryFields.ID.Int32),
+				UserID:   categoryFields.UserID,
+				ParentID: categoryFields.ParentID,
+				Name:     categoryFields.Name.String,
+				Type:     obj.Type,
+			}
+		}
 
 		items = append(items, &obj)
 	}
 
-	return items, count, nil
+	items, page = completePage(items, filter, page, func(obj *models.Expense) []string {
+		return []string{obj.Date.Format(time.DateOnly), strconv.FormatUint(uint64(obj.ID), 10)}
+	})
+
+	return items, page, nil
 }
 
 func (er *ExpenseRepository) Find(id uint) (*models.Expense, error) {
@@ -98,6 +132,12 @@ func (er *ExpenseRepository) Find(id uint) (*models.Expense, error) {
 		Brand sql.NullString
 		Model sql.NullString
 	}{}
+	categoryFields := struct {
+		ID       sql.NullInt32
+		UserID   sql.NullInt32
+		ParentID sql.NullInt32
+		Name     sql.NullString
+	}{}
 
 	err := er.DB.QueryRow(query, params...).Scan(
 		&obj.ID,
@@ -109,6 +149,12 @@ func (er *ExpenseRepository) Find(id uint) (*models.Expense, error) {
 		&carFields.Brand,
 		&carFields.Model,
 		&obj.Type,
+		&categoryFields.ID,
+		&categoryFields.UserID,
+		&categoryFields.ParentID,
+		&categoryFields.Name,
+		&obj.RecurringID,
+		&obj.Version,
 		&obj.CreatedAt)
 
 	if err != nil {
@@ -127,6 +173,15 @@ func (er *ExpenseRepository) Find(id uint) (*models.Expense, error) {
 		}
 		obj.Car = &car
 	}
+	if categoryFields.ID.Valid {
+		obj.Category = &models.ExpenseCategory{
+			ID:       uint(categoryFields.ID.Int32),
+			UserID:   categoryFields.UserID,
+			ParentID: categoryFields.ParentID,
+			Name:     categoryFields.Name.String,
+			Type:     obj.Type,
+		}
+	}
 
 	return &obj, nil
 }
@@ -160,6 +215,12 @@ func (er *ExpenseRepository) SaveExpense(obj *models.Expense, userId uint) (uint
 	data["cost"] = fmt.Sprintf("%.2f", 0.01*float64(obj.Cost.Value))
 	data["type"] = int(obj.Type)
 
+	if obj.Category != nil {
+		data["category_id"] = obj.Category.ID
+	} else {
+		data["category_id"] = nil
+	}
+
 	if obj.Car != nil {
 		data["car_id"] = obj.Car.ID
 	} else {
@@ -169,9 +230,18 @@ func (er *ExpenseRepository) SaveExpense(obj *models.Expense, userId uint) (uint
 	var ds exp.SQLExpression
 	if obj.ID == 0 {
 		data["user_id"] = userId
+		if obj.RecurringID.Valid {
+			data["recurring_id"] = obj.RecurringID.Int32
+		}
 		ds = goqu.Dialect("mysql8").Insert("expenses").Rows(data)
 	} else {
-		ds = goqu.Dialect("mysql8").Update("expenses").Set(data).Where(goqu.Ex{"id": obj.ID})
+		cond := goqu.Ex{"id": obj.ID}
+		if obj.Version > 0 {
+			cond["version"] = obj.Version
+		}
+
+		data["version"] = goqu.L("version + 1")
+		ds = goqu.Dialect("mysql8").Update("expenses").Set(data).Where(cond)
 	}
 
 	query, _, err := ds.ToSQL()
@@ -193,6 +263,14 @@ func (er *ExpenseRepository) SaveExpense(obj *models.Expense, userId uint) (uint
 		return uint(lastID), nil
 	}
 
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if affected == 0 {
+		return 0, models.StaleRecord
+	}
+
 	return obj.ID, nil
 }
 
@@ -215,6 +293,22 @@ func expenseListQueryExpression(userID uint, filter *filters.ExpenseFilter) *goq
 		})
 	}
 
+	if filter.GetCategoryId() > 0 {
+		ds = ds.Where(goqu.ExOr{
+			"ec.id":        filter.GetCategoryId(),
+			"ec.parent_id": filter.GetCategoryId(),
+		})
+	}
+
+	ds = commonFilterExpression(ds, filter, filterColumns{
+		date:        "e.date",
+		cost:        "e.cost",
+		currency:    "cur.code",
+		description: "e.description",
+		id:          "e.id",
+		entity:      pb.SyncEntity_SYNC_EXPENSE,
+	})
+
 	return ds
 }
 
@@ -229,6 +323,12 @@ func expenseQueryExpression() *goqu.SelectDataset {
 		goqu.I("c.brand_name").As("car_brand"),
 		goqu.I("c.model_name").As("car_model"),
 		"e.type",
+		goqu.I("ec.id").As("category_id"),
+		goqu.I("ec.user_id").As("category_user_id"),
+		goqu.I("ec.parent_id").As("category_parent_id"),
+		goqu.I("ec.name").As("category_name"),
+		"e.recurring_id",
+		"e.version",
 		"e.created_at",
 	).LeftJoin(
 		goqu.T("cars").As("c"),
@@ -240,5 +340,10 @@ func expenseQueryExpression() *goqu.SelectDataset {
 		goqu.On(goqu.Ex{
 			"cur.id": goqu.I("e.currency_id"),
 		}),
+	).LeftJoin(
+		goqu.T("expense_categories").As("ec"),
+		goqu.On(goqu.Ex{
+			"ec.id": goqu.I("e.category_id"),
+		}),
 	)
 }
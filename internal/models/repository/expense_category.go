@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type ExpenseCategoryRepository struct {
+	DB *database.DB
+}
+
+// GetCategories returns the built-in categories and the categories of the user
+func (ecr *ExpenseCategoryRepository) GetCategories(userID uint) ([]*models.ExpenseCategory, error) {
+	ds := expenseCategoryQueryExpression().Where(goqu.Or(
+		goqu.I("ec.user_id").IsNull(),
+		goqu.I("ec.user_id").Eq(userID),
+	)).Order(goqu.I("ec.user_id").Asc(), goqu.I("ec.name").Asc())
+
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := ecr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.ExpenseCategory, 0)
+
+	for rows.Next() {
+		obj := models.ExpenseCategory{}
+		err = rows.Scan(
+			&obj.ID,
+			&obj.UserID,
+			&obj.ParentID,
+			&obj.Name,
+			&obj.Type,
+			&obj.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, nil
+}
+
+func (ecr *ExpenseCategoryRepository) Find(id uint) (*models.ExpenseCategory, error) {
+	return ecr.findBy(goqu.Ex{"ec.id": id})
+}
+
+// FindByType returns the built-in category of the enum value
+func (ecr *ExpenseCategoryRepository) FindByType(expenseType pb.ExpenseType) (*models.ExpenseCategory, error) {
+	return ecr.findBy(goqu.Ex{
+		"ec.type":    int(expenseType),
+		"ec.user_id": nil,
+	})
+}
+
+func (ecr *ExpenseCategoryRepository) CountChildren(id uint) (int, error) {
+	query := `
+		SELECT
+			COUNT(ec.id)
+		FROM expense_categories AS ec
+		WHERE ec.parent_id = ?`
+
+	var cnt int
+	err := ecr.DB.QueryRow(query, id).Scan(&cnt)
+
+	return cnt, err
+}
+
+func (ecr *ExpenseCategoryRepository) SaveCategory(obj *models.ExpenseCategory) (uint, error) {
+	data := goqu.Record{}
+
+	data["name"] = obj.Name
+	data["type"] = int(obj.Type)
+	if obj.ParentID.Valid {
+		data["parent_id"] = obj.ParentID.Int32
+	} else {
+		data["parent_id"] = nil
+	}
+
+	var ds exp.SQLExpression
+	if obj.ID == 0 {
+		data["user_id"] = obj.UserID
+		ds = goqu.Dialect("mysql8").Insert("expense_categories").Rows(data)
+	} else {
+		ds = goqu.Dialect("mysql8").Update("expense_categories").Set(data).Where(goqu.Ex{"id": obj.ID})
+	}
+
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := ecr.DB.Exec(query)
+	if err != nil {
+		return 0, err
+	}
+
+	if obj.ID == 0 {
+		lastID, err := res.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		return uint(lastID), nil
+	}
+
+	return obj.ID, nil
+}
+
+// UpdateChildrenType keeps the enum value of the children in line with
+// their parent, together with the expenses of these categories. The version
+// of a changed expense is increased as on SaveExpense
+func (ecr *ExpenseCategoryRepository) UpdateChildrenType(obj *models.ExpenseCategory) error {
+	query := `
+		UPDATE expense_categories
+		SET type = ?
+		WHERE parent_id = ?`
+
+	if _, err := ecr.DB.Exec(query, int(obj.Type), obj.ID); err != nil {
+		return err
+	}
+
+	query = `
+		UPDATE expenses AS e
+			INNER JOIN expense_categories AS ec ON ec.id = e.category_id
+		SET e.type = ec.type, e.version = e.version + 1
+		WHERE (ec.id = ? OR ec.parent_id = ?) AND e.type <> ec.type`
+
+	_, err := ecr.DB.Exec(query, obj.ID, obj.ID)
+
+	return err
+}
+
+func (ecr *ExpenseCategoryRepository) findBy(cond goqu.Ex) (*models.ExpenseCategory, error) {
+	ds := expenseCategoryQueryExpression().Where(cond)
+	query, params, _ := ds.Prepared(true).ToSQL()
+
+	obj := models.ExpenseCategory{}
+	err := ecr.DB.QueryRow(query, params...).Scan(
+		&obj.ID,
+		&obj.UserID,
+		&obj.ParentID,
+		&obj.Name,
+		&obj.Type,
+		&obj.CreatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.RecordNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &obj, nil
+}
+
+func expenseCategoryQueryExpression() *goqu.SelectDataset {
+	return goqu.Dialect("mysql8").From(goqu.T("expense_categories").As("ec")).Select(
+		"ec.id",
+		"ec.user_id",
+		"ec.parent_id",
+		"ec.name",
+		"ec.type",
+		"ec.created_at",
+	)
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/filters"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// filterColumns names the columns of a list query used by the common
+// conditions, an empty name disables the condition for the entity
+type filterColumns struct {
+	date        string
+	cost        string
+	currency    string
+	description string
+	// record id and type for the tag condition
+	id     string
+	entity pb.SyncEntity
+}
+
+func commonFilterExpression(ds *goqu.SelectDataset, filter filters.CommonPart, columns filterColumns) *goqu.SelectDataset {
+	if columns.date != "" {
+		if filter.HasDateFrom() {
+			ds = ds.Where(goqu.I(columns.date).Gte(filter.GetDateFrom().Format(time.DateOnly)))
+		}
+		if filter.HasDateTo() {
+			ds = ds.Where(goqu.I(columns.date).Lte(filter.GetDateTo().Format(time.DateOnly)))
+		}
+	}
+
+	if columns.cost != "" {
+		if filter.GetMinCost() > 0 {
+			ds = ds.Where(goqu.I(columns.cost).Gte(costValue(filter.GetMinCost())))
+		}
+		if filter.GetMaxCost() > 0 {
+			ds = ds.Where(goqu.I(columns.cost).Lte(costValue(filter.GetMaxCost())))
+		}
+	}
+
+	if columns.currency != "" && filter.GetCurrency() != "" {
+		ds = ds.Where(goqu.Ex{
+			columns.currency: filter.GetCurrency(),
+		})
+	}
+
+	if columns.description != "" && filter.GetSearch() != "" {
+		ds = ds.Where(goqu.I(columns.description).Like("%" + likeReplacer.Replace(filter.GetSearch()) + "%"))
+	}
+
+	if columns.id != "" && filter.GetTagId() > 0 {
+		ds = ds.Where(goqu.I(columns.id).In(
+			goqu.Dialect("mysql8").From("record_tags").Select("record_id").Where(goqu.Ex{
+				"tag_id": filter.GetTagId(),
+				"entity": int(columns.entity),
+			}),
+		))
+	}
+
+	return ds
+}
+
+func costValue(cost int32) string {
+	return fmt.Sprintf("%.2f", 0.01*float64(cost))
+}
+
+// sortExpression orders the list by the columns of the sort field, the
+// remaining columns keep the order stable for pagination
+func sortExpression(ds *goqu.SelectDataset, sort filters.Sort, columns map[string][]exp.Orderable) *goqu.SelectDataset {
+	order := make([]exp.OrderedExpression, 0, len(columns[sort.Field]))
+	for _, column := range columns[sort.Field] {
+		if sort.Desc {
+			order = append(order, column.Desc())
+		} else {
+			order = append(order, column.Asc())
+		}
+	}
+
+	return ds.Order(order...)
+}
+
+// cursorExpression continues the list after the row of the cursor,
+// the columns are the ones the list is ordered by for the date sort
+func cursorExpression(ds *goqu.SelectDataset, filter filters.CommonPart, columns []string) (*goqu.SelectDataset, error) {
+	values, err := filter.GetCursor()
+	if err != nil {
+		return nil, err
+	}
+	if values == nil {
+		return ds, nil
+	}
+	if len(values) != len(columns) {
+		return nil, filters.InvalidCursor
+	}
+
+	desc := filter.GetSort().Desc
+	conditions := make([]exp.Expression, 0, len(columns))
+	for i, column := range columns {
+		prefix := make([]exp.Expression, 0, i+1)
+		for j := 0; j < i; j++ {
+			prefix = append(prefix, goqu.I(columns[j]).Eq(values[j]))
+		}
+		if desc {
+			prefix = append(prefix, goqu.I(column).Lt(values[i]))
+		} else {
+			prefix = append(prefix, goqu.I(column).Gt(values[i]))
+		}
+		conditions = append(conditions, goqu.And(prefix...))
+	}
+
+	return ds.Where(goqu.Or(conditions...)), nil
+}
+
+// limitExpression fetches one extra row to find out whether there is a next page
+func limitExpression(ds *goqu.SelectDataset, filter filters.CommonPart) *goqu.SelectDataset {
+	if filter.GetLimit() > 0 {
+		ds = ds.Limit(uint(filter.GetLimit() + 1))
+		if !filter.HasCursor() && filter.GetPage() > 1 {
+			ds = ds.Offset(uint(filter.GetLimit() * (filter.GetPage() - 1)))
+		}
+	}
+
+	return ds
+}
+
+// completePage drops the extra row and builds the cursor of the next page
+func completePage[T any](items []T, filter filters.CommonPart, page models.ListPage, cursor func(T) []string) ([]T, models.ListPage) {
+	if filter.GetLimit() > 0 && len(items) > filter.GetLimit() {
+		items = items[:filter.GetLimit()]
+		page.HasMore = true
+		if filter.SupportsCursor() {
+			page.NextCursor = filters.EncodeCursor(cursor(items[len(items)-1])...)
+		}
+	}
+
+	return items, page
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -11,37 +12,47 @@ import (
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/filters"
 	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
 type FuelRepository struct {
 	DB *database.DB
 }
 
-func (fr *FuelRepository) GetFuelsByUser(userID uint, filter *filters.FuelFilter) ([]*models.Fuel, int, error) {
-	cntDs := fuelListQueryExpression(userID, filter)
-	cntDs = cntDs.ClearSelect().Select(goqu.COUNT("f.id"))
+var fuelSortColumns = map[string][]exp.Orderable{
+	filters.SortByDate:  {goqu.I("f.date"), goqu.I("f.id")},
+	filters.SortByCost:  {goqu.I("f.cost"), goqu.I("f.date"), goqu.I("f.id")},
+	filters.SortByValue: {goqu.I("f.value"), goqu.I("f.date"), goqu.I("f.id")},
+	filters.SortByPrice: {goqu.L("f.cost / f.value"), goqu.I("f.date"), goqu.I("f.id")},
+}
 
-	var count int
-	cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
-	err := fr.DB.QueryRow(cntQuery, cntParams...).Scan(&count)
-	if err != nil {
-		return nil, 0, err
-	}
+var fuelCursorColumns = []string{"f.date", "f.id"}
 
-	ds := fuelListQueryExpression(userID, filter)
-	ds = ds.Order(goqu.I("f.date").Desc(), goqu.I("f.id").Desc())
+func (fr *FuelRepository) GetFuelsByUser(userID uint, filter *filters.FuelFilter) ([]*models.Fuel, models.ListPage, error) {
+	page := models.ListPage{Count: -1}
+	if filter.NeedCount() {
+		cntDs := fuelListQueryExpression(userID, filter)
+		cntDs = cntDs.ClearSelect().Select(goqu.COUNT("f.id"))
 
-	if filter.GetLimit() > 0 {
-		ds = ds.Limit(uint(filter.GetLimit()))
-		if filter.GetPage() > 1 {
-			ds = ds.Offset(uint(filter.GetLimit() * (filter.GetPage() - 1)))
+		cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
+		err := fr.DB.QueryRow(cntQuery, cntParams...).Scan(&page.Count)
+		if err != nil {
+			return nil, page, err
 		}
 	}
 
+	ds := fuelListQueryExpression(userID, filter)
+	ds = sortExpression(ds, filter.GetSort(), fuelSortColumns)
+	ds, err := cursorExpression(ds, filter, fuelCursorColumns)
+	if err != nil {
+		return nil, page, err
+	}
+	ds = limitExpression(ds, filter)
+
 	query, params, _ := ds.Prepared(true).ToSQL()
 	rows, err := fr.DB.Query(query, params...)
 	if err != nil {
-		return nil, 0, err
+		return nil, page, err
 	}
 
 	defer rows.Close()
@@ -59,6 +70,7 @@ func (fr *FuelRepository) GetFuelsByUser(userID uint, filter *filters.FuelFilter
 			&obj.ID,
 			&obj.Date,
 			&obj.Value,
+			&obj.Description,
 			&obj.Station.ID,
 			&obj.Station.Name,
 			&obj.Station.CreatedAt,
@@ -70,10 +82,11 @@ func (fr *FuelRepository) GetFuelsByUser(userID uint, filter *filters.FuelFilter
 			&obj.Distance,
 			&obj.Type.ID,
 			&obj.Type.Name,
+			&obj.Version,
 			&obj.CreatedAt)
 
 		if err != nil {
-			return nil, 0, err
+			return nil, page, err
 		}
 
 		if carFields.ID.Valid {
@@ -88,7 +101,11 @@ func (fr *FuelRepository) GetFuelsByUser(userID uint, filter *filters.FuelFilter
 		items = append(items, &obj)
 	}
 
-	return items, count, nil
+	items, page = completePage(items, filter, page, func(obj *models.Fuel) []string {
+		return []string{obj.Date.Format(time.DateOnly), strconv.FormatUint(uint64(obj.ID), 10)}
+	})
+
+	return items, page, nil
 }
 
 func (fr *FuelRepository) Find(id uint) (*models.Fuel, error) {
@@ -108,6 +125,7 @@ func (fr *FuelRepository) Find(id uint) (*models.Fuel, error) {
 		&obj.ID,
 		&obj.Date,
 		&obj.Value,
+		&obj.Description,
 		&obj.Station.ID,
 		&obj.Station.Name,
 		&obj.Station.CreatedAt,
@@ -119,6 +137,7 @@ func (fr *FuelRepository) Find(id uint) (*models.Fuel, error) {
 		&obj.Distance,
 		&obj.Type.ID,
 		&obj.Type.Name,
+		&obj.Version,
 		&obj.CreatedAt)
 
 	if err != nil {
@@ -165,7 +184,8 @@ func (fr *FuelRepository) FindType(id uint) (*models.FuelType, error) {
 	query := `
 		SELECT
 			ft.id,
-			ft.name
+			ft.name,
+			ft.parent_id
 		FROM fuel_types AS ft
 		WHERE ft.id = ?`
 
@@ -173,7 +193,8 @@ func (fr *FuelRepository) FindType(id uint) (*models.FuelType, error) {
 
 	err := fr.DB.QueryRow(query, id).Scan(
 		&obj.ID,
-		&obj.Name)
+		&obj.Name,
+		&obj.ParentID)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -194,6 +215,7 @@ func (fr *FuelRepository) SaveFuel(obj *models.Fuel, userId uint) (uint, error)
 	data["currency_id"] = obj.Cost.CurrencyID
 	data["cost"] = fmt.Sprintf("%.2f", 0.01*float64(obj.Cost.Value))
 	data["value"] = fmt.Sprintf("%.2f", 0.01*float64(obj.Value))
+	data["description"] = obj.Description
 	data["type_id"] = obj.Type.ID
 
 	if obj.Car != nil {
@@ -213,7 +235,13 @@ func (fr *FuelRepository) SaveFuel(obj *models.Fuel, userId uint) (uint, error)
 		data["user_id"] = userId
 		ds = goqu.Dialect("mysql8").Insert("fuels").Rows(data)
 	} else {
-		ds = goqu.Dialect("mysql8").Update("fuels").Set(data).Where(goqu.Ex{"id": obj.ID})
+		cond := goqu.Ex{"id": obj.ID}
+		if obj.Version > 0 {
+			cond["version"] = obj.Version
+		}
+
+		data["version"] = goqu.L("version + 1")
+		ds = goqu.Dialect("mysql8").Update("fuels").Set(data).Where(cond)
 	}
 
 	query, _, err := ds.ToSQL()
@@ -235,9 +263,98 @@ func (fr *FuelRepository) SaveFuel(obj *models.Fuel, userId uint) (uint, error)
 		return uint(lastID), nil
 	}
 
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if affected == 0 {
+		return 0, models.StaleRecord
+	}
+
 	return obj.ID, nil
 }
 
+func (fr *FuelRepository) GetFuelPrices(userID uint, filter *filters.FuelPriceFilter) ([]*models.FuelPrice, error) {
+	ds := goqu.Dialect("mysql8").From(goqu.T("fuels").As("f")).Select(
+		"f.id",
+		goqu.I("f.date").As("f_date"),
+		goqu.I("azs.id").As("station_id"),
+		goqu.I("azs.name").As("station_name"),
+		goqu.I("azs.created_at").As("station_created_at"),
+		goqu.I("ft.id").As("type_id"),
+		goqu.I("ft.name").As("type_name"),
+		goqu.I("cur.code").As("curr_code"),
+		goqu.L("CAST(f.cost * 100 AS SIGNED INT)").As("cost"),
+		goqu.L("CAST(f.value * 100 AS SIGNED INT)").As("value"),
+	).InnerJoin(
+		goqu.T("filling_stations").As("azs"),
+		goqu.On(goqu.Ex{
+			"azs.id": goqu.I("f.station_id"),
+		}),
+	).InnerJoin(
+		goqu.T("currencies").As("cur"),
+		goqu.On(goqu.Ex{
+			"cur.id": goqu.I("f.currency_id"),
+		}),
+	).InnerJoin(
+		goqu.T("fuel_types").As("ft"),
+		goqu.On(goqu.Ex{
+			"ft.id": goqu.I("f.type_id"),
+		}),
+	).Where(goqu.Ex{
+		"f.user_id": userID,
+	}).Order(goqu.I("f.date").Asc(), goqu.I("f.id").Asc())
+
+	if filter.GetStationId() > 0 {
+		ds = ds.Where(goqu.Ex{
+			"f.station_id": filter.GetStationId(),
+		})
+	}
+
+	if filter.GetTypeId() > 0 {
+		ds = ds.Where(goqu.Ex{
+			"f.type_id": filter.GetTypeId(),
+		})
+	}
+
+	ds = commonFilterExpression(ds, filter, filterColumns{
+		date: "f.date",
+	})
+
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := fr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.FuelPrice, 0)
+
+	for rows.Next() {
+		obj := models.FuelPrice{}
+		err = rows.Scan(
+			&obj.FuelID,
+			&obj.Date,
+			&obj.Station.ID,
+			&obj.Station.Name,
+			&obj.Station.CreatedAt,
+			&obj.Type.ID,
+			&obj.Type.Name,
+			&obj.CurrencyCode,
+			&obj.Cost,
+			&obj.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, nil
+}
+
 func (fr *FuelRepository) GetFillingStations() ([]*models.FillingStation, error) {
 	query := `
 		SELECT
@@ -277,7 +394,8 @@ func (fr *FuelRepository) GetFuelTypes() ([]*models.FuelType, error) {
 	query := `
 		SELECT
 			ft.id,
-			ft.name
+			ft.name,
+			ft.parent_id
 		FROM fuel_types AS ft
 		ORDER BY ft.name`
 
@@ -294,7 +412,8 @@ func (fr *FuelRepository) GetFuelTypes() ([]*models.FuelType, error) {
 		obj := models.FuelType{}
 		err = rows.Scan(
 			&obj.ID,
-			&obj.Name)
+			&obj.Name,
+			&obj.ParentID)
 
 		if err != nil {
 			return nil, err
@@ -306,6 +425,97 @@ func (fr *FuelRepository) GetFuelTypes() ([]*models.FuelType, error) {
 	return items, nil
 }
 
+func (fr *FuelRepository) SaveType(obj *models.FuelType) (uint, error) {
+	data := goqu.Record{}
+
+	data["name"] = obj.Name
+	if obj.ParentID.Valid {
+		data["parent_id"] = obj.ParentID.Int32
+	} else {
+		data["parent_id"] = nil
+	}
+
+	var ds exp.SQLExpression
+	if obj.ID == 0 {
+		ds = goqu.Dialect("mysql8").Insert("fuel_types").Rows(data)
+	} else {
+		ds = goqu.Dialect("mysql8").Update("fuel_types").Set(data).Where(goqu.Ex{"id": obj.ID})
+	}
+
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := fr.DB.Exec(query)
+	if err != nil {
+		return 0, err
+	}
+
+	if obj.ID == 0 {
+		lastID, err := res.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		return uint(lastID), nil
+	}
+
+	return obj.ID, nil
+}
+
+func (fr *FuelRepository) CountChildTypes(id uint) (int, error) {
+	query := `
+		SELECT
+			COUNT(ft.id)
+		FROM fuel_types AS ft
+		WHERE ft.parent_id = ?`
+
+	var cnt int
+	err := fr.DB.QueryRow(query, id).Scan(&cnt)
+
+	return cnt, err
+}
+
+// MergeTypes moves the children, fuels and default settings of the source
+// type to the target and removes the source, call it in a transaction
+func (fr *FuelRepository) MergeTypes(source, target *models.FuelType) error {
+	queries := []struct {
+		query  string
+		params []any
+	}{
+		{
+			query:  "UPDATE fuel_types SET parent_id = ? WHERE parent_id = ? AND id <> ?",
+			params: []any{target.ID, source.ID, target.ID},
+		},
+		{
+			// the target was a child of the source
+			query:  "UPDATE fuel_types SET parent_id = ? WHERE id = ? AND parent_id = ?",
+			params: []any{source.ParentID, target.ID, source.ID},
+		},
+		{
+			query:  "UPDATE fuels SET type_id = ?, version = version + 1 WHERE type_id = ?",
+			params: []any{target.ID, source.ID},
+		},
+		{
+			query:  "UPDATE user_settings SET default_fuel_type_id = ? WHERE default_fuel_type_id = ?",
+			params: []any{target.ID, source.ID},
+		},
+		{
+			query:  "DELETE FROM fuel_types WHERE id = ?",
+			params: []any{source.ID},
+		},
+	}
+
+	for _, item := range queries {
+		if _, err := fr.DB.Exec(item.query, item.params...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func fuelListQueryExpression(userID uint, filter *filters.FuelFilter) *goqu.SelectDataset {
 	ds := fuelQueryExpression()
 
@@ -332,6 +542,15 @@ func fuelListQueryExpression(userID uint, filter *filters.FuelFilter) *goqu.Sele
 		})
 	}
 
+	ds = commonFilterExpression(ds, filter, filterColumns{
+		date:        "f.date",
+		cost:        "f.cost",
+		currency:    "cur.code",
+		description: "f.description",
+		id:          "f.id",
+		entity:      pb.SyncEntity_SYNC_FUEL,
+	})
+
 	return ds
 }
 
@@ -340,6 +559,7 @@ func fuelQueryExpression() *goqu.SelectDataset {
 		"f.id",
 		goqu.I("f.date").As("f_date"),
 		goqu.L("CAST(f.value * 100 AS SIGNED INT)").As("value"),
+		"f.description",
 		goqu.I("azs.id").As("station_id"),
 		goqu.I("azs.name").As("station_name"),
 		goqu.I("azs.created_at").As("station_created_at"),
@@ -351,6 +571,7 @@ func fuelQueryExpression() *goqu.SelectDataset {
 		"m.distance",
 		goqu.I("ft.id").As("type_id"),
 		goqu.I("ft.name").As("type_name"),
+		"f.version",
 		"f.created_at",
 	).InnerJoin(
 		goqu.T("filling_stations").As("azs"),
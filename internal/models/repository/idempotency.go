@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+)
+
+const mysqlDuplicateEntry = 1062
+
+type IdempotencyRepository struct {
+	DB *database.DB
+}
+
+// Reserve stores the key before the call, so concurrent retries
+// cannot run the same request twice. A reservation without a response
+// older than the timeout is left by a failed call and is taken over.
+// It returns false and the stored key if the key already exists
+func (ir *IdempotencyRepository) Reserve(obj *models.IdempotencyKey, retention, timeout time.Duration) (bool, *models.IdempotencyKey, error) {
+	_, err := ir.DB.Exec(
+		"DELETE FROM idempotency_keys WHERE user_id = ? AND created_at < NOW() - INTERVAL ? SECOND",
+		obj.UserID,
+		int64(retention.Seconds()),
+	)
+	if err != nil {
+		return false, nil, err
+	}
+
+	_, err = ir.DB.Exec(
+		"DELETE FROM idempotency_keys WHERE user_id = ? AND idempotency_key = ? AND response IS NULL AND created_at < NOW() - INTERVAL ? SECOND",
+		obj.UserID,
+		obj.Key,
+		int64(timeout.Seconds()),
+	)
+	if err != nil {
+		return false, nil, err
+	}
+
+	_, err = ir.DB.Exec(
+		"INSERT INTO idempotency_keys (user_id, idempotency_key, method, request_hash) VALUES (?, ?, ?, ?)",
+		obj.UserID,
+		obj.Key,
+		obj.Method,
+		obj.RequestHash,
+	)
+	if err == nil {
+		return true, nil, nil
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlDuplicateEntry {
+		return false, nil, err
+	}
+
+	stored, err := ir.Find(obj.UserID, obj.Key)
+	if err != nil {
+		return false, nil, err
+	}
+
+	return false, stored, nil
+}
+
+func (ir *IdempotencyRepository) Find(userID uint, key string) (*models.IdempotencyKey, error) {
+	query := `
+		SELECT
+			ik.id,
+			ik.user_id,
+			ik.idempotency_key,
+			ik.method,
+			ik.request_hash,
+			ik.response,
+			ik.created_at
+		FROM idempotency_keys AS ik
+		WHERE ik.user_id = ? AND ik.idempotency_key = ?`
+
+	obj := models.IdempotencyKey{}
+
+	err := ir.DB.QueryRow(query, userID, key).Scan(
+		&obj.ID,
+		&obj.UserID,
+		&obj.Key,
+		&obj.Method,
+		&obj.RequestHash,
+		&obj.Response,
+		&obj.CreatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.RecordNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &obj, nil
+}
+
+func (ir *IdempotencyRepository) Complete(userID uint, key string, response []byte) error {
+	_, err := ir.DB.Exec(
+		"UPDATE idempotency_keys SET response = ? WHERE user_id = ? AND idempotency_key = ?",
+		response,
+		userID,
+		key,
+	)
+
+	return err
+}
+
+// Release removes the key of a failed call, so the client can retry it
+func (ir *IdempotencyRepository) Release(userID uint, key string) error {
+	_, err := ir.DB.Exec(
+		"DELETE FROM idempotency_keys WHERE user_id = ? AND idempotency_key = ? AND response IS NULL",
+		userID,
+		key,
+	)
+
+	return err
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"database/sql"
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -10,37 +11,50 @@ import (
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/filters"
 	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
 type MileageRepository struct {
 	DB *database.DB
 }
 
-func (mr *MileageRepository) GetMileagesByUser(userID uint, filter *filters.MileageFilter) ([]*models.Mileage, int, error) {
-	cntDs := milageListQueryExpression(userID, filter)
-	cntDs = cntDs.ClearSelect().Select(goqu.COUNT("m.id"))
+type mileageQuery struct {
+	query  string
+	params []any
+}
 
-	var count int
-	cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
-	err := mr.DB.QueryRow(cntQuery, cntParams...).Scan(&count)
-	if err != nil {
-		return nil, 0, err
-	}
+var mileageSortColumns = map[string][]exp.Orderable{
+	filters.SortByDate:     {goqu.I("m.date"), goqu.I("m.distance"), goqu.I("m.id")},
+	filters.SortByDistance: {goqu.I("m.distance"), goqu.I("m.date"), goqu.I("m.id")},
+}
 
-	ds := milageListQueryExpression(userID, filter)
-	ds = ds.Order(goqu.I("m.date").Desc(), goqu.I("m.distance").Desc())
+var mileageCursorColumns = []string{"m.date", "m.distance", "m.id"}
+
+func (mr *MileageRepository) GetMileagesByUser(userID uint, filter *filters.MileageFilter) ([]*models.Mileage, models.ListPage, error) {
+	page := models.ListPage{Count: -1}
+	if filter.NeedCount() {
+		cntDs := milageListQueryExpression(userID, filter)
+		cntDs = cntDs.ClearSelect().Select(goqu.COUNT("m.id"))
 
-	if filter.GetLimit() > 0 {
-		ds = ds.Limit(uint(filter.GetLimit()))
-		if filter.GetPage() > 1 {
-			ds = ds.Offset(uint(filter.GetLimit() * (filter.GetPage() - 1)))
+		cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
+		err := mr.DB.QueryRow(cntQuery, cntParams...).Scan(&page.Count)
+		if err != nil {
+			return nil, page, err
 		}
 	}
 
+	ds := milageListQueryExpression(userID, filter)
+	ds = sortExpression(ds, filter.GetSort(), mileageSortColumns)
+	ds, err := cursorExpression(ds, filter, mileageCursorColumns)
+	if err != nil {
+		return nil, page, err
+	}
+	ds = limitExpression(ds, filter)
+
 	query, params, _ := ds.Prepared(true).ToSQL()
 	rows, err := mr.DB.Query(query, params...)
 	if err != nil {
-		return nil, 0, err
+		return nil, page, err
 	}
 
 	defer rows.Close()
@@ -64,7 +78,7 @@ func (mr *MileageRepository) GetMileagesByUser(userID uint, filter *filters.Mile
 			&obj.CreatedAt)
 
 		if err != nil {
-			return nil, 0, err
+			return nil, page, err
 		}
 
 		if carFields.ID.Valid {
@@ -79,7 +93,52 @@ func (mr *MileageRepository) GetMileagesByUser(userID uint, filter *filters.Mile
 		items = append(items, &obj)
 	}
 
-	return items, count, nil
+	items, page = completePage(items, filter, page, func(obj *models.Mileage) []string {
+		return []string{obj.Date.Format(time.DateOnly), strconv.FormatUint(uint64(obj.Distance), 10), strconv.FormatUint(uint64(obj.ID), 10)}
+	})
+
+	return items, page, nil
+}
+
+// GetMileagesByCar returns the whole history of the car ordered by date
+func (mr *MileageRepository) GetMileagesByCar(carId uint) ([]*models.Mileage, error) {
+	ds := mileageQueryExpression()
+
+	ds = ds.Where(goqu.Ex{"m.car_id": carId}).Order(
+		goqu.I("m.date").Asc(),
+		goqu.I("m.distance").Asc(),
+		goqu.I("m.id").Asc(),
+	)
+
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := mr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.Mileage, 0)
+
+	for rows.Next() {
+		obj := models.Mileage{Car: &models.Car{}}
+		err = rows.Scan(
+			&obj.ID,
+			&obj.Date,
+			&obj.Distance,
+			&obj.Car.ID,
+			&obj.Car.Brand,
+			&obj.Car.Model,
+			&obj.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, nil
 }
 
 func (mr *MileageRepository) FindUniq(distance, carId uint, dt time.Time) (*models.Mileage, error) {
@@ -102,6 +161,40 @@ func (mr *MileageRepository) Find(id uint) (*models.Mileage, error) {
 	return mr.findMileageRow(ds)
 }
 
+// FindLast returns the highest reading of the car
+func (mr *MileageRepository) FindLast(carId uint) (*models.Mileage, error) {
+	ds := mileageQueryExpression()
+
+	ds = ds.Where(goqu.Ex{"m.car_id": carId}).Order(
+		goqu.I("m.distance").Desc(),
+		goqu.I("m.date").Desc(),
+	).Limit(1)
+
+	return mr.findMileageRow(ds)
+}
+
+// MileageOwner returns the owner of the car of the mileage
+func (mr *MileageRepository) MileageOwner(mileageId uint) (uint, error) {
+	query := `
+		SELECT
+			c.user_id
+		FROM mileages AS m
+			INNER JOIN cars AS c ON c.id = m.car_id
+		WHERE m.id = ?`
+
+	var userId uint
+	err := mr.DB.QueryRow(query, mileageId).Scan(&userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, models.RecordNotFound
+		} else {
+			return 0, err
+		}
+	}
+
+	return userId, nil
+}
+
 func (mr *MileageRepository) findMileageRow(ds *goqu.SelectDataset) (*models.Mileage, error) {
 	query, params, _ := ds.Prepared(true).ToSQL()
 
@@ -246,6 +339,103 @@ func (mr *MileageRepository) FindOrCreate(distance, carId uint, dt time.Time) (*
 	return mileageModel, nil
 }
 
+// SetDistance corrects the reading, the fuels and orders with it get a new
+// version so the clients reload the distance, call it in a transaction
+func (mr *MileageRepository) SetDistance(obj *models.Mileage, distance uint) error {
+	queries := []mileageQuery{
+		{
+			query:  "UPDATE mileages SET distance = ? WHERE id = ?",
+			params: []any{distance, obj.ID},
+		},
+		{
+			query:  "UPDATE fuels SET version = version + 1 WHERE mileage_id = ?",
+			params: []any{obj.ID},
+		},
+		{
+			query:  "UPDATE orders SET version = version + 1 WHERE mileage_id = ?",
+			params: []any{obj.ID},
+		},
+	}
+
+	return mr.execAll(queries)
+}
+
+// Merge moves the fuels, orders, services, trips and tags of the source
+// reading to the target and removes the source, call it in a transaction
+func (mr *MileageRepository) Merge(source, target *models.Mileage) error {
+	queries := []mileageQuery{
+		{
+			query:  "UPDATE fuels SET mileage_id = ?, version = version + 1 WHERE mileage_id = ?",
+			params: []any{target.ID, source.ID},
+		},
+		{
+			query:  "UPDATE orders SET mileage_id = ?, version = version + 1 WHERE mileage_id = ?",
+			params: []any{target.ID, source.ID},
+		},
+		{
+			query:  "UPDATE services SET mileage_id = ? WHERE mileage_id = ?",
+			params: []any{target.ID, source.ID},
+		},
+		{
+			query:  "UPDATE trips SET start_mileage_id = ? WHERE start_mileage_id = ?",
+			params: []any{target.ID, source.ID},
+		},
+		{
+			query:  "UPDATE trips SET end_mileage_id = ? WHERE end_mileage_id = ?",
+			params: []any{target.ID, source.ID},
+		},
+		{
+			query:  "INSERT IGNORE INTO record_tags (tag_id, entity, record_id) SELECT tag_id, entity, ? FROM record_tags WHERE entity = ? AND record_id = ?",
+			params: []any{target.ID, int(pb.SyncEntity_SYNC_MILEAGE), source.ID},
+		},
+	}
+
+	if err := mr.execAll(queries); err != nil {
+		return err
+	}
+
+	return mr.Delete(source)
+}
+
+// Delete removes the reading, the fuels, orders and services lose their
+// mileage, call it in a transaction
+func (mr *MileageRepository) Delete(obj *models.Mileage) error {
+	queries := []mileageQuery{
+		{
+			query:  "UPDATE fuels SET mileage_id = NULL, version = version + 1 WHERE mileage_id = ?",
+			params: []any{obj.ID},
+		},
+		{
+			query:  "UPDATE orders SET mileage_id = NULL, version = version + 1 WHERE mileage_id = ?",
+			params: []any{obj.ID},
+		},
+		{
+			query:  "UPDATE services SET mileage_id = NULL WHERE mileage_id = ?",
+			params: []any{obj.ID},
+		},
+		{
+			query:  "DELETE FROM record_tags WHERE entity = ? AND record_id = ?",
+			params: []any{int(pb.SyncEntity_SYNC_MILEAGE), obj.ID},
+		},
+		{
+			query:  "DELETE FROM mileages WHERE id = ?",
+			params: []any{obj.ID},
+		},
+	}
+
+	return mr.execAll(queries)
+}
+
+func (mr *MileageRepository) execAll(queries []mileageQuery) error {
+	for _, item := range queries {
+		if _, err := mr.DB.Exec(item.query, item.params...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func milageListQueryExpression(userID uint, filter *filters.MileageFilter) *goqu.SelectDataset {
 	ds := mileageQueryExpression()
 
@@ -259,6 +449,12 @@ func milageListQueryExpression(userID uint, filter *filters.MileageFilter) *goqu
 		})
 	}
 
+	ds = commonFilterExpression(ds, filter, filterColumns{
+		date:   "m.date",
+		id:     "m.id",
+		entity: pb.SyncEntity_SYNC_MILEAGE,
+	})
+
 	return ds
 }
 
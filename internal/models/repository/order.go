@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -11,37 +12,45 @@ import (
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/filters"
 	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
 type OrderRepository struct {
 	DB *database.DB
 }
 
-func (or *OrderRepository) GetOrdersByUser(userID uint, filter *filters.OrderFilter) ([]*models.Order, int, error) {
-	cntDs := orderListQueryExpression(userID, filter)
-	cntDs = cntDs.ClearSelect().Select(goqu.COUNT("o.id"))
+var orderSortColumns = map[string][]exp.Orderable{
+	filters.SortByDate: {goqu.I("o.date"), goqu.I("o.id")},
+	filters.SortByCost: {goqu.I("o.cost"), goqu.I("o.date"), goqu.I("o.id")},
+}
 
-	var count int
-	cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
-	err := or.DB.QueryRow(cntQuery, cntParams...).Scan(&count)
-	if err != nil {
-		return nil, 0, err
-	}
+var orderCursorColumns = []string{"o.date", "o.id"}
 
-	ds := orderListQueryExpression(userID, filter)
-	ds = ds.Order(goqu.I("o.date").Desc(), goqu.I("o.id").Desc())
+func (or *OrderRepository) GetOrdersByUser(userID uint, filter *filters.OrderFilter) ([]*models.Order, models.ListPage, error) {
+	page := models.ListPage{Count: -1}
+	if filter.NeedCount() {
+		cntDs := orderListQueryExpression(userID, filter)
+		cntDs = cntDs.ClearSelect().Select(goqu.COUNT("o.id"))
 
-	if filter.GetLimit() > 0 {
-		ds = ds.Limit(uint(filter.GetLimit()))
-		if filter.GetPage() > 1 {
-			ds = ds.Offset(uint(filter.GetLimit() * (filter.GetPage() - 1)))
+		cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
+		err := or.DB.QueryRow(cntQuery, cntParams...).Scan(&page.Count)
+		if err != nil {
+			return nil, page, err
 		}
 	}
 
+	ds := orderListQueryExpression(userID, filter)
+	ds = sortExpression(ds, filter.GetSort(), orderSortColumns)
+	ds, err := cursorExpression(ds, filter, orderCursorColumns)
+	if err != nil {
+		return nil, page, err
+	}
+	ds = limitExpression(ds, filter)
+
 	query, params, _ := ds.Prepared(true).ToSQL()
 	rows, err := or.DB.Query(query, params...)
 	if err != nil {
-		return nil, 0, err
+		return nil, page, err
 	}
 
 	defer rows.Close()
@@ -73,10 +82,11 @@ func (or *OrderRepository) GetOrdersByUser(userID uint, filter *filters.OrderFil
 			&obj.Distance,
 			&typeFields.ID,
 			&typeFields.Name,
+			&obj.Version,
 			&obj.CreatedAt)
 
 		if err != nil {
-			return nil, 0, err
+			return nil, page, err
 		}
 
 		if carFields.ID.Valid {
@@ -96,7 +106,11 @@ func (or *OrderRepository) GetOrdersByUser(userID uint, filter *filters.OrderFil
 		items = append(items, &obj)
 	}
 
-	return items, count, nil
+	items, page = completePage(items, filter, page, func(obj *models.Order) []string {
+		return []string{obj.Date.Format(time.DateOnly), strconv.FormatUint(uint64(obj.ID), 10)}
+	})
+
+	return items, page, nil
 }
 
 func (or *OrderRepository) Find(id uint) (*models.Order, error) {
@@ -130,6 +144,7 @@ func (or *OrderRepository) Find(id uint) (*models.Order, error) {
 		&obj.Distance,
 		&typeFields.ID,
 		&typeFields.Name,
+		&obj.Version,
 		&obj.CreatedAt)
 
 	if err != nil {
@@ -278,7 +293,13 @@ func (or *OrderRepository) SaveOrder(obj *models.Order, userId uint) (uint, erro
 		data["user_id"] = userId
 		ds = goqu.Dialect("mysql8").Insert("orders").Rows(data)
 	} else {
-		ds = goqu.Dialect("mysql8").Update("orders").Set(data).Where(goqu.Ex{"id": obj.ID})
+		cond := goqu.Ex{"id": obj.ID}
+		if obj.Version > 0 {
+			cond["version"] = obj.Version
+		}
+
+		data["version"] = goqu.L("version + 1")
+		ds = goqu.Dialect("mysql8").Update("orders").Set(data).Where(cond)
 	}
 
 	query, _, err := ds.ToSQL()
@@ -300,9 +321,173 @@ func (or *OrderRepository) SaveOrder(obj *models.Order, userId uint) (uint, erro
 		return uint(lastID), nil
 	}
 
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if affected == 0 {
+		return 0, models.StaleRecord
+	}
+
 	return obj.ID, nil
 }
 
+// GetUnusedOrders returns the purchased orders without an install date
+func (or *OrderRepository) GetUnusedOrders(userID, carID, typeID uint) ([]*models.Order, error) {
+	ds := orderInventoryQueryExpression(userID, carID, typeID)
+	ds = ds.Where(goqu.I("o.used_at").IsNull()).Order(
+		goqu.I("o.date").Asc(),
+		goqu.I("o.id").Asc(),
+	)
+
+	return or.queryOrders(ds)
+}
+
+// GetInstalledOrders returns the typed orders of cars with an install date,
+// sorted by the install date
+func (or *OrderRepository) GetInstalledOrders(userID, carID, typeID uint) ([]*models.Order, error) {
+	ds := orderInventoryQueryExpression(userID, carID, typeID)
+	ds = ds.Where(
+		goqu.I("o.used_at").IsNotNull(),
+		goqu.I("o.car_id").IsNotNull(),
+		goqu.I("o.type_id").IsNotNull(),
+	).Order(
+		goqu.I("o.used_at").Asc(),
+		goqu.I("m.distance").Asc(),
+		goqu.I("o.id").Asc(),
+	)
+
+	return or.queryOrders(ds)
+}
+
+// MarkUsed sets the install date and mileage of the order, the stored
+// mileage is kept without a new one
+func (or *OrderRepository) MarkUsed(obj *models.Order) error {
+	query, err := markUsedQuery(obj)
+	if err != nil {
+		return err
+	}
+
+	res, err := or.DB.Exec(query)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return models.StaleRecord
+	}
+
+	return nil
+}
+
+func markUsedQuery(obj *models.Order) (string, error) {
+	data := goqu.Record{}
+
+	data["used_at"] = obj.UsedAt.Time.Format(time.DateOnly)
+	if obj.Mileage != nil {
+		data["mileage_id"] = obj.Mileage.ID
+	}
+	data["version"] = goqu.L("version + 1")
+
+	cond := goqu.Ex{"id": obj.ID}
+	if obj.Version > 0 {
+		cond["version"] = obj.Version
+	}
+
+	query, _, err := goqu.Dialect("mysql8").Update("orders").Set(data).Where(cond).ToSQL()
+
+	return query, err
+}
+
+func (or *OrderRepository) queryOrders(ds *goqu.SelectDataset) ([]*models.Order, error) {
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := or.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.Order, 0)
+
+	for rows.Next() {
+		obj := models.Order{}
+		carFields := struct {
+			ID    sql.NullInt32
+			Brand sql.NullString
+			Model sql.NullString
+		}{}
+		typeFields := struct {
+			ID   sql.NullInt32
+			Name sql.NullString
+		}{}
+		err = rows.Scan(
+			&obj.ID,
+			&obj.Date,
+			&obj.Cost.Value,
+			&obj.Cost.CurrencyCode,
+			&obj.Description,
+			&obj.Capacity,
+			&obj.UsedAt,
+			&carFields.ID,
+			&carFields.Brand,
+			&carFields.Model,
+			&obj.Distance,
+			&typeFields.ID,
+			&typeFields.Name,
+			&obj.Version,
+			&obj.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if carFields.ID.Valid {
+			obj.Car = &models.Car{
+				ID:    uint(carFields.ID.Int32),
+				Brand: carFields.Brand.String,
+				Model: carFields.Model.String,
+			}
+		}
+		if typeFields.ID.Valid {
+			obj.Type = &models.OrderType{
+				ID:   uint(typeFields.ID.Int32),
+				Name: typeFields.Name.String,
+			}
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, rows.Err()
+}
+
+func orderInventoryQueryExpression(userID, carID, typeID uint) *goqu.SelectDataset {
+	ds := orderQueryExpression()
+
+	ds = ds.Where(goqu.Ex{
+		"o.user_id": userID,
+	})
+
+	if carID > 0 {
+		ds = ds.Where(goqu.Ex{
+			"o.car_id": carID,
+		})
+	}
+
+	if typeID > 0 {
+		ds = ds.Where(goqu.Ex{
+			"o.type_id": typeID,
+		})
+	}
+
+	return ds
+}
+
 func orderListQueryExpression(userID uint, filter *filters.OrderFilter) *goqu.SelectDataset {
 	ds := orderQueryExpression()
 
@@ -322,6 +507,15 @@ func orderListQueryExpression(userID uint, filter *filters.OrderFilter) *goqu.Se
 		})
 	}
 
+	ds = commonFilterExpression(ds, filter, filterColumns{
+		date:        "o.date",
+		cost:        "o.cost",
+		currency:    "cur.code",
+		description: "o.description",
+		id:          "o.id",
+		entity:      pb.SyncEntity_SYNC_ORDER,
+	})
+
 	return ds
 }
 
@@ -340,6 +534,7 @@ func orderQueryExpression() *goqu.SelectDataset {
 		"m.distance",
 		goqu.I("ot.id").As("type_id"),
 		goqu.I("ot.name").As("type_name"),
+		"o.version",
 		"o.created_at",
 	).LeftJoin(
 		goqu.T("cars").As("c"),
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	"xelbot.com/auto-notes/server/internal/models"
+)
+
+func TestMarkUsedQuery(t *testing.T) {
+	// an order with a stored mileage marked as used without a distance
+	order := &models.Order{
+		ID:       7,
+		UsedAt:   sql.NullTime{Time: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), Valid: true},
+		Distance: sql.NullInt32{Int32: 125000, Valid: true},
+		Version:  3,
+	}
+
+	query, err := markUsedQuery(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(query, "mileage_id") {
+		t.Errorf("got %q; want mileage_id unchanged", query)
+	}
+	if !strings.Contains(query, "`used_at`='2026-10-19'") {
+		t.Errorf("got %q; want used_at set", query)
+	}
+
+	order.Mileage = &models.Mileage{ID: 42}
+	query, err = markUsedQuery(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(query, "`mileage_id`=42") {
+		t.Errorf("got %q; want mileage_id set to 42", query)
+	}
+}
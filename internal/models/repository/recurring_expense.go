@@ -0,0 +1,298 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+)
+
+type RecurringExpenseRepository struct {
+	DB *database.DB
+}
+
+func (rr *RecurringExpenseRepository) GetRecurringExpensesByUser(userID uint) ([]*models.RecurringExpense, error) {
+	ds := recurringExpenseQueryExpression().Where(goqu.Ex{
+		"re.user_id": userID,
+	}).Order(goqu.I("re.start_date").Asc(), goqu.I("re.id").Asc())
+
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := rr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.RecurringExpense, 0)
+
+	for rows.Next() {
+		obj, err := scanRecurringExpense(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, obj)
+	}
+
+	return items, nil
+}
+
+func (rr *RecurringExpenseRepository) Find(id uint) (*models.RecurringExpense, error) {
+	ds := recurringExpenseQueryExpression().Where(goqu.Ex{"re.id": id})
+
+	return rr.findOne(ds)
+}
+
+// FindForUpdate locks the template until the end of the transaction,
+// so concurrent schedulers do not create the same expense twice
+func (rr *RecurringExpenseRepository) FindForUpdate(id uint) (*models.RecurringExpense, error) {
+	ds := recurringExpenseQueryExpression().Where(goqu.Ex{"re.id": id}).ForUpdate(exp.Wait)
+
+	return rr.findOne(ds)
+}
+
+// GetDueIDs returns the templates after the id with expenses due on the day
+func (rr *RecurringExpenseRepository) GetDueIDs(day time.Time, afterID, limit uint) ([]uint, error) {
+	query := `
+		SELECT
+			re.id
+		FROM recurring_expenses AS re
+		WHERE re.next_date <= ? AND re.id > ?
+		ORDER BY re.id
+		LIMIT ?`
+
+	rows, err := rr.DB.Query(query, day.Format(time.DateOnly), afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	ids := make([]uint, 0)
+	for rows.Next() {
+		var id uint
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// CreatedDates returns the dates of the expenses created from the template
+func (rr *RecurringExpenseRepository) CreatedDates(id uint) ([]time.Time, error) {
+	rows, err := rr.DB.Query("SELECT date FROM expenses WHERE recurring_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	dates := make([]time.Time, 0)
+	for rows.Next() {
+		var date time.Time
+		if err = rows.Scan(&date); err != nil {
+			return nil, err
+		}
+
+		dates = append(dates, date)
+	}
+
+	return dates, nil
+}
+
+func (rr *RecurringExpenseRepository) SaveRecurringExpense(obj *models.RecurringExpense) (uint, error) {
+	data := goqu.Record{}
+
+	data["currency_id"] = obj.Cost.CurrencyID
+	data["cost"] = fmt.Sprintf("%.2f", 0.01*float64(obj.Cost.Value))
+	data["description"] = obj.Description
+	data["category_id"] = obj.Category.ID
+	data["frequency"] = int(obj.Frequency)
+	data["interval"] = obj.Interval
+	data["start_date"] = obj.StartDate.Format(time.DateOnly)
+	data["occurrences"] = obj.Occurrences
+	data["next_date"] = recurringNextDate(obj)
+
+	if obj.Car != nil {
+		data["car_id"] = obj.Car.ID
+	} else {
+		data["car_id"] = nil
+	}
+
+	if obj.EndDate.Valid {
+		data["end_date"] = obj.EndDate.Time.Format(time.DateOnly)
+	} else {
+		data["end_date"] = nil
+	}
+
+	var ds exp.SQLExpression
+	if obj.ID == 0 {
+		data["user_id"] = obj.UserID
+		ds = goqu.Dialect("mysql8").Insert("recurring_expenses").Rows(data)
+	} else {
+		ds = goqu.Dialect("mysql8").Update("recurring_expenses").Set(data).Where(goqu.Ex{"id": obj.ID})
+	}
+
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := rr.DB.Exec(query)
+	if err != nil {
+		return 0, err
+	}
+
+	if obj.ID == 0 {
+		lastID, err := res.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		return uint(lastID), nil
+	}
+
+	return obj.ID, nil
+}
+
+// UpdateProgress stores the number of the created expenses
+func (rr *RecurringExpenseRepository) UpdateProgress(obj *models.RecurringExpense) error {
+	query := `
+		UPDATE recurring_expenses
+		SET occurrences = ?, next_date = ?
+		WHERE id = ?`
+
+	_, err := rr.DB.Exec(query, obj.Occurrences, recurringNextDate(obj), obj.ID)
+
+	return err
+}
+
+// Delete removes the template, the created expenses are kept
+func (rr *RecurringExpenseRepository) Delete(id uint) error {
+	if _, err := rr.DB.Exec("UPDATE expenses SET recurring_id = NULL WHERE recurring_id = ?", id); err != nil {
+		return err
+	}
+
+	_, err := rr.DB.Exec("DELETE FROM recurring_expenses WHERE id = ?", id)
+
+	return err
+}
+
+func (rr *RecurringExpenseRepository) findOne(ds *goqu.SelectDataset) (*models.RecurringExpense, error) {
+	query, params, _ := ds.Prepared(true).ToSQL()
+
+	obj, err := scanRecurringExpense(rr.DB.QueryRow(query, params...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.RecordNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+func scanRecurringExpense(row interface{ Scan(dest ...any) error }) (*models.RecurringExpense, error) {
+	obj := models.RecurringExpense{
+		Category: &models.ExpenseCategory{},
+	}
+	carFields := struct {
+		ID    sql.NullInt32
+		Brand sql.NullString
+		Model sql.NullString
+	}{}
+
+	err := row.Scan(
+		&obj.ID,
+		&obj.UserID,
+		&obj.Cost.Value,
+		&obj.Cost.CurrencyID,
+		&obj.Cost.CurrencyCode,
+		&obj.Description,
+		&carFields.ID,
+		&carFields.Brand,
+		&carFields.Model,
+		&obj.Category.ID,
+		&obj.Category.UserID,
+		&obj.Category.ParentID,
+		&obj.Category.Name,
+		&obj.Category.Type,
+		&obj.Frequency,
+		&obj.Interval,
+		&obj.StartDate,
+		&obj.EndDate,
+		&obj.Occurrences,
+		&obj.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if carFields.ID.Valid {
+		obj.Car = &models.Car{
+			ID:    uint(carFields.ID.Int32),
+			Brand: carFields.Brand.String,
+			Model: carFields.Model.String,
+		}
+	}
+
+	return &obj, nil
+}
+
+func recurringNextDate(obj *models.RecurringExpense) any {
+	if next, ok := obj.NextDate(); ok {
+		return next.Format(time.DateOnly)
+	}
+
+	return nil
+}
+
+func recurringExpenseQueryExpression() *goqu.SelectDataset {
+	return goqu.Dialect("mysql8").From(goqu.T("recurring_expenses").As("re")).Select(
+		"re.id",
+		"re.user_id",
+		goqu.L("CAST(re.cost * 100 AS SIGNED INT)").As("cost"),
+		"re.currency_id",
+		goqu.I("cur.code").As("curr_code"),
+		"re.description",
+		goqu.I("c.id").As("car_id"),
+		goqu.I("c.brand_name").As("car_brand"),
+		goqu.I("c.model_name").As("car_model"),
+		goqu.I("ec.id").As("category_id"),
+		goqu.I("ec.user_id").As("category_user_id"),
+		goqu.I("ec.parent_id").As("category_parent_id"),
+		goqu.I("ec.name").As("category_name"),
+		goqu.I("ec.type").As("category_type"),
+		"re.frequency",
+		"re.interval",
+		"re.start_date",
+		"re.end_date",
+		"re.occurrences",
+		"re.created_at",
+	).InnerJoin(
+		goqu.T("currencies").As("cur"),
+		goqu.On(goqu.Ex{
+			"cur.id": goqu.I("re.currency_id"),
+		}),
+	).InnerJoin(
+		goqu.T("expense_categories").As("ec"),
+		goqu.On(goqu.Ex{
+			"ec.id": goqu.I("re.category_id"),
+		}),
+	).LeftJoin(
+		goqu.T("cars").As("c"),
+		goqu.On(goqu.Ex{
+			"c.id": goqu.I("re.car_id"),
+		}),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -11,37 +12,46 @@ import (
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/filters"
 	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
 type ServiceRepository struct {
 	DB *database.DB
 }
 
-func (sr *ServiceRepository) GetServicesByUser(userID uint, filter *filters.ServiceFilter) ([]*models.Service, int, error) {
-	cntDs := serviceListQueryExpression(userID, filter)
-	cntDs = cntDs.ClearSelect().Select(goqu.COUNT("s.id"))
+var serviceSortColumns = map[string][]exp.Orderable{
+	filters.SortByDate:     {goqu.I("s.date"), goqu.I("s.id")},
+	filters.SortByCost:     {goqu.I("s.cost"), goqu.I("s.date"), goqu.I("s.id")},
+	filters.SortByDistance: {goqu.I("m.distance"), goqu.I("s.date"), goqu.I("s.id")},
+}
 
-	var count int
-	cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
-	err := sr.DB.QueryRow(cntQuery, cntParams...).Scan(&count)
-	if err != nil {
-		return nil, 0, err
-	}
+var serviceCursorColumns = []string{"s.date", "s.id"}
 
-	ds := serviceListQueryExpression(userID, filter)
-	ds = ds.Order(goqu.I("s.date").Desc(), goqu.I("s.id").Desc())
+func (sr *ServiceRepository) GetServicesByUser(userID uint, filter *filters.ServiceFilter) ([]*models.Service, models.ListPage, error) {
+	page := models.ListPage{Count: -1}
+	if filter.NeedCount() {
+		cntDs := serviceListQueryExpression(userID, filter)
+		cntDs = cntDs.ClearSelect().Select(goqu.COUNT("s.id"))
 
-	if filter.GetLimit() > 0 {
-		ds = ds.Limit(uint(filter.GetLimit()))
-		if filter.GetPage() > 1 {
-			ds = ds.Offset(uint(filter.GetLimit() * (filter.GetPage() - 1)))
+		cntQuery, cntParams, _ := cntDs.Prepared(true).ToSQL()
+		err := sr.DB.QueryRow(cntQuery, cntParams...).Scan(&page.Count)
+		if err != nil {
+			return nil, page, err
 		}
 	}
 
+	ds := serviceListQueryExpression(userID, filter)
+	ds = sortExpression(ds, filter.GetSort(), serviceSortColumns)
+	ds, err := cursorExpression(ds, filter, serviceCursorColumns)
+	if err != nil {
+		return nil, page, err
+	}
+	ds = limitExpression(ds, filter)
+
 	query, params, _ := ds.Prepared(true).ToSQL()
 	rows, err := sr.DB.Query(query, params...)
 	if err != nil {
-		return nil, 0, err
+		return nil, page, err
 	}
 
 	defer rows.Close()
@@ -72,7 +82,7 @@ func (sr *ServiceRepository) GetServicesByUser(userID uint, filter *filters.Serv
 			&obj.CreatedAt)
 
 		if err != nil {
-			return nil, 0, err
+			return nil, page, err
 		}
 
 		if carFields.ID.Valid {
@@ -92,7 +102,11 @@ func (sr *ServiceRepository) GetServicesByUser(userID uint, filter *filters.Serv
 		items = append(items, &obj)
 	}
 
-	return items, count, nil
+	items, page = completePage(items, filter, page, func(obj *models.Service) []string {
+		return []string{obj.Date.Format(time.DateOnly), strconv.FormatUint(uint64(obj.ID), 10)}
+	})
+
+	return items, page, nil
 }
 
 func (sr *ServiceRepository) Find(id uint) (*models.Service, error) {
@@ -238,6 +252,15 @@ func serviceListQueryExpression(userID uint, filter *filters.ServiceFilter) *goq
 		})
 	}
 
+	ds = commonFilterExpression(ds, filter, filterColumns{
+		date:        "s.date",
+		cost:        "s.cost",
+		currency:    "cur.code",
+		description: "s.description",
+		id:          "s.id",
+		entity:      pb.SyncEntity_SYNC_SERVICE,
+	})
+
 	return ds
 }
 
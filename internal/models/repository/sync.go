@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+)
+
+type SyncRepository struct {
+	DB *database.DB
+}
+
+// syncSafetyLag holds back the latest changes: the ids are taken on insert,
+// so a transaction committed later may add a change with a lower id than
+// the ones a client has already got. The transactions of the server are
+// short, the changes older than the lag are complete
+const syncSafetyLag = 5
+
+// GetChanges returns the latest change of each record of the user after
+// the cursor, older changes of the same record are skipped
+func (sr *SyncRepository) GetChanges(userID uint, cursor uint64, limit int) ([]*models.SyncChange, error) {
+	query := `
+		SELECT
+			sc.id,
+			sc.entity,
+			sc.record_id,
+			sc.deleted
+		FROM sync_changes AS sc
+		WHERE sc.user_id = ?
+			AND sc.id > ?
+			AND sc.created_at < NOW() - INTERVAL ? SECOND
+			AND NOT EXISTS (
+				SELECT 1
+				FROM sync_changes AS sc2
+				WHERE sc2.user_id = sc.user_id
+					AND sc2.entity = sc.entity
+					AND sc2.record_id = sc.record_id
+					AND sc2.id > sc.id
+			)
+		ORDER BY sc.id
+		LIMIT ?`
+
+	rows, err := sr.DB.Query(query, userID, cursor, syncSafetyLag, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.SyncChange, 0)
+
+	for rows.Next() {
+		obj := models.SyncChange{}
+		err = rows.Scan(
+			&obj.ID,
+			&obj.Entity,
+			&obj.RecordID,
+			&obj.Deleted)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,310 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type TagRepository struct {
+	DB *database.DB
+}
+
+// taggedCostTables are the tables of the record types with a cost
+var taggedCostTables = []struct {
+	entity pb.SyncEntity
+	table  string
+}{
+	{entity: pb.SyncEntity_SYNC_FUEL, table: "fuels"},
+	{entity: pb.SyncEntity_SYNC_ORDER, table: "orders"},
+	{entity: pb.SyncEntity_SYNC_EXPENSE, table: "expenses"},
+	{entity: pb.SyncEntity_SYNC_SERVICE, table: "services"},
+}
+
+func (tr *TagRepository) GetTagsByUser(userID uint) ([]*models.Tag, error) {
+	ds := tagQueryExpression().Where(goqu.Ex{
+		"t.user_id": userID,
+	}).Order(goqu.I("t.name").Asc())
+
+	return tr.queryTags(ds)
+}
+
+// GetTagsByIDs returns the tags of the user among the ids
+func (tr *TagRepository) GetTagsByIDs(userID uint, ids []uint) ([]*models.Tag, error) {
+	ds := tagQueryExpression().Where(goqu.Ex{
+		"t.user_id": userID,
+		"t.id":      ids,
+	}).Order(goqu.I("t.name").Asc())
+
+	return tr.queryTags(ds)
+}
+
+// GetRecordTags returns the tags of the records of the type by the record id
+func (tr *TagRepository) GetRecordTags(entity pb.SyncEntity, recordIDs []uint) (map[uint][]*models.Tag, error) {
+	result := make(map[uint][]*models.Tag)
+	if len(recordIDs) == 0 {
+		return result, nil
+	}
+
+	ds := tagQueryExpression().SelectAppend("rt.record_id").InnerJoin(
+		goqu.T("record_tags").As("rt"),
+		goqu.On(goqu.Ex{
+			"rt.tag_id": goqu.I("t.id"),
+		}),
+	).Where(goqu.Ex{
+		"rt.entity":    int(entity),
+		"rt.record_id": recordIDs,
+	}).Order(goqu.I("t.name").Asc())
+
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := tr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var recordID uint
+		obj := models.Tag{}
+		err = rows.Scan(
+			&obj.ID,
+			&obj.UserID,
+			&obj.Name,
+			&obj.CreatedAt,
+			&recordID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result[recordID] = append(result[recordID], &obj)
+	}
+
+	return result, nil
+}
+
+func (tr *TagRepository) Find(id uint) (*models.Tag, error) {
+	return tr.findBy(goqu.Ex{"t.id": id})
+}
+
+func (tr *TagRepository) FindByName(userID uint, name string) (*models.Tag, error) {
+	return tr.findBy(goqu.Ex{
+		"t.user_id": userID,
+		"t.name":    name,
+	})
+}
+
+func (tr *TagRepository) SaveTag(obj *models.Tag) (uint, error) {
+	data := goqu.Record{}
+
+	data["name"] = obj.Name
+
+	var ds exp.SQLExpression
+	if obj.ID == 0 {
+		data["user_id"] = obj.UserID
+		ds = goqu.Dialect("mysql8").Insert("tags").Rows(data)
+	} else {
+		ds = goqu.Dialect("mysql8").Update("tags").Set(data).Where(goqu.Ex{"id": obj.ID})
+	}
+
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tr.DB.Exec(query)
+	if err != nil {
+		return 0, err
+	}
+
+	if obj.ID == 0 {
+		lastID, err := res.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		return uint(lastID), nil
+	}
+
+	return obj.ID, nil
+}
+
+// Delete removes the tag from the records and the tag itself, call it in a transaction
+func (tr *TagRepository) Delete(id uint) error {
+	if _, err := tr.DB.Exec("DELETE FROM record_tags WHERE tag_id = ?", id); err != nil {
+		return err
+	}
+
+	_, err := tr.DB.Exec("DELETE FROM tags WHERE id = ?", id)
+
+	return err
+}
+
+// SetRecordTags replaces the tags of the record, call it in a transaction
+func (tr *TagRepository) SetRecordTags(entity pb.SyncEntity, recordID uint, tagIDs []uint) error {
+	query := `
+		DELETE FROM record_tags
+		WHERE entity = ? AND record_id = ?`
+
+	if _, err := tr.DB.Exec(query, int(entity), recordID); err != nil {
+		return err
+	}
+
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]any, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		rows = append(rows, goqu.Record{
+			"tag_id":    tagID,
+			"entity":    int(entity),
+			"record_id": recordID,
+		})
+	}
+
+	query, _, err := goqu.Dialect("mysql8").Insert("record_tags").Rows(rows...).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = tr.DB.Exec(query)
+
+	return err
+}
+
+// GetTaggedRecords returns the date and the cost of every record with the tag
+func (tr *TagRepository) GetTaggedRecords(tagID uint) ([]*models.TaggedRecord, error) {
+	parts := make([]string, 0, len(taggedCostTables)+1)
+	params := make([]any, 0, 2*(len(taggedCostTables)+1))
+
+	for _, source := range taggedCostTables {
+		parts = append(parts, fmt.Sprintf(`
+			SELECT
+				rt.entity,
+				r.date,
+				CAST(r.cost * 100 AS SIGNED INT) AS cost,
+				cur.code AS curr_code
+			FROM record_tags AS rt
+				INNER JOIN %s AS r ON r.id = rt.record_id
+				LEFT JOIN currencies AS cur ON cur.id = r.currency_id
+			WHERE rt.tag_id = ? AND rt.entity = ?`, source.table))
+		params = append(params, tagID, int(source.entity))
+	}
+
+	parts = append(parts, `
+			SELECT
+				rt.entity,
+				m.date,
+				NULL AS cost,
+				NULL AS curr_code
+			FROM record_tags AS rt
+				INNER JOIN mileages AS m ON m.id = rt.record_id
+			WHERE rt.tag_id = ? AND rt.entity = ?`)
+	params = append(params, tagID, int(pb.SyncEntity_SYNC_MILEAGE))
+
+	rows, err := tr.DB.Query(strings.Join(parts, "\n\t\tUNION ALL"), params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.TaggedRecord, 0)
+
+	for rows.Next() {
+		obj := models.TaggedRecord{}
+		costFields := struct {
+			Value        sql.NullInt32
+			CurrencyCode sql.NullString
+		}{}
+		err = rows.Scan(
+			&obj.Entity,
+			&obj.Date,
+			&costFields.Value,
+			&costFields.CurrencyCode)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if costFields.Value.Valid && costFields.CurrencyCode.Valid {
+			obj.Cost = &models.Cost{
+				Value:        costFields.Value.Int32,
+				CurrencyCode: costFields.CurrencyCode.String,
+			}
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, nil
+}
+
+func (tr *TagRepository) queryTags(ds *goqu.SelectDataset) ([]*models.Tag, error) {
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := tr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.Tag, 0)
+
+	for rows.Next() {
+		obj := models.Tag{}
+		err = rows.Scan(
+			&obj.ID,
+			&obj.UserID,
+			&obj.Name,
+			&obj.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, nil
+}
+
+func (tr *TagRepository) findBy(cond goqu.Ex) (*models.Tag, error) {
+	ds := tagQueryExpression().Where(cond)
+	query, params, _ := ds.Prepared(true).ToSQL()
+
+	obj := models.Tag{}
+	err := tr.DB.QueryRow(query, params...).Scan(
+		&obj.ID,
+		&obj.UserID,
+		&obj.Name,
+		&obj.CreatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.RecordNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &obj, nil
+}
+
+func tagQueryExpression() *goqu.SelectDataset {
+	return goqu.Dialect("mysql8").From(goqu.T("tags").As("t")).Select(
+		"t.id",
+		"t.user_id",
+		"t.name",
+		"t.created_at",
+	)
+}
@@ -0,0 +1,366 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type TripRepository struct {
+	DB *database.DB
+}
+
+func (tr *TripRepository) GetTripsByUser(userID, carID uint, onlyOpen bool) ([]*models.Trip, error) {
+	ds := tripQueryExpression().Where(goqu.Ex{
+		"t.user_id": userID,
+	})
+
+	if carID > 0 {
+		ds = ds.Where(goqu.Ex{"t.car_id": carID})
+	}
+
+	if onlyOpen {
+		ds = ds.Where(goqu.I("t.end_mileage_id").IsNull())
+	}
+
+	ds = ds.Order(goqu.I("ms.date").Desc(), goqu.I("t.id").Desc())
+
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := tr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.Trip, 0)
+
+	for rows.Next() {
+		obj, err := scanTrip(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, obj)
+	}
+
+	return items, nil
+}
+
+func (tr *TripRepository) Find(id uint) (*models.Trip, error) {
+	return tr.findBy(goqu.Ex{"t.id": id})
+}
+
+// FindOpen returns the open trip of the car
+func (tr *TripRepository) FindOpen(carID uint) (*models.Trip, error) {
+	return tr.findBy(goqu.Ex{
+		"t.car_id":         carID,
+		"t.end_mileage_id": nil,
+	})
+}
+
+func (tr *TripRepository) SaveTrip(obj *models.Trip) (uint, error) {
+	data := goqu.Record{}
+
+	data["name"] = obj.Name
+	data["start_mileage_id"] = obj.Start.ID
+
+	if obj.End != nil {
+		data["end_mileage_id"] = obj.End.ID
+	} else {
+		data["end_mileage_id"] = nil
+	}
+
+	var ds exp.SQLExpression
+	if obj.ID == 0 {
+		data["user_id"] = obj.UserID
+		data["car_id"] = obj.Car.ID
+		ds = goqu.Dialect("mysql8").Insert("trips").Rows(data)
+	} else {
+		ds = goqu.Dialect("mysql8").Update("trips").Set(data).Where(goqu.Ex{"id": obj.ID})
+	}
+
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tr.DB.Exec(query)
+	if err != nil {
+		return 0, err
+	}
+
+	if obj.ID == 0 {
+		lastID, err := res.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		return uint(lastID), nil
+	}
+
+	return obj.ID, nil
+}
+
+func (tr *TripRepository) Delete(id uint) error {
+	_, err := tr.DB.Exec("DELETE FROM trips WHERE id = ?", id)
+
+	return err
+}
+
+// HasMileage checks whether a trip starts or ends with the reading
+func (tr *TripRepository) HasMileage(mileageID uint) (bool, error) {
+	query := `
+		SELECT
+			COUNT(t.id)
+		FROM trips AS t
+		WHERE t.start_mileage_id = ? OR t.end_mileage_id = ?`
+
+	var cnt int
+	err := tr.DB.QueryRow(query, mileageID, mileageID).Scan(&cnt)
+
+	return cnt > 0, err
+}
+
+// GetTripRecords returns the fuels and the services of the car within the
+// mileage of the trip, or within its dates for the records without a mileage,
+// and the expenses within the dates. The records at the start reading are
+// left out as the ones made before the trip
+func (tr *TripRepository) GetTripRecords(trip *models.Trip, endDate time.Time) ([]*models.TripRecord, error) {
+	fuelDs := goqu.Dialect("mysql8").From(goqu.T("fuels").As("f")).Select(
+		goqu.V(int(pb.SyncEntity_SYNC_FUEL)).As("entity"),
+		goqu.I("f.date").As("r_date"),
+		goqu.L("CAST(f.cost * 100 AS SIGNED INT)").As("cost"),
+		goqu.I("cur.code").As("curr_code"),
+		goqu.L("CAST(f.value * 100 AS SIGNED INT)").As("value"),
+		goqu.V(0).As("type"),
+	).LeftJoin(
+		goqu.T("currencies").As("cur"),
+		goqu.On(goqu.Ex{
+			"cur.id": goqu.I("f.currency_id"),
+		}),
+	).LeftJoin(
+		goqu.T("mileages").As("m"),
+		goqu.On(goqu.Ex{
+			"m.id": goqu.I("f.mileage_id"),
+		}),
+	).Where(
+		goqu.Ex{"f.car_id": trip.Car.ID},
+		tripRangeExpression("f.date", trip, endDate),
+	)
+
+	serviceDs := goqu.Dialect("mysql8").From(goqu.T("services").As("s")).Select(
+		goqu.V(int(pb.SyncEntity_SYNC_SERVICE)).As("entity"),
+		goqu.I("s.date").As("r_date"),
+		goqu.L("CAST(s.cost * 100 AS SIGNED INT)").As("cost"),
+		goqu.I("cur.code").As("curr_code"),
+		goqu.V(0).As("value"),
+		goqu.V(0).As("type"),
+	).LeftJoin(
+		goqu.T("currencies").As("cur"),
+		goqu.On(goqu.Ex{
+			"cur.id": goqu.I("s.currency_id"),
+		}),
+	).LeftJoin(
+		goqu.T("mileages").As("m"),
+		goqu.On(goqu.Ex{
+			"m.id": goqu.I("s.mileage_id"),
+		}),
+	).Where(
+		goqu.Ex{"s.car_id": trip.Car.ID},
+		tripRangeExpression("s.date", trip, endDate),
+	)
+
+	// expenses without a car, tolls for example, count for the trips of all the cars
+	expenseDs := goqu.Dialect("mysql8").From(goqu.T("expenses").As("e")).Select(
+		goqu.V(int(pb.SyncEntity_SYNC_EXPENSE)).As("entity"),
+		goqu.I("e.date").As("r_date"),
+		goqu.L("CAST(e.cost * 100 AS SIGNED INT)").As("cost"),
+		goqu.I("cur.code").As("curr_code"),
+		goqu.V(0).As("value"),
+		goqu.I("e.type").As("type"),
+	).LeftJoin(
+		goqu.T("currencies").As("cur"),
+		goqu.On(goqu.Ex{
+			"cur.id": goqu.I("e.currency_id"),
+		}),
+	).Where(
+		goqu.Ex{"e.user_id": trip.UserID},
+		goqu.Or(
+			goqu.I("e.car_id").Eq(trip.Car.ID),
+			goqu.I("e.car_id").IsNull(),
+		),
+		goqu.I("e.date").Between(goqu.Range(
+			trip.Start.Date.Format(time.DateOnly),
+			endDate.Format(time.DateOnly),
+		)),
+	)
+
+	ds := fuelDs.UnionAll(serviceDs).UnionAll(expenseDs)
+
+	query, params, _ := ds.Prepared(true).ToSQL()
+	rows, err := tr.DB.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.TripRecord, 0)
+
+	for rows.Next() {
+		obj := models.TripRecord{}
+		costFields := struct {
+			Value        sql.NullInt32
+			CurrencyCode sql.NullString
+		}{}
+		err = rows.Scan(
+			&obj.Entity,
+			&obj.Date,
+			&costFields.Value,
+			&costFields.CurrencyCode,
+			&obj.Value,
+			&obj.ExpenseType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if costFields.Value.Valid && costFields.CurrencyCode.Valid {
+			obj.Cost = &models.Cost{
+				Value:        costFields.Value.Int32,
+				CurrencyCode: costFields.CurrencyCode.String,
+			}
+		}
+
+		items = append(items, &obj)
+	}
+
+	return items, nil
+}
+
+func (tr *TripRepository) findBy(cond goqu.Ex) (*models.Trip, error) {
+	ds := tripQueryExpression().Where(cond)
+	query, params, _ := ds.Prepared(true).ToSQL()
+
+	obj, err := scanTrip(tr.DB.QueryRow(query, params...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.RecordNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+func scanTrip(row interface{ Scan(dest ...any) error }) (*models.Trip, error) {
+	obj := models.Trip{
+		Car:   &models.Car{},
+		Start: &models.Mileage{},
+	}
+	endFields := struct {
+		ID        sql.NullInt32
+		Date      sql.NullTime
+		Distance  sql.NullInt32
+		CreatedAt sql.NullTime
+	}{}
+
+	err := row.Scan(
+		&obj.ID,
+		&obj.UserID,
+		&obj.Name,
+		&obj.Car.ID,
+		&obj.Car.Brand,
+		&obj.Car.Model,
+		&obj.Start.ID,
+		&obj.Start.Date,
+		&obj.Start.Distance,
+		&obj.Start.CreatedAt,
+		&endFields.ID,
+		&endFields.Date,
+		&endFields.Distance,
+		&endFields.CreatedAt,
+		&obj.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	obj.Start.Car = obj.Car
+	if endFields.ID.Valid {
+		obj.End = &models.Mileage{
+			ID:        uint(endFields.ID.Int32),
+			Distance:  uint(endFields.Distance.Int32),
+			Date:      endFields.Date.Time,
+			Car:       obj.Car,
+			CreatedAt: endFields.CreatedAt.Time,
+		}
+	}
+
+	return &obj, nil
+}
+
+// tripRangeExpression matches the records with a mileage within the trip
+// and the records without a mileage within its dates
+func tripRangeExpression(dateColumn string, trip *models.Trip, endDate time.Time) exp.Expression {
+	distance := []exp.Expression{
+		goqu.I("m.distance").Gt(trip.Start.Distance),
+	}
+	if trip.End != nil {
+		distance = append(distance, goqu.I("m.distance").Lte(trip.End.Distance))
+	}
+
+	return goqu.Or(
+		goqu.And(distance...),
+		goqu.And(
+			goqu.I("m.id").IsNull(),
+			goqu.I(dateColumn).Between(goqu.Range(
+				trip.Start.Date.Format(time.DateOnly),
+				endDate.Format(time.DateOnly),
+			)),
+		),
+	)
+}
+
+func tripQueryExpression() *goqu.SelectDataset {
+	return goqu.Dialect("mysql8").From(goqu.T("trips").As("t")).Select(
+		"t.id",
+		"t.user_id",
+		"t.name",
+		goqu.I("c.id").As("car_id"),
+		goqu.I("c.brand_name").As("car_brand"),
+		goqu.I("c.model_name").As("car_model"),
+		goqu.I("ms.id").As("start_id"),
+		goqu.I("ms.date").As("start_date"),
+		goqu.I("ms.distance").As("start_distance"),
+		goqu.I("ms.created_at").As("start_created_at"),
+		goqu.I("me.id").As("end_id"),
+		goqu.I("me.date").As("end_date"),
+		goqu.I("me.distance").As("end_distance"),
+		goqu.I("me.created_at").As("end_created_at"),
+		"t.created_at",
+	).InnerJoin(
+		goqu.T("cars").As("c"),
+		goqu.On(goqu.Ex{
+			"c.id": goqu.I("t.car_id"),
+		}),
+	).InnerJoin(
+		goqu.T("mileages").As("ms"),
+		goqu.On(goqu.Ex{
+			"ms.id": goqu.I("t.start_mileage_id"),
+		}),
+	).LeftJoin(
+		goqu.T("mileages").As("me"),
+		goqu.On(goqu.Ex{
+			"me.id": goqu.I("t.end_mileage_id"),
+		}),
+	)
+}
@@ -27,6 +27,9 @@ func (usr *UserSettingRepository) GetUserSettings(userID uint) (*models.UserSett
 			cr.created_at AS cr_created_at,
 			ft.id AS ft_id,
 			ft.name AS ft_name,
+			us.distance_unit,
+			us.volume_unit,
+			us.timezone,
 			us.created_at,
 			us.updated_at
 		FROM user_settings AS us
@@ -48,6 +51,9 @@ func (usr *UserSettingRepository) GetUserSettings(userID uint) (*models.UserSett
 		&obj.CurrencyCreatedAt,
 		&obj.FuelTypeID,
 		&obj.FuelTypeName,
+		&obj.DistanceUnit,
+		&obj.VolumeUnit,
+		&obj.Timezone,
 		&obj.CreatedAt,
 		&obj.UpdatedAt)
 
@@ -62,6 +68,18 @@ func (usr *UserSettingRepository) GetUserSettings(userID uint) (*models.UserSett
 	return &obj, nil
 }
 
+// GetTimezone returns the timezone name of the user, empty without
+// the settings
+func (usr *UserSettingRepository) GetTimezone(userID uint) (string, error) {
+	var timezone string
+	err := usr.DB.QueryRow("SELECT timezone FROM user_settings WHERE user_id = ?", userID).Scan(&timezone)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+
+	return timezone, err
+}
+
 func (usr *UserSettingRepository) SaveUserSettings(settings *models.UserSetting, userId uint) error {
 	if settings == nil {
 		return errors.New("user settings cannot be null")
@@ -84,6 +102,10 @@ func (usr *UserSettingRepository) SaveUserSettings(settings *models.UserSetting,
 		data["default_fuel_type_id"] = nil
 	}
 
+	data["distance_unit"] = int(settings.DistanceUnit)
+	data["volume_unit"] = int(settings.VolumeUnit)
+	data["timezone"] = settings.Timezone
+
 	var ds exp.SQLExpression
 	if settings.ID == 0 {
 		data["user_id"] = userId
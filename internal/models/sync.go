@@ -0,0 +1,28 @@
+package models
+
+import pb "xelbot.com/auto-notes/server/rpc/server"
+
+const (
+	SyncEntityFuel         = "FUEL"
+	SyncEntityOrder        = "ORDER"
+	SyncEntityExpense      = "EXPENSE"
+	SyncEntityService      = "SERVICE"
+	SyncEntityMileage      = "MILEAGE"
+	SyncEntityCar          = "CAR"
+	SyncEntityUserSettings = "USER_SETTINGS"
+)
+
+type SyncChange struct {
+	ID       uint64
+	Entity   string
+	RecordID uint
+	Deleted  bool
+}
+
+func (sc *SyncChange) ToRpcMessage() *pb.SyncChange {
+	return &pb.SyncChange{
+		Entity:  pb.SyncEntity(pb.SyncEntity_value["SYNC_"+sc.Entity]),
+		Id:      int32(sc.RecordID),
+		Deleted: sc.Deleted,
+	}
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type Tag struct {
+	ID        uint
+	UserID    uint
+	Name      string
+	CreatedAt time.Time
+}
+
+func (t *Tag) ToRpcMessage() *pb.Tag {
+	return &pb.Tag{
+		Id:        int32(t.ID),
+		Name:      t.Name,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+}
+
+// TaggedRecord is a record with the tag, Cost is nil for mileages
+type TaggedRecord struct {
+	Entity pb.SyncEntity
+	Date   time.Time
+	Cost   *Cost
+}
+
+// NewTagSummary sums the costs of the tagged records per record type and
+// currency. The converted total is filled only with a convert function,
+// records without an exchange rate are counted as unconverted
+func NewTagSummary(tag *Tag, records []*TaggedRecord, convert func(*Cost, time.Time) *pb.Cost) *pb.TagSummary {
+	summary := &pb.TagSummary{
+		Tag:      tag.ToRpcMessage(),
+		Entities: make([]*pb.TagEntityTotal, 0),
+	}
+
+	entities := make(map[pb.SyncEntity]*pb.TagEntityTotal)
+	entityCosts := make(map[pb.SyncEntity]map[string]int32)
+	totals := make(map[string]int32)
+
+	if convert != nil {
+		summary.ConvertedTotal = &pb.Cost{}
+	}
+
+	for _, record := range records {
+		total, found := entities[record.Entity]
+		if !found {
+			total = &pb.TagEntityTotal{Entity: record.Entity}
+			entities[record.Entity] = total
+			entityCosts[record.Entity] = make(map[string]int32)
+			summary.Entities = append(summary.Entities, total)
+		}
+		total.Count++
+
+		if record.Cost == nil || record.Cost.CurrencyCode == "" {
+			continue
+		}
+
+		entityCosts[record.Entity][record.Cost.CurrencyCode] += record.Cost.Value
+		totals[record.Cost.CurrencyCode] += record.Cost.Value
+
+		if convert != nil {
+			if converted := convert(record.Cost, record.Date); converted != nil {
+				summary.ConvertedTotal.Value += converted.Value
+				summary.ConvertedTotal.Currency = converted.Currency
+			} else {
+				summary.Unconverted++
+			}
+		}
+	}
+
+	sort.Slice(summary.Entities, func(i, j int) bool {
+		return summary.Entities[i].Entity < summary.Entities[j].Entity
+	})
+
+	for _, total := range summary.Entities {
+		total.Costs = costsByCurrency(entityCosts[total.Entity])
+	}
+	summary.Costs = costsByCurrency(totals)
+
+	if summary.ConvertedTotal != nil && summary.ConvertedTotal.Currency == "" {
+		summary.ConvertedTotal = nil
+	}
+
+	return summary
+}
+
+func costsByCurrency(values map[string]int32) []*pb.Cost {
+	costs := make([]*pb.Cost, 0, len(values))
+	for code, value := range values {
+		costs = append(costs, &pb.Cost{
+			Value:    value,
+			Currency: code,
+		})
+	}
+
+	sort.Slice(costs, func(i, j int) bool {
+		return costs[i].Currency < costs[j].Currency
+	})
+
+	return costs
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+func TestTagSummary(t *testing.T) {
+	tag := &Tag{ID: 1, Name: "Summer trip"}
+	day := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	records := []*TaggedRecord{
+		{Entity: pb.SyncEntity_SYNC_EXPENSE, Date: day, Cost: &Cost{Value: 5000, CurrencyCode: "RUB"}},
+		{Entity: pb.SyncEntity_SYNC_FUEL, Date: day, Cost: &Cost{Value: 300000, CurrencyCode: "RUB"}},
+		{Entity: pb.SyncEntity_SYNC_FUEL, Date: day.AddDate(0, 0, 2), Cost: &Cost{Value: 4000, CurrencyCode: "EUR"}},
+		{Entity: pb.SyncEntity_SYNC_FUEL, Date: day.AddDate(0, 0, 3), Cost: &Cost{Value: 1000, CurrencyCode: "EUR"}},
+		{Entity: pb.SyncEntity_SYNC_MILEAGE, Date: day},
+	}
+
+	convert := func(cost *Cost, date time.Time) *pb.Cost {
+		switch {
+		case cost.CurrencyCode == "RUB":
+			return &pb.Cost{Value: cost.Value, Currency: "RUB"}
+		case date.Equal(day.AddDate(0, 0, 2)):
+			return &pb.Cost{Value: cost.Value * 100, Currency: "RUB"}
+		}
+
+		return nil
+	}
+
+	summary := NewTagSummary(tag, records, convert)
+
+	if summary.Tag.GetName() != "Summer trip" {
+		t.Errorf("got tag %q; want Summer trip", summary.Tag.GetName())
+	}
+
+	entities := summary.GetEntities()
+	if len(entities) != 3 {
+		t.Fatalf("got %d record types; want 3", len(entities))
+	}
+
+	fuel := entities[0]
+	if fuel.GetEntity() != pb.SyncEntity_SYNC_FUEL || fuel.GetCount() != 3 {
+		t.Errorf("got %s with %d records; want SYNC_FUEL with 3", fuel.GetEntity(), fuel.GetCount())
+	}
+	if len(fuel.GetCosts()) != 2 || fuel.Costs[0].GetCurrency() != "EUR" || fuel.Costs[0].GetValue() != 5000 {
+		t.Errorf("got fuel costs %v; want 5000 EUR first", fuel.GetCosts())
+	}
+
+	mileage := entities[2]
+	if mileage.GetEntity() != pb.SyncEntity_SYNC_MILEAGE || mileage.GetCount() != 1 || len(mileage.GetCosts()) != 0 {
+		t.Errorf("got mileage total %v; want one record without costs", mileage)
+	}
+
+	if len(summary.GetCosts()) != 2 || summary.Costs[1].GetValue() != 305000 {
+		t.Errorf("got costs %v; want 305000 RUB", summary.GetCosts())
+	}
+
+	if summary.ConvertedTotal.GetValue() != 705000 || summary.ConvertedTotal.GetCurrency() != "RUB" {
+		t.Errorf("got converted total %v; want 705000 RUB", summary.ConvertedTotal)
+	}
+	if summary.GetUnconverted() != 1 {
+		t.Errorf("got %d unconverted; want 1", summary.GetUnconverted())
+	}
+
+	if NewTagSummary(tag, records, nil).ConvertedTotal != nil {
+		t.Error("converted total without a default currency")
+	}
+}
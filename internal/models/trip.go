@@ -0,0 +1,147 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type Trip struct {
+	ID     uint
+	UserID uint
+	Name   string
+	Car    *Car
+	Start  *Mileage
+	// nil while the trip is open
+	End       *Mileage
+	CreatedAt time.Time
+}
+
+func (t *Trip) IsOpen() bool {
+	return t.End == nil
+}
+
+func (t *Trip) ToRpcMessage() *pb.Trip {
+	message := &pb.Trip{
+		Id:        int32(t.ID),
+		Name:      t.Name,
+		Start:     t.Start.ToRpcMessage(),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+
+	if t.Car != nil {
+		message.Car = &pb.Car{
+			Id:   int32(t.Car.ID),
+			Name: t.Car.Brand + " " + t.Car.Model,
+		}
+	}
+
+	if t.End != nil {
+		message.End = t.End.ToRpcMessage()
+	}
+
+	return message
+}
+
+// TripRecord is a fuel, an expense or a service within a trip
+type TripRecord struct {
+	Entity pb.SyncEntity
+	Date   time.Time
+	// nil for a service without a cost
+	Cost *Cost
+	// fuel volume, integer value as in Fuel
+	Value       int32
+	ExpenseType pb.ExpenseType
+}
+
+// NewTripReport sums the records of the trip per kind and currency, the
+// converted total and the cost per km are filled only with a convert function.
+// The distance in km and the volumes are given in the units
+func NewTripReport(trip *Trip, distance int32, records []*TripRecord, convert func(*Cost, time.Time) *pb.Cost, units Units) *pb.TripReport {
+	report := &pb.TripReport{
+		Trip:  trip.ToRpcMessage(),
+		Units: units.ToRpcMessage(),
+	}
+
+	var litres int32
+
+	fuelCosts := make(map[string]int32)
+	tollCosts := make(map[string]int32)
+	parkingCosts := make(map[string]int32)
+	otherCosts := make(map[string]int32)
+	serviceCosts := make(map[string]int32)
+	totals := make(map[string]int32)
+
+	if convert != nil {
+		report.ConvertedTotal = &pb.Cost{}
+	}
+
+	for _, record := range records {
+		var costs map[string]int32
+
+		switch record.Entity {
+		case pb.SyncEntity_SYNC_FUEL:
+			report.FuelCount++
+			litres += record.Value
+			costs = fuelCosts
+		case pb.SyncEntity_SYNC_EXPENSE:
+			report.ExpenseCount++
+			switch record.ExpenseType {
+			case pb.ExpenseType_ROAD:
+				costs = tollCosts
+			case pb.ExpenseType_PARKING:
+				costs = parkingCosts
+			default:
+				costs = otherCosts
+			}
+		case pb.SyncEntity_SYNC_SERVICE:
+			report.ServiceCount++
+			costs = serviceCosts
+		default:
+			continue
+		}
+
+		if record.Cost == nil {
+			continue
+		}
+
+		code := record.Cost.CurrencyCode
+		costs[code] += record.Cost.Value
+		totals[code] += record.Cost.Value
+
+		if convert != nil {
+			if converted := convert(record.Cost, record.Date); converted != nil {
+				report.ConvertedTotal.Value += converted.Value
+				report.ConvertedTotal.Currency = converted.Currency
+			} else {
+				report.Unconverted++
+			}
+		}
+	}
+
+	report.FuelCosts = costsByCurrency(fuelCosts)
+	report.TollCosts = costsByCurrency(tollCosts)
+	report.ParkingCosts = costsByCurrency(parkingCosts)
+	report.OtherCosts = costsByCurrency(otherCosts)
+	report.ServiceCosts = costsByCurrency(serviceCosts)
+	report.Costs = costsByCurrency(totals)
+
+	if report.ConvertedTotal != nil && report.ConvertedTotal.Currency == "" {
+		report.ConvertedTotal = nil
+	}
+
+	report.Distance = int32(math.Round(units.Distance(float64(distance))))
+	report.FuelValue = int32(math.Round(units.Volume(float64(litres))))
+
+	if distance > 0 {
+		// the values are multiplied by 100
+		report.Consumption = int32(math.Round(100 * units.Consumption(float64(litres)/100, float64(distance))))
+		if report.ConvertedTotal != nil {
+			report.CostPerKm = int32(math.Round(float64(report.ConvertedTotal.Value) / units.Distance(float64(distance))))
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+func TestTripReport(t *testing.T) {
+	day := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	trip := &Trip{
+		ID:    1,
+		Name:  "Summer trip",
+		Car:   &Car{ID: 1},
+		Start: &Mileage{ID: 10, Distance: 120000, Date: day},
+	}
+
+	rub := func(value int32) *Cost {
+		return &Cost{Value: value, CurrencyCode: "RUB"}
+	}
+
+	records := []*TripRecord{
+		{Entity: pb.SyncEntity_SYNC_FUEL, Date: day, Cost: rub(300000), Value: 5000},
+		{Entity: pb.SyncEntity_SYNC_FUEL, Date: day.AddDate(0, 0, 2), Cost: &Cost{Value: 7000, CurrencyCode: "EUR"}, Value: 4000},
+		{Entity: pb.SyncEntity_SYNC_EXPENSE, Date: day, Cost: rub(50000), ExpenseType: pb.ExpenseType_ROAD},
+		{Entity: pb.SyncEntity_SYNC_EXPENSE, Date: day, Cost: rub(20000), ExpenseType: pb.ExpenseType_PARKING},
+		{Entity: pb.SyncEntity_SYNC_EXPENSE, Date: day, Cost: rub(10000), ExpenseType: pb.ExpenseType_WASHING},
+		{Entity: pb.SyncEntity_SYNC_SERVICE, Date: day, Cost: rub(120000)},
+		{Entity: pb.SyncEntity_SYNC_SERVICE, Date: day},
+	}
+
+	convert := func(cost *Cost, _ time.Time) *pb.Cost {
+		if cost.CurrencyCode == "RUB" {
+			return &pb.Cost{Value: cost.Value, Currency: "RUB"}
+		}
+
+		return nil
+	}
+
+	report := NewTripReport(trip, 1500, records, convert, Units{})
+
+	if report.GetFuelCount() != 2 || report.GetFuelValue() != 9000 {
+		t.Errorf("got %d fuels with %d; want 2 with 9000", report.GetFuelCount(), report.GetFuelValue())
+	}
+	if report.GetConsumption() != 600 {
+		t.Errorf("got consumption %d; want 600", report.GetConsumption())
+	}
+	if len(report.GetFuelCosts()) != 2 {
+		t.Errorf("got fuel costs %v; want two currencies", report.GetFuelCosts())
+	}
+	if len(report.GetTollCosts()) != 1 || report.TollCosts[0].GetValue() != 50000 {
+		t.Errorf("got toll costs %v; want 50000 RUB", report.GetTollCosts())
+	}
+	if len(report.GetParkingCosts()) != 1 || report.ParkingCosts[0].GetValue() != 20000 {
+		t.Errorf("got parking costs %v; want 20000 RUB", report.GetParkingCosts())
+	}
+	if len(report.GetOtherCosts()) != 1 || report.OtherCosts[0].GetValue() != 10000 {
+		t.Errorf("got other costs %v; want 10000 RUB", report.GetOtherCosts())
+	}
+	if report.GetExpenseCount() != 3 || report.GetServiceCount() != 2 {
+		t.Errorf("got %d expenses and %d services; want 3 and 2", report.GetExpenseCount(), report.GetServiceCount())
+	}
+
+	if report.ConvertedTotal.GetValue() != 500000 || report.GetUnconverted() != 1 {
+		t.Errorf("got converted total %v with %d unconverted; want 500000 RUB with 1", report.ConvertedTotal, report.GetUnconverted())
+	}
+	if report.GetCostPerKm() != 333 {
+		t.Errorf("got cost per km %d; want 333", report.GetCostPerKm())
+	}
+
+	imperial := NewTripReport(trip, 1500, records, convert, Units{
+		DistanceUnit: pb.DistanceUnit_DISTANCE_MILES,
+		VolumeUnit:   pb.VolumeUnit_VOLUME_IMPERIAL_GALLONS,
+	})
+	if imperial.GetDistance() != 932 || imperial.GetFuelValue() != 1980 {
+		t.Errorf("got %d miles with %d gallons; want 932 with 1980", imperial.GetDistance(), imperial.GetFuelValue())
+	}
+	if imperial.GetConsumption() != 4708 || imperial.GetUnits().GetConsumption() != pb.ConsumptionUnit_CONSUMPTION_MPG {
+		t.Errorf("got consumption %d (%s); want 4708 MPG", imperial.GetConsumption(), imperial.GetUnits().GetConsumption())
+	}
+	if imperial.GetCostPerKm() != 536 {
+		t.Errorf("got cost per mile %d; want 536", imperial.GetCostPerKm())
+	}
+
+	empty := NewTripReport(trip, 0, nil, nil, Units{})
+	if empty.GetConsumption() != 0 || empty.ConvertedTotal != nil || empty.GetTrip().GetEnd() != nil {
+		t.Errorf("got report %v for an open trip without records", empty)
+	}
+}
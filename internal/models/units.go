@@ -0,0 +1,82 @@
+package models
+
+import (
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const (
+	kmPerMile               = 1.609344
+	litresPerUSGallon       = 3.785411784
+	litresPerImperialGallon = 4.54609
+)
+
+// Units converts the values stored in km and litres to the units
+// preferred by the user, the zero value is metric
+type Units struct {
+	DistanceUnit pb.DistanceUnit
+	VolumeUnit   pb.VolumeUnit
+}
+
+func (u Units) Distance(km float64) float64 {
+	return km / u.kmPerUnit()
+}
+
+func (u Units) Volume(litres float64) float64 {
+	return litres / u.litresPerUnit()
+}
+
+// PerVolume converts a price per litre to the price per volume unit
+func (u Units) PerVolume(perLitre float64) float64 {
+	return perLitre * u.litresPerUnit()
+}
+
+// IsMPG means the consumption is given in miles per gallon
+func (u Units) IsMPG() bool {
+	return u.DistanceUnit == pb.DistanceUnit_DISTANCE_MILES && u.VolumeUnit != pb.VolumeUnit_VOLUME_LITRES
+}
+
+// Consumption returns the volume per 100 distance units or the miles
+// per gallon, 0 without the volume or the distance
+func (u Units) Consumption(litres, km float64) float64 {
+	if litres <= 0 || km <= 0 {
+		return 0
+	}
+
+	if u.IsMPG() {
+		return u.Distance(km) / u.Volume(litres)
+	}
+
+	return 100 * u.Volume(litres) / u.Distance(km)
+}
+
+func (u Units) ToRpcMessage() *pb.Units {
+	message := &pb.Units{
+		Distance: u.DistanceUnit,
+		Volume:   u.VolumeUnit,
+	}
+
+	if u.IsMPG() {
+		message.Consumption = pb.ConsumptionUnit_CONSUMPTION_MPG
+	}
+
+	return message
+}
+
+func (u Units) kmPerUnit() float64 {
+	if u.DistanceUnit == pb.DistanceUnit_DISTANCE_MILES {
+		return kmPerMile
+	}
+
+	return 1
+}
+
+func (u Units) litresPerUnit() float64 {
+	switch u.VolumeUnit {
+	case pb.VolumeUnit_VOLUME_US_GALLONS:
+		return litresPerUSGallon
+	case pb.VolumeUnit_VOLUME_IMPERIAL_GALLONS:
+		return litresPerImperialGallon
+	default:
+		return 1
+	}
+}
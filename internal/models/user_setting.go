@@ -3,6 +3,9 @@ package models
 import (
 	"database/sql"
 	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
 type UserSetting struct {
@@ -21,4 +24,54 @@ type UserSetting struct {
 	CurrencyName      sql.NullString
 	CurrencyCode      sql.NullString
 	CurrencyCreatedAt sql.NullTime
+
+	DistanceUnit pb.DistanceUnit
+	VolumeUnit   pb.VolumeUnit
+	// IANA name, empty for the server timezone
+	Timezone string
+}
+
+func (us *UserSetting) Units() Units {
+	return Units{
+		DistanceUnit: us.DistanceUnit,
+		VolumeUnit:   us.VolumeUnit,
+	}
+}
+
+func (us *UserSetting) ToRpcMessage() *pb.UserSettings {
+	message := &pb.UserSettings{
+		Id:           int32(us.ID),
+		CreatedAt:    timestamppb.New(us.CreatedAt),
+		DistanceUnit: us.DistanceUnit.Enum(),
+		VolumeUnit:   us.VolumeUnit.Enum(),
+		Timezone:     &us.Timezone,
+	}
+
+	if us.CarID.Valid {
+		message.DefaultCar = &pb.Car{
+			Id:   us.CarID.Int32,
+			Name: us.CarBrand.String + " " + us.CarModel.String,
+		}
+	}
+	if us.CurrencyID.Valid {
+		message.DefaultCurrency = &pb.Currency{
+			Id:   us.CurrencyID.Int32,
+			Name: us.CurrencyName.String,
+			Code: us.CurrencyCode.String,
+		}
+		if us.CurrencyCreatedAt.Valid {
+			message.DefaultCurrency.CreatedAt = timestamppb.New(us.CurrencyCreatedAt.Time)
+		}
+	}
+	if us.FuelTypeID.Valid {
+		message.DefaultFuelType = &pb.FuelType{
+			Id:   us.FuelTypeID.Int32,
+			Name: us.FuelTypeName.String,
+		}
+	}
+	if us.UpdatedAt.Valid {
+		message.UpdatedAt = timestamppb.New(us.UpdatedAt.Time)
+	}
+
+	return message
 }
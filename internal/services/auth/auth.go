@@ -36,7 +36,7 @@ func (auth *AuthService) GetToken(ctx context.Context, req *pb.LoginRequest) (*p
 		return nil, twirp.InvalidArgument.Error("password is required")
 	}
 
-	repo := repository.UserRepository{DB: auth.app.DB}
+	repo := repository.UserRepository{DB: auth.app.DB.WithContext(ctx)}
 	user, err := repo.GetUserByUsername(req.Username)
 	if err != nil {
 		if errors.Is(err, models.RecordNotFound) {
@@ -85,7 +85,7 @@ func (auth *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenR
 
 	auth.app.Debug("Auth.RefreshToken: parsed claims", ctx, "claims", claims)
 
-	repo := repository.UserRepository{DB: auth.app.DB}
+	repo := repository.UserRepository{DB: auth.app.DB.WithContext(ctx)}
 	user, err := repo.GetUserByUsername(claims.Username)
 	if err != nil {
 		if errors.Is(err, models.RecordNotFound) {
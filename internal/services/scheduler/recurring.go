@@ -0,0 +1,137 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+)
+
+const recurringBatchSize = 100
+
+// a day comes first in UTC+14, the templates due by its date are checked
+// against the current date of their owners
+var latestZone = time.FixedZone("UTC+14", 14*60*60)
+
+// RecurringExpenses creates the expenses of the recurring templates
+// when their dates come
+type RecurringExpenses struct {
+	app application.Container
+}
+
+func NewRecurringExpenses(app application.Container) *RecurringExpenses {
+	return &RecurringExpenses{app: app}
+}
+
+// Run checks the templates at start and then every interval until
+// the context is cancelled
+func (re *RecurringExpenses) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		re.createDue(ctx, application.TodayIn(latestZone))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (re *RecurringExpenses) createDue(ctx context.Context, day time.Time) {
+	repo := repository.RecurringExpenseRepository{DB: re.app.DB.WithContext(ctx)}
+
+	var created int
+	var lastID uint
+	for {
+		// a failed template stays due, so the pages go by id
+		ids, err := repo.GetDueIDs(day, lastID, recurringBatchSize)
+		if err != nil {
+			re.app.ServerError(ctx, err)
+			return
+		}
+
+		for _, id := range ids {
+			lastID = id
+			cnt, err := re.createExpenses(ctx, id)
+			if err != nil {
+				// the template stays due, the next run retries it
+				re.app.Error("RecurringExpenses: create expenses failed", ctx, "id", id, "err", err.Error())
+				continue
+			}
+			created += cnt
+		}
+
+		if len(ids) < recurringBatchSize {
+			break
+		}
+	}
+
+	if created > 0 {
+		re.app.Info("RecurringExpenses: expenses created", ctx, "cnt", created)
+	}
+}
+
+func (re *RecurringExpenses) createExpenses(ctx context.Context, id uint) (int, error) {
+	var cnt int
+	err := re.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		repo := repository.RecurringExpenseRepository{DB: tx}
+		obj, err := repo.FindForUpdate(id)
+		if err != nil {
+			return err
+		}
+
+		// the dates come in the timezone of the owner as in SaveRecurringExpense
+		settingsRepo := repository.UserSettingRepository{DB: tx}
+		loc, err := application.UserLocation(obj.UserID, settingsRepo.GetTimezone)
+		if err != nil {
+			return err
+		}
+
+		dates := obj.DueDates(application.TodayIn(loc))
+		if len(dates) == 0 {
+			return nil
+		}
+
+		created, err := repo.CreatedDates(obj.ID)
+		if err != nil {
+			return err
+		}
+
+		// an expense of the date may be left by an earlier schedule
+		exists := models.DateSet(created)
+
+		expenseRepo := repository.ExpenseRepository{DB: tx}
+		for _, date := range dates {
+			if exists[date.Format(time.DateOnly)] {
+				continue
+			}
+
+			expense := models.Expense{
+				Cost:        obj.Cost,
+				Description: obj.Description,
+				Date:        date,
+				Car:         obj.Car,
+				Type:        obj.Category.Type,
+				Category:    obj.Category,
+				RecurringID: sql.NullInt32{Int32: int32(obj.ID), Valid: true},
+			}
+			if _, err = expenseRepo.SaveExpense(&expense, obj.UserID); err != nil {
+				return err
+			}
+			cnt++
+		}
+
+		obj.Occurrences += len(dates)
+
+		return repo.UpdateProgress(obj)
+	})
+
+	return cnt, err
+}
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	"xelbot.com/auto-notes/server/internal/utils/storage"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const (
+	thumbnailSize         = 256
+	maxAttachmentNameSize = 255
+)
+
+// attachmentEntities are the record types files can be attached to
+var attachmentEntities = []pb.SyncEntity{
+	pb.SyncEntity_SYNC_FUEL,
+	pb.SyncEntity_SYNC_ORDER,
+	pb.SyncEntity_SYNC_EXPENSE,
+	pb.SyncEntity_SYNC_SERVICE,
+	pb.SyncEntity_SYNC_CAR,
+}
+
+var attachmentExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+type AttachmentRepositoryService struct {
+	app application.Container
+}
+
+func NewAttachmentRepositoryService(app application.Container) *AttachmentRepositoryService {
+	return &AttachmentRepositoryService{app: app}
+}
+
+func (ar *AttachmentRepositoryService) GetAttachments(ctx context.Context, filter *pb.AttachmentFilter) (*pb.AttachmentCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	db := ar.app.DB.WithContext(ctx)
+	if err = checkRecordOwner(ar.app, ctx, db, user, attachmentEntities, filter.GetEntity(), uint(filter.GetRecordId())); err != nil {
+		return nil, err
+	}
+
+	repo := repository.AttachmentRepository{DB: db}
+	dbItems, err := repo.GetAttachments(filter.GetEntity(), uint(filter.GetRecordId()))
+	if err != nil {
+		return nil, toTwirpError(ar.app, err, ctx)
+	}
+
+	attachments := make([]*pb.Attachment, 0, len(dbItems))
+	for _, dbItem := range dbItems {
+		attachments = append(attachments, dbItem.ToRpcMessage())
+	}
+
+	ar.app.Info("AttachmentRepositoryService: populate attachments", ctx, "cnt", len(dbItems))
+
+	return &pb.AttachmentCollection{Attachments: attachments}, nil
+}
+
+func (ar *AttachmentRepositoryService) UploadAttachment(ctx context.Context, upload *pb.AttachmentUpload) (*pb.Attachment, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	cfg := application.GetConfig().Attachments
+	data := upload.GetData()
+	if len(data) == 0 {
+		return nil, twirp.RequiredArgumentError("data")
+	}
+	if len(data) > cfg.MaxBytes() {
+		return nil, twirp.InvalidArgument.Error(fmt.Sprintf("attachment is larger than %d MB", cfg.MaxSize))
+	}
+
+	mimeType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	if !slices.Contains(cfg.MimeTypes, mimeType) {
+		return nil, twirp.InvalidArgument.Error("unsupported file type " + mimeType)
+	}
+
+	db := ar.app.DB.WithContext(ctx)
+	if err = checkRecordOwner(ar.app, ctx, db, user, attachmentEntities, upload.GetEntity(), uint(upload.GetRecordId())); err != nil {
+		return nil, err
+	}
+
+	store := storage.NewLocal(cfg.Root)
+	ext := attachmentExtensions[mimeType]
+	key, err := storage.NewKey(fmt.Sprintf("%d/%s", user.ID, time.Now().Format("2006/01")), ext)
+	if err != nil {
+		return nil, toTwirpError(ar.app, err, ctx)
+	}
+
+	if err = store.Save(key, data); err != nil {
+		return nil, toTwirpError(ar.app, err, ctx)
+	}
+
+	obj := models.Attachment{
+		UserID:   user.ID,
+		Entity:   upload.GetEntity(),
+		RecordID: uint(upload.GetRecordId()),
+		Name:     attachmentName(upload.GetName()),
+		MimeType: mimeType,
+		Size:     len(data),
+		Path:     key,
+	}
+
+	if strings.HasPrefix(mimeType, "image/") {
+		// an image the decoders do not support is stored without a thumbnail
+		if thumbnail, err := storage.Thumbnail(data, thumbnailSize); err == nil {
+			thumbnailKey := strings.TrimSuffix(key, ext) + ".thumb.jpg"
+			if err = store.Save(thumbnailKey, thumbnail); err != nil {
+				ar.app.ServerError(ctx, err)
+			} else {
+				obj.ThumbnailPath.Valid = true
+				obj.ThumbnailPath.String = thumbnailKey
+			}
+		}
+	}
+
+	repo := repository.AttachmentRepository{DB: db}
+	id, err := repo.SaveAttachment(&obj)
+	if err != nil {
+		ar.deleteFiles(ctx, store, &obj)
+
+		return nil, toTwirpError(ar.app, err, ctx)
+	}
+
+	dbItem, err := repo.Find(id)
+	if err != nil {
+		return nil, toTwirpError(ar.app, err, ctx)
+	}
+
+	ar.app.Info("AttachmentRepositoryService: attachment uploaded", ctx, "id", id, "size", obj.Size)
+
+	return dbItem.ToRpcMessage(), nil
+}
+
+func (ar *AttachmentRepositoryService) DownloadAttachment(ctx context.Context, req *pb.AttachmentRequest) (*pb.AttachmentContent, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	db := ar.app.DB.WithContext(ctx)
+	obj, err := ar.findAttachment(ctx, db, user, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	key, mimeType := obj.Path, obj.MimeType
+	if req.GetThumbnail() {
+		if !obj.ThumbnailPath.Valid {
+			return nil, twirp.NotFoundError("thumbnail not found")
+		}
+
+		key, mimeType = obj.ThumbnailPath.String, "image/jpeg"
+	}
+
+	data, err := storage.NewLocal(application.GetConfig().Attachments.Root).Read(key)
+	if err != nil {
+		return nil, toTwirpError(ar.app, err, ctx)
+	}
+
+	return &pb.AttachmentContent{
+		Attachment: obj.ToRpcMessage(),
+		MimeType:   mimeType,
+		Data:       data,
+	}, nil
+}
+
+func (ar *AttachmentRepositoryService) DeleteAttachment(ctx context.Context, req *pb.IdRequest) (*emptypb.Empty, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	db := ar.app.DB.WithContext(ctx)
+	obj, err := ar.findAttachment(ctx, db, user, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	repo := repository.AttachmentRepository{DB: db}
+	if err = repo.Delete(obj.ID); err != nil {
+		return nil, toTwirpError(ar.app, err, ctx)
+	}
+
+	ar.deleteFiles(ctx, storage.NewLocal(application.GetConfig().Attachments.Root), obj)
+
+	ar.app.Info("AttachmentRepositoryService: attachment deleted", ctx, "id", obj.ID)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (ar *AttachmentRepositoryService) findAttachment(
+	ctx context.Context,
+	db *database.DB,
+	user *security.UserClaims,
+	id uint,
+) (*models.Attachment, error) {
+	repo := repository.AttachmentRepository{DB: db}
+	obj, err := repo.Find(id)
+	if err != nil {
+		return nil, toTwirpError(ar.app, err, ctx)
+	}
+
+	if obj.UserID != user.ID {
+		return nil, twirp.InvalidArgument.Error("invalid attachment owner")
+	}
+
+	if err = checkRecordOwner(ar.app, ctx, db, user, attachmentEntities, obj.Entity, obj.RecordID); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (ar *AttachmentRepositoryService) deleteFiles(ctx context.Context, store *storage.Local, obj *models.Attachment) {
+	keys := []string{obj.Path}
+	if obj.ThumbnailPath.Valid {
+		keys = append(keys, obj.ThumbnailPath.String)
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			ar.app.ServerError(ctx, err)
+		}
+	}
+}
+
+// attachmentName keeps the base name of the client file within the column size
+func attachmentName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+
+	for len(name) > maxAttachmentNameSize {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+
+	return name
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const batchMaxItems = 100
+
+// saveBatch saves the items with lookups shared by the whole batch. In the
+// all-or-nothing mode the items are saved in a transaction and the first
+// failed item fails the call, otherwise every failed item gets its own error
+func saveBatch[T proto.Message](
+	app application.Container,
+	ctx context.Context,
+	mode pb.BatchMode,
+	items []T,
+	save func(lookups *saveLookups, item T) (T, error),
+) ([]T, []*pb.BatchError, error) {
+	if len(items) == 0 {
+		return nil, nil, twirp.InvalidArgument.Error("empty batch")
+	}
+	if len(items) > batchMaxItems {
+		return nil, nil, twirp.InvalidArgument.Error(fmt.Sprintf("too many items, %d at most", batchMaxItems))
+	}
+
+	results := make([]T, len(items))
+	errs := make([]*pb.BatchError, len(items))
+
+	if mode == pb.BatchMode_BATCH_BEST_EFFORT {
+		lookups := newSaveLookups(app.DB.WithContext(ctx))
+		for i, item := range items {
+			saved, err := save(lookups, item)
+			if err != nil {
+				errs[i] = batchError(err)
+				continue
+			}
+			results[i] = saved
+		}
+
+		return results, errs, nil
+	}
+
+	err := app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		lookups := newSaveLookups(tx)
+		for i, item := range items {
+			saved, err := save(lookups, item)
+			if err != nil {
+				return batchItemError(i, err)
+			}
+			results[i] = saved
+		}
+
+		return nil
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, nil, err
+		}
+
+		return nil, nil, toTwirpError(app, err, ctx)
+	}
+
+	return results, errs, nil
+}
+
+func batchError(err error) *pb.BatchError {
+	var twerr twirp.Error
+	if !errors.As(err, &twerr) {
+		twerr = twirp.InternalError("internal error")
+	}
+
+	return &pb.BatchError{
+		Code:    string(twerr.Code()),
+		Message: twerr.Msg(),
+		Meta:    twerr.MetaMap(),
+	}
+}
+
+// batchItemError adds the index of the failed item to its error
+func batchItemError(idx int, err error) error {
+	var twerr twirp.Error
+	if !errors.As(err, &twerr) {
+		return err
+	}
+
+	itemErr := twirp.NewError(twerr.Code(), fmt.Sprintf("item %d: %s", idx, twerr.Msg()))
+	for key, value := range twerr.MetaMap() {
+		itemErr = itemErr.WithMeta(key, value)
+	}
+
+	return itemErr.WithMeta("index", strconv.Itoa(idx))
+}
@@ -3,12 +3,14 @@ package server
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/twitchtv/twirp"
 	"xelbot.com/auto-notes/server/internal/application"
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/filters"
 	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
 	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
@@ -27,30 +29,48 @@ func (cr *CarRepositoryService) GetServices(ctx context.Context, pbFilter *pb.Se
 	}
 
 	filter := filters.NewServiceFilter(pbFilter)
+	if !filter.HasValidSort() {
+		return nil, twirp.InvalidArgument.Error("invalid sort field")
+	}
 
-	repo := repository.ServiceRepository{DB: cr.app.DB}
-	dbItems, cntItems, err := repo.GetServicesByUser(user.ID, filter)
+	repo := repository.ServiceRepository{DB: cr.app.DB.WithContext(ctx)}
+	dbItems, page, err := repo.GetServicesByUser(user.ID, filter)
 	if err != nil {
 		return nil, toTwirpError(cr.app, err, ctx)
 	}
 
-	if pageOutOfRange(filter, cntItems) {
+	if pageOutOfRange(filter, page) {
 		return nil, twirp.NotFoundError("services not found")
 	}
 
 	items := make([]*pb.Service, 0, len(dbItems))
+	converter, err := newCurrencyConverter(cr.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	err = loadRates(converter, dbItems, func(obj *models.Service) (*models.Cost, time.Time) { return obj.Cost, obj.Date })
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	tags, err := recordTags(repo.DB, pb.SyncEntity_SYNC_SERVICE, dbItems, func(obj *models.Service) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
 	for _, dbItem := range dbItems {
-		items = append(items, dbItem.ToRpcMessage())
+		message := dbItem.ToRpcMessage()
+		message.Tags = tags[dbItem.ID]
+		message.ConvertedCost = converter.convert(dbItem.Cost, dbItem.Date)
+		items = append(items, message)
 	}
 
 	cr.app.Info("CarRepositoryService: populate services", ctx, "cnt", len(dbItems))
 
 	return &pb.ServiceCollection{
 		Services: items,
-		Meta: &pb.PaginationMeta{
-			Current: int32(filter.GetPage()),
-			Last:    int32(filters.GetLastPage(filter, cntItems)),
-		},
+		Meta:     paginationMeta(filter, page),
 	}, nil
 }
 
@@ -60,7 +80,7 @@ func (cr *CarRepositoryService) FindService(ctx context.Context, idReq *pb.IdReq
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.ServiceRepository{DB: cr.app.DB}
+	repo := repository.ServiceRepository{DB: cr.app.DB.WithContext(ctx)}
 	if idReq.GetId() > 0 {
 		ownerId, err := repo.ServiceOwner(uint(idReq.GetId()))
 		if err != nil {
@@ -78,7 +98,15 @@ func (cr *CarRepositoryService) FindService(ctx context.Context, idReq *pb.IdReq
 		return nil, toTwirpError(cr.app, err, ctx)
 	}
 
-	return dbItem.ToRpcMessage(), nil
+	tags, err := recordTags(repo.DB, pb.SyncEntity_SYNC_SERVICE, []*models.Service{dbItem}, func(obj *models.Service) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	message := dbItem.ToRpcMessage()
+	message.Tags = tags[dbItem.ID]
+
+	return message, nil
 }
 
 func (cr *CarRepositoryService) SaveService(ctx context.Context, service *pb.Service) (*pb.Service, error) {
@@ -87,6 +115,14 @@ func (cr *CarRepositoryService) SaveService(ctx context.Context, service *pb.Ser
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
+	return idempotent(cr.app, ctx, user.ID, "SaveService", service, func() (*pb.Service, error) {
+		return cr.saveService(ctx, user, service)
+	})
+}
+
+func (cr *CarRepositoryService) saveService(ctx context.Context, user *security.UserClaims, service *pb.Service) (*pb.Service, error) {
+	var err error
+
 	if service.GetDate() == nil {
 		return nil, twirp.InvalidArgument.Error("date is required")
 	}
@@ -98,7 +134,7 @@ func (cr *CarRepositoryService) SaveService(ctx context.Context, service *pb.Ser
 			return nil, twirp.InvalidArgument.Error("empty currency code")
 		}
 
-		currencyRepo := repository.CurrencyRepository{DB: cr.app.DB}
+		currencyRepo := repository.CurrencyRepository{DB: cr.app.DB.WithContext(ctx)}
 		currency, err := currencyRepo.GetCurrencyByCode(currencyCode)
 		if err != nil {
 			if errors.Is(err, models.RecordNotFound) {
@@ -116,7 +152,7 @@ func (cr *CarRepositoryService) SaveService(ctx context.Context, service *pb.Ser
 
 	var car *models.Car
 	if service.Car.GetId() > 0 {
-		carRepo := repository.CarRepository{DB: cr.app.DB}
+		carRepo := repository.CarRepository{DB: cr.app.DB.WithContext(ctx)}
 		car, err = carRepo.Find(uint(service.Car.GetId()))
 		if err != nil {
 			if errors.Is(err, models.RecordNotFound) {
@@ -135,7 +171,7 @@ func (cr *CarRepositoryService) SaveService(ctx context.Context, service *pb.Ser
 
 	var mileage *models.Mileage
 	if service.Distance > 0 && car != nil && service.GetDate() != nil {
-		mileageRepo := repository.MileageRepository{DB: cr.app.DB}
+		mileageRepo := repository.MileageRepository{DB: cr.app.DB.WithContext(ctx)}
 		mileage, err = mileageRepo.FindOrCreate(uint(service.Distance), car.ID, service.GetDate().AsTime())
 		if err != nil {
 			return nil, toTwirpError(cr.app, err, ctx)
@@ -151,7 +187,7 @@ func (cr *CarRepositoryService) SaveService(ctx context.Context, service *pb.Ser
 		Mileage:     mileage,
 	}
 
-	repo := repository.ServiceRepository{DB: cr.app.DB}
+	repo := repository.ServiceRepository{DB: cr.app.DB.WithContext(ctx)}
 	serviceID, err := repo.SaveService(&serviceModel, user.ID)
 	if err != nil {
 		return nil, toTwirpError(cr.app, err, ctx)
@@ -172,30 +208,37 @@ func (cr *CarRepositoryService) GetMileages(ctx context.Context, pbFilter *pb.Mi
 	}
 
 	filter := filters.NewMileageFilter(pbFilter)
+	if !filter.HasValidSort() {
+		return nil, twirp.InvalidArgument.Error("invalid sort field")
+	}
 
-	repo := repository.MileageRepository{DB: cr.app.DB}
-	dbTypes, cntItems, err := repo.GetMileagesByUser(user.ID, filter)
+	repo := repository.MileageRepository{DB: cr.app.DB.WithContext(ctx)}
+	dbTypes, page, err := repo.GetMileagesByUser(user.ID, filter)
 	if err != nil {
 		return nil, toTwirpError(cr.app, err, ctx)
 	}
 
-	if pageOutOfRange(filter, cntItems) {
+	if pageOutOfRange(filter, page) {
 		return nil, twirp.NotFoundError("mileages not found")
 	}
 
+	tags, err := recordTags(repo.DB, pb.SyncEntity_SYNC_MILEAGE, dbTypes, func(obj *models.Mileage) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
 	items := make([]*pb.Mileage, 0, len(dbTypes))
 	for _, dbItem := range dbTypes {
-		items = append(items, dbItem.ToRpcMessage())
+		message := dbItem.ToRpcMessage()
+		message.Tags = tags[dbItem.ID]
+		items = append(items, message)
 	}
 
 	cr.app.Info("CarRepositoryService: populate mileages", ctx, "cnt", len(dbTypes))
 
 	return &pb.MileageCollection{
 		Mileages: items,
-		Meta: &pb.PaginationMeta{
-			Current: int32(filter.GetPage()),
-			Last:    int32(filters.GetLastPage(filter, cntItems)),
-		},
+		Meta:     paginationMeta(filter, page),
 	}, nil
 }
 
@@ -205,9 +248,17 @@ func (cr *CarRepositoryService) SaveMileage(ctx context.Context, mileage *pb.Mil
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
+	return idempotent(cr.app, ctx, user.ID, "SaveMileage", mileage, func() (*pb.Mileage, error) {
+		return cr.saveMileage(ctx, user, mileage)
+	})
+}
+
+func (cr *CarRepositoryService) saveMileage(ctx context.Context, user *security.UserClaims, mileage *pb.Mileage) (*pb.Mileage, error) {
+	var err error
+
 	var car *models.Car
 	if mileage.Car.GetId() > 0 {
-		carRepo := repository.CarRepository{DB: cr.app.DB}
+		carRepo := repository.CarRepository{DB: cr.app.DB.WithContext(ctx)}
 		car, err = carRepo.Find(uint(mileage.Car.GetId()))
 		if err != nil {
 			if errors.Is(err, models.RecordNotFound) {
@@ -225,7 +276,7 @@ func (cr *CarRepositoryService) SaveMileage(ctx context.Context, mileage *pb.Mil
 	}
 
 	var dbItem *models.Mileage
-	mileageRepo := repository.MileageRepository{DB: cr.app.DB}
+	mileageRepo := repository.MileageRepository{DB: cr.app.DB.WithContext(ctx)}
 
 	if mileage.GetId() == 0 {
 		dbItem, err = mileageRepo.FindUniq(
@@ -3,8 +3,11 @@ package server
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"xelbot.com/auto-notes/server/internal/application"
 	"xelbot.com/auto-notes/server/internal/constants"
 	"xelbot.com/auto-notes/server/internal/models"
@@ -28,26 +31,79 @@ func userClaimsFromContext(ctx context.Context) (*security.UserClaims, error) {
 	return &user, nil
 }
 
-func pageOutOfRange(filter filters.PaginationPart, cntItems int) bool {
+// userToday returns the current date in the timezone of the user
+// put into the context by middlewares.LocalDates
+func userToday(ctx context.Context) time.Time {
+	if loc, ok := ctx.Value(constants.CtxKeyLocation).(*time.Location); ok {
+		return application.TodayIn(loc)
+	}
+
+	return application.Today()
+}
+
+// adminClaimsFromContext accepts only the users listed in the admins config
+func adminClaimsFromContext(ctx context.Context) (*security.UserClaims, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	if !application.IsAdmin(user.Username) {
+		return nil, twirp.PermissionDenied.Error("admin access required")
+	}
+
+	return user, nil
+}
+
+func pageOutOfRange(filter filters.PaginationPart, page models.ListPage) bool {
 	if filter.GetPage() < 1 {
 		return true
 	}
 
-	if filter.GetPage() > filters.GetLastPage(filter, cntItems) {
+	if page.Count >= 0 && filter.GetPage() > filters.GetLastPage(filter, page.Count) {
 		return true
 	}
 
 	return false
 }
 
+func paginationMeta(filter filters.PaginationPart, page models.ListPage) *pb.PaginationMeta {
+	meta := &pb.PaginationMeta{
+		Current:    int32(filter.GetPage()),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}
+
+	if page.Count >= 0 {
+		meta.Last = int32(filters.GetLastPage(filter, page.Count))
+	}
+
+	return meta
+}
+
 func toTwirpError(app application.Container, err error, ctx context.Context) error {
 	if errors.Is(err, models.RecordNotFound) {
 		return twirp.NotFound.Error(pb.ErrorCode_E001.String() + ": record not found")
 	} else if errors.Is(err, models.InvalidMileage) {
 		return twirp.InvalidArgument.Error(pb.ErrorCode_E002.String() + ": invalid distance")
+	} else if errors.Is(err, filters.InvalidCursor) {
+		return twirp.InvalidArgument.Error("invalid cursor")
 	}
 
 	app.ServerError(ctx, err)
 
 	return twirp.InternalError("internal error")
 }
+
+// staleRecordError rejects an update based on an outdated version,
+// the current server copy is passed in the "record" metadata as JSON
+func staleRecordError(current proto.Message) error {
+	twerr := twirp.Aborted.Error(pb.ErrorCode_E003.String() + ": record was modified by another client")
+
+	data, err := protojson.Marshal(current)
+	if err == nil {
+		twerr = twerr.WithMeta("record", string(data))
+	}
+
+	return twerr
+}
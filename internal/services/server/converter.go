@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+// userUnits returns the units of the statistics preferred by the user,
+// metric by default
+func userUnits(app application.Container, ctx context.Context, userID uint) (models.Units, error) {
+	repo := repository.UserSettingRepository{DB: app.DB.WithContext(ctx)}
+	settings, err := repo.GetUserSettings(userID)
+	if errors.Is(err, models.RecordNotFound) {
+		return models.Units{}, nil
+	} else if err != nil {
+		return models.Units{}, err
+	}
+
+	return settings.Units(), nil
+}
+
+type rateKey struct {
+	code string
+	date string
+}
+
+// currencyConverter converts costs to the default currency of the user,
+// the rates are cached for the request
+type currencyConverter struct {
+	app   application.Container
+	ctx   context.Context
+	repo  repository.ExchangeRateRepository
+	code  string
+	rates map[rateKey]float64
+	// the rates loaded for the listed records by loadRates
+	history models.RateHistory
+}
+
+// newCurrencyConverter returns nil when the user has no default currency
+func newCurrencyConverter(app application.Container, ctx context.Context, userID uint) (*currencyConverter, error) {
+	db := app.DB.WithContext(ctx)
+
+	settingsRepo := repository.UserSettingRepository{DB: db}
+	settings, err := settingsRepo.GetUserSettings(userID)
+	if errors.Is(err, models.RecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !settings.CurrencyCode.Valid {
+		return nil, nil
+	}
+
+	return &currencyConverter{
+		app:   app,
+		ctx:   ctx,
+		repo:  repository.ExchangeRateRepository{DB: db},
+		code:  settings.CurrencyCode.String,
+		rates: make(map[rateKey]float64),
+	}, nil
+}
+
+// convert returns the cost in the default currency by the rate on the date,
+// or nil if there is no rate
+func (cc *currencyConverter) convert(cost *models.Cost, date time.Time) *pb.Cost {
+	if cc == nil || cost == nil || cost.CurrencyCode == "" {
+		return nil
+	}
+
+	if cost.CurrencyCode == cc.code {
+		return &pb.Cost{
+			Value:    cost.Value,
+			Currency: cc.code,
+		}
+	}
+
+	key := rateKey{
+		code: cost.CurrencyCode,
+		date: date.Format(time.DateOnly),
+	}
+
+	rate, found := cc.rates[key]
+	if _, loaded := cc.history[cost.CurrencyCode]; loaded && !found {
+		rate, _ = cc.history.Rate(cost.CurrencyCode, date)
+		cc.rates[key] = rate
+	} else if !found {
+		var err error
+		rate, err = cc.repo.FindRate(cost.CurrencyCode, cc.code, date)
+		if err != nil && !errors.Is(err, models.RecordNotFound) {
+			cc.app.ServerError(cc.ctx, err)
+		}
+		cc.rates[key] = rate
+	}
+
+	if rate == 0 {
+		return nil
+	}
+
+	return &pb.Cost{
+		Value:    int32(math.Round(float64(cost.Value) * rate)),
+		Currency: cc.code,
+	}
+}
+
+// loadRates loads the rates for the costs of the items in one query,
+// so the conversion of a list does not query the rate of every date
+func loadRates[T any](cc *currencyConverter, items []T, cost func(T) (*models.Cost, time.Time)) error {
+	if cc == nil {
+		return nil
+	}
+
+	var from, to time.Time
+	codes := make([]string, 0)
+	for _, item := range items {
+		itemCost, date := cost(item)
+		if itemCost == nil || itemCost.CurrencyCode == "" || itemCost.CurrencyCode == cc.code {
+			continue
+		}
+
+		if !slices.Contains(codes, itemCost.CurrencyCode) {
+			codes = append(codes, itemCost.CurrencyCode)
+		}
+		if from.IsZero() || date.Before(from) {
+			from = date
+		}
+		if date.After(to) {
+			to = date
+		}
+	}
+
+	if len(codes) == 0 {
+		return nil
+	}
+
+	history, err := cc.repo.GetRates(codes, cc.code, from, to)
+	if err != nil {
+		return err
+	}
+
+	// a currency without rates is loaded as well
+	for _, code := range codes {
+		if _, found := history[code]; !found {
+			history[code] = nil
+		}
+	}
+	cc.history = history
+
+	return nil
+}
@@ -3,6 +3,8 @@ package server
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/twitchtv/twirp"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -11,6 +13,8 @@ import (
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/filters"
 	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+	"xelbot.com/auto-notes/server/internal/utils/database"
 	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
@@ -29,40 +33,87 @@ func (fr *FuelRepositoryService) GetFuels(ctx context.Context, pbFilter *pb.Fuel
 	}
 
 	filter := filters.NewFuelFilter(pbFilter)
+	if !filter.HasValidSort() {
+		return nil, twirp.InvalidArgument.Error("invalid sort field")
+	}
 
-	repo := repository.FuelRepository{DB: fr.app.DB}
-	dbFuels, cntFuels, err := repo.GetFuelsByUser(user.ID, filter)
+	repo := repository.FuelRepository{DB: fr.app.DB.WithContext(ctx)}
+	dbFuels, page, err := repo.GetFuelsByUser(user.ID, filter)
 	if err != nil {
 		return nil, toTwirpError(fr.app, err, ctx)
 	}
 
-	if pageOutOfRange(filter, cntFuels) {
+	if pageOutOfRange(filter, page) {
 		return nil, twirp.NotFoundError("fuels not found")
 	}
 
 	fuels := make([]*pb.Fuel, 0, len(dbFuels))
+	converter, err := newCurrencyConverter(fr.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	err = loadRates(converter, dbFuels, func(obj *models.Fuel) (*models.Cost, time.Time) { return &obj.Cost, obj.Date })
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	tags, err := recordTags(repo.DB, pb.SyncEntity_SYNC_FUEL, dbFuels, func(obj *models.Fuel) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
 	for _, dbFuel := range dbFuels {
-		fuels = append(fuels, dbFuel.ToRpcMessage())
+		message := dbFuel.ToRpcMessage()
+		message.Tags = tags[dbFuel.ID]
+		message.ConvertedCost = converter.convert(&dbFuel.Cost, dbFuel.Date)
+		fuels = append(fuels, message)
 	}
 
 	fr.app.Info("FuelRepositoryService: populate fuels", ctx, "cnt", len(dbFuels))
 
 	return &pb.FuelCollection{
 		Fuels: fuels,
-		Meta: &pb.PaginationMeta{
-			Current: int32(filter.GetPage()),
-			Last:    int32(filters.GetLastPage(filter, cntFuels)),
-		},
+		Meta:  paginationMeta(filter, page),
 	}, nil
 }
 
+func (fr *FuelRepositoryService) GetFuelPrices(ctx context.Context, pbFilter *pb.FuelPriceFilter) (*pb.FuelPriceCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	repo := repository.FuelRepository{DB: fr.app.DB.WithContext(ctx)}
+	dbPrices, err := repo.GetFuelPrices(user.ID, filters.NewFuelPriceFilter(pbFilter))
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	units, err := userUnits(fr.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	history := models.NewFuelPriceHistory(dbPrices)
+
+	prices := make([]*pb.FuelPriceHistory, 0, len(history))
+	for _, item := range history {
+		prices = append(prices, item.ToRpcMessage(units))
+	}
+
+	fr.app.Info("FuelRepositoryService: populate fuel prices", ctx, "cnt", len(dbPrices))
+
+	return &pb.FuelPriceCollection{Prices: prices, Units: units.ToRpcMessage()}, nil
+}
+
 func (fr *FuelRepositoryService) GetFillingStations(ctx context.Context, _ *emptypb.Empty) (*pb.FillingStationCollection, error) {
 	_, err := userClaimsFromContext(ctx)
 	if err != nil {
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.FuelRepository{DB: fr.app.DB}
+	repo := repository.FuelRepository{DB: fr.app.DB.WithContext(ctx)}
 	dbStations, err := repo.GetFillingStations()
 	if err != nil {
 		return nil, toTwirpError(fr.app, err, ctx)
@@ -90,7 +141,7 @@ func (fr *FuelRepositoryService) GetFuelTypes(ctx context.Context, _ *emptypb.Em
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.FuelRepository{DB: fr.app.DB}
+	repo := repository.FuelRepository{DB: fr.app.DB.WithContext(ctx)}
 	dbTypes, err := repo.GetFuelTypes()
 	if err != nil {
 		return nil, toTwirpError(fr.app, err, ctx)
@@ -98,17 +149,194 @@ func (fr *FuelRepositoryService) GetFuelTypes(ctx context.Context, _ *emptypb.Em
 
 	types := make([]*pb.FuelType, 0, len(dbTypes))
 	for _, dbItem := range dbTypes {
-		item := &pb.FuelType{
-			Id:   int32(dbItem.ID),
-			Name: dbItem.Name,
+		types = append(types, dbItem.ToRpcMessage())
+	}
+
+	fr.app.Info("FuelRepositoryService: populate fuel types", ctx, "cnt", len(dbTypes))
+
+	return &pb.FuelTypeCollection{
+		Types: types,
+		Tree:  models.FuelTypeTree(dbTypes),
+	}, nil
+}
+
+func (fr *FuelRepositoryService) CreateFuelType(ctx context.Context, fuelType *pb.FuelType) (*pb.FuelType, error) {
+	if _, err := adminClaimsFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	obj := models.FuelType{Name: strings.TrimSpace(fuelType.GetName())}
+	if obj.Name == "" {
+		return nil, twirp.RequiredArgumentError("name")
+	}
+
+	repo := repository.FuelRepository{DB: fr.app.DB.WithContext(ctx)}
+	if fuelType.GetParentId() > 0 {
+		if err := fr.checkParentType(ctx, repo, 0, uint(fuelType.GetParentId())); err != nil {
+			return nil, err
 		}
 
-		types = append(types, item)
+		obj.ParentID.Valid = true
+		obj.ParentID.Int32 = fuelType.GetParentId()
 	}
 
-	fr.app.Info("FuelRepositoryService: populate fuel types", ctx, "cnt", len(dbTypes))
+	id, err := repo.SaveType(&obj)
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
 
-	return &pb.FuelTypeCollection{Types: types}, nil
+	fr.app.Info("FuelRepositoryService: fuel type created", ctx, "id", id)
+
+	return fr.findFuelType(ctx, repo, id)
+}
+
+func (fr *FuelRepositoryService) RenameFuelType(ctx context.Context, fuelType *pb.FuelType) (*pb.FuelType, error) {
+	if _, err := adminClaimsFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(fuelType.GetName())
+	if name == "" {
+		return nil, twirp.RequiredArgumentError("name")
+	}
+
+	repo := repository.FuelRepository{DB: fr.app.DB.WithContext(ctx)}
+	obj, err := repo.FindType(uint(fuelType.GetId()))
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	obj.Name = name
+	if _, err = repo.SaveType(obj); err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	fr.app.Info("FuelRepositoryService: fuel type renamed", ctx, "id", obj.ID)
+
+	return obj.ToRpcMessage(), nil
+}
+
+func (fr *FuelRepositoryService) MoveFuelType(ctx context.Context, fuelType *pb.FuelType) (*pb.FuelType, error) {
+	if _, err := adminClaimsFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	repo := repository.FuelRepository{DB: fr.app.DB.WithContext(ctx)}
+	obj, err := repo.FindType(uint(fuelType.GetId()))
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	obj.ParentID.Valid = fuelType.GetParentId() > 0
+	obj.ParentID.Int32 = fuelType.GetParentId()
+	if obj.ParentID.Valid {
+		if err = fr.checkParentType(ctx, repo, obj.ID, uint(obj.ParentID.Int32)); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err = repo.SaveType(obj); err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	fr.app.Info("FuelRepositoryService: fuel type moved", ctx, "id", obj.ID, "parent", obj.ParentID.Int32)
+
+	return obj.ToRpcMessage(), nil
+}
+
+func (fr *FuelRepositoryService) MergeFuelTypes(ctx context.Context, merge *pb.FuelTypeMerge) (*pb.FuelType, error) {
+	if _, err := adminClaimsFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	if merge.GetSourceId() == merge.GetTargetId() {
+		return nil, twirp.InvalidArgument.Error("source and target must differ")
+	}
+
+	var target *models.FuelType
+	err := fr.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		repo := repository.FuelRepository{DB: tx}
+		source, err := repo.FindType(uint(merge.GetSourceId()))
+		if err != nil {
+			return err
+		}
+
+		target, err = repo.FindType(uint(merge.GetTargetId()))
+		if err != nil {
+			return err
+		}
+
+		// the children of the source would be nested two levels deep
+		if target.ParentID.Valid && target.ParentID.Int32 != int32(source.ID) {
+			cnt, err := repo.CountChildTypes(source.ID)
+			if err != nil {
+				return err
+			}
+			if cnt > 0 {
+				return twirp.InvalidArgument.Error("fuel type with children can be merged only into a root type")
+			}
+		}
+
+		if err = repo.MergeTypes(source, target); err != nil {
+			return err
+		}
+
+		target, err = repo.FindType(target.ID)
+
+		return err
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	fr.app.Info("FuelRepositoryService: fuel types merged", ctx, "source", merge.GetSourceId(), "target", target.ID)
+
+	return target.ToRpcMessage(), nil
+}
+
+// checkParentType allows only root types as parents and does not nest
+// types with children, so the tree stays one level deep
+func (fr *FuelRepositoryService) checkParentType(ctx context.Context, repo repository.FuelRepository, id, parentID uint) error {
+	if id == parentID {
+		return twirp.InvalidArgument.Error("fuel type cannot be its own parent")
+	}
+
+	parent, err := repo.FindType(parentID)
+	if errors.Is(err, models.RecordNotFound) {
+		return twirp.InvalidArgument.Error("invalid parent type")
+	} else if err != nil {
+		return toTwirpError(fr.app, err, ctx)
+	}
+
+	if parent.ParentID.Valid {
+		return twirp.InvalidArgument.Error("parent must be a root type")
+	}
+
+	if id > 0 {
+		cnt, err := repo.CountChildTypes(id)
+		if err != nil {
+			return toTwirpError(fr.app, err, ctx)
+		}
+		if cnt > 0 {
+			return twirp.InvalidArgument.Error("fuel type with children cannot be nested")
+		}
+	}
+
+	return nil
+}
+
+func (fr *FuelRepositoryService) findFuelType(ctx context.Context, repo repository.FuelRepository, id uint) (*pb.FuelType, error) {
+	obj, err := repo.FindType(id)
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	return obj.ToRpcMessage(), nil
 }
 
 func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*pb.Fuel, error) {
@@ -117,6 +345,17 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
+	return idempotent(fr.app, ctx, user.ID, "SaveFuel", fuel, func() (*pb.Fuel, error) {
+		return fr.saveFuel(ctx, newSaveLookups(fr.app.DB.WithContext(ctx)), user, fuel)
+	})
+}
+
+func (fr *FuelRepositoryService) saveFuel(
+	ctx context.Context,
+	lookups *saveLookups,
+	user *security.UserClaims,
+	fuel *pb.Fuel,
+) (*pb.Fuel, error) {
 	currencyCode := fuel.Cost.GetCurrency()
 	if currencyCode == "" {
 		return nil, twirp.InvalidArgument.Error("empty currency code")
@@ -134,7 +373,7 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 		return nil, twirp.InvalidArgument.Error("date is required")
 	}
 
-	fuelRepo := repository.FuelRepository{DB: fr.app.DB}
+	fuelRepo := repository.FuelRepository{DB: lookups.db}
 	if fuel.GetId() > 0 {
 		ownerId, err := fuelRepo.FuelOwner(uint(fuel.GetId()))
 		if err != nil {
@@ -145,7 +384,7 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 		}
 	}
 
-	fuelType, err := fuelRepo.FindType(uint(fuel.Type.GetId()))
+	fuelType, err := lookups.fuelType(uint(fuel.Type.GetId()))
 	if err != nil {
 		if errors.Is(err, models.RecordNotFound) {
 			return nil, twirp.InvalidArgument.Error("invalid fuel type")
@@ -154,8 +393,7 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 		return nil, toTwirpError(fr.app, err, ctx)
 	}
 
-	currencyRepo := repository.CurrencyRepository{DB: fr.app.DB}
-	currency, err := currencyRepo.GetCurrencyByCode(currencyCode)
+	currency, err := lookups.currency(currencyCode)
 	if err != nil {
 		if errors.Is(err, models.RecordNotFound) {
 			return nil, twirp.InvalidArgument.Error("invalid currency")
@@ -166,8 +404,7 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 
 	var car *models.Car
 	if fuel.Car.GetId() > 0 {
-		carRepo := repository.CarRepository{DB: fr.app.DB}
-		car, err = carRepo.Find(uint(fuel.Car.GetId()))
+		car, err = lookups.car(uint(fuel.Car.GetId()))
 		if err != nil {
 			if errors.Is(err, models.RecordNotFound) {
 				return nil, twirp.InvalidArgument.Error("invalid car")
@@ -183,8 +420,7 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 
 	var mileage *models.Mileage
 	if fuel.Distance > 0 && car != nil {
-		mileageRepo := repository.MileageRepository{DB: fr.app.DB}
-		mileage, err = mileageRepo.FindOrCreate(uint(fuel.Distance), car.ID, fuel.Date.AsTime())
+		mileage, err = lookups.mileage(uint(fuel.Distance), car.ID, fuel.Date.AsTime())
 		if err != nil {
 			return nil, toTwirpError(fr.app, err, ctx)
 		}
@@ -197,8 +433,9 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 			Value:      fuel.Cost.GetValue(),
 			CurrencyID: currency.ID,
 		},
-		Value: fuel.GetValue(),
-		Date:  fuel.Date.AsTime(),
+		Value:       fuel.GetValue(),
+		Description: fuel.GetDescription(),
+		Date:        fuel.Date.AsTime(),
 		Station: models.FillingStation{
 			ID: uint(fuel.Station.GetId()),
 		},
@@ -206,10 +443,18 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 			ID: fuelType.ID,
 		},
 		Mileage: mileage,
+		Version: uint(fuel.GetVersion()),
 	}
 
 	fuelID, err := fuelRepo.SaveFuel(&fuelModel, user.ID)
-	if err != nil {
+	if errors.Is(err, models.StaleRecord) {
+		current, err := fuelRepo.Find(fuelModel.ID)
+		if err != nil {
+			return nil, toTwirpError(fr.app, err, ctx)
+		}
+
+		return nil, staleRecordError(current.ToRpcMessage())
+	} else if err != nil {
 		return nil, toTwirpError(fr.app, err, ctx)
 	}
 
@@ -221,13 +466,39 @@ func (fr *FuelRepositoryService) SaveFuel(ctx context.Context, fuel *pb.Fuel) (*
 	return dbFuel.ToRpcMessage(), nil
 }
 
+func (fr *FuelRepositoryService) BatchSaveFuels(ctx context.Context, batch *pb.FuelBatch) (*pb.FuelBatchResult, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	return idempotent(fr.app, ctx, user.ID, "BatchSaveFuels", batch, func() (*pb.FuelBatchResult, error) {
+		saved, errs, err := saveBatch(fr.app, ctx, batch.GetMode(), batch.GetFuels(),
+			func(lookups *saveLookups, item *pb.Fuel) (*pb.Fuel, error) {
+				return fr.saveFuel(ctx, lookups, user, item)
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*pb.FuelBatchItem, 0, len(saved))
+		for i := range saved {
+			items = append(items, &pb.FuelBatchItem{Fuel: saved[i], Error: errs[i]})
+		}
+
+		fr.app.Info("FuelRepositoryService: batch save fuels", ctx, "cnt", len(items))
+
+		return &pb.FuelBatchResult{Items: items}, nil
+	})
+}
+
 func (fr *FuelRepositoryService) FindFuel(ctx context.Context, idReq *pb.IdRequest) (*pb.Fuel, error) {
 	user, err := userClaimsFromContext(ctx)
 	if err != nil {
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	fuelRepo := repository.FuelRepository{DB: fr.app.DB}
+	fuelRepo := repository.FuelRepository{DB: fr.app.DB.WithContext(ctx)}
 	if idReq.GetId() > 0 {
 		ownerId, err := fuelRepo.FuelOwner(uint(idReq.GetId()))
 		if err != nil {
@@ -245,5 +516,13 @@ func (fr *FuelRepositoryService) FindFuel(ctx context.Context, idReq *pb.IdReque
 		return nil, toTwirpError(fr.app, err, ctx)
 	}
 
-	return dbFuel.ToRpcMessage(), nil
+	tags, err := recordTags(fuelRepo.DB, pb.SyncEntity_SYNC_FUEL, []*models.Fuel{dbFuel}, func(obj *models.Fuel) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(fr.app, err, ctx)
+	}
+
+	message := dbFuel.ToRpcMessage()
+	message.Tags = tags[dbFuel.ID]
+
+	return message, nil
 }
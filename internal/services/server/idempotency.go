@@ -0,0 +1,99 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/constants"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+)
+
+const (
+	maxIdempotencyKeyLength = 255
+	// a call without a response after it has failed without releasing the key,
+	// the requests are limited by the 10 second write timeout
+	idempotencyInProgressTimeout = time.Minute
+)
+
+// idempotent runs the save call once per Idempotency-Key and replays
+// the stored response when the client retries the request
+func idempotent[Req, Resp proto.Message](
+	app application.Container,
+	ctx context.Context,
+	userID uint,
+	method string,
+	req Req,
+	save func() (Resp, error),
+) (Resp, error) {
+	var empty Resp
+
+	key, ok := ctx.Value(constants.CtxKeyIdempotency).(string)
+	if !ok || key == "" {
+		return save()
+	}
+
+	if len(key) > maxIdempotencyKeyLength {
+		return empty, twirp.InvalidArgument.Error("idempotency key is too long")
+	}
+
+	reqData, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return empty, toTwirpError(app, err, ctx)
+	}
+	reqHash := sha256.Sum256(reqData)
+
+	repo := repository.IdempotencyRepository{DB: app.DB.WithContext(ctx)}
+	reserved, stored, err := repo.Reserve(&models.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		Method:      method,
+		RequestHash: reqHash[:],
+	}, application.GetConfig().IdempotencyRetention, idempotencyInProgressTimeout)
+	if err != nil {
+		return empty, toTwirpError(app, err, ctx)
+	}
+
+	if !reserved {
+		if stored.Method != method || !bytes.Equal(stored.RequestHash, reqHash[:]) {
+			return empty, twirp.InvalidArgument.Error("idempotency key is reused for another request")
+		}
+		if stored.Response == nil {
+			return empty, twirp.Aborted.Error("request with the same idempotency key is in progress")
+		}
+
+		resp := empty.ProtoReflect().New().Interface().(Resp)
+		if err = proto.Unmarshal(stored.Response, resp); err != nil {
+			return empty, toTwirpError(app, err, ctx)
+		}
+
+		app.Info("Idempotency: replay response", ctx, "method", method)
+
+		return resp, nil
+	}
+
+	resp, err := save()
+	if err != nil {
+		if releaseErr := repo.Release(userID, key); releaseErr != nil {
+			app.ServerError(ctx, releaseErr)
+		}
+
+		return empty, err
+	}
+
+	respData, err := proto.Marshal(resp)
+	if err != nil {
+		return empty, toTwirpError(app, err, ctx)
+	}
+
+	if err = repo.Complete(userID, key, respData); err != nil {
+		app.ServerError(ctx, err)
+	}
+
+	return resp, nil
+}
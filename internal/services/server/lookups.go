@@ -0,0 +1,163 @@
+package server
+
+import (
+	"time"
+
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+type mileageKey struct {
+	distance uint
+	carID    uint
+	date     string
+}
+
+// saveLookups caches the reference records loaded by the save calls,
+// so a batch resolves every currency, car, type or mileage only once
+type saveLookups struct {
+	db *database.DB
+
+	currencies map[string]*models.Currency
+	cars       map[uint]*models.Car
+	fuelTypes  map[uint]*models.FuelType
+	orderTypes map[uint]*models.OrderType
+	mileages   map[mileageKey]*models.Mileage
+
+	expenseCategories map[uint]*models.ExpenseCategory
+	expenseTypes      map[pb.ExpenseType]*models.ExpenseCategory
+}
+
+func newSaveLookups(db *database.DB) *saveLookups {
+	return &saveLookups{
+		db:         db,
+		currencies: make(map[string]*models.Currency),
+		cars:       make(map[uint]*models.Car),
+		fuelTypes:  make(map[uint]*models.FuelType),
+		orderTypes: make(map[uint]*models.OrderType),
+		mileages:   make(map[mileageKey]*models.Mileage),
+
+		expenseCategories: make(map[uint]*models.ExpenseCategory),
+		expenseTypes:      make(map[pb.ExpenseType]*models.ExpenseCategory),
+	}
+}
+
+func (sl *saveLookups) currency(code string) (*models.Currency, error) {
+	if obj, found := sl.currencies[code]; found {
+		return obj, nil
+	}
+
+	repo := repository.CurrencyRepository{DB: sl.db}
+	obj, err := repo.GetCurrencyByCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	sl.currencies[code] = obj
+
+	return obj, nil
+}
+
+func (sl *saveLookups) car(id uint) (*models.Car, error) {
+	if obj, found := sl.cars[id]; found {
+		return obj, nil
+	}
+
+	repo := repository.CarRepository{DB: sl.db}
+	obj, err := repo.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	sl.cars[id] = obj
+
+	return obj, nil
+}
+
+func (sl *saveLookups) fuelType(id uint) (*models.FuelType, error) {
+	if obj, found := sl.fuelTypes[id]; found {
+		return obj, nil
+	}
+
+	repo := repository.FuelRepository{DB: sl.db}
+	obj, err := repo.FindType(id)
+	if err != nil {
+		return nil, err
+	}
+
+	sl.fuelTypes[id] = obj
+
+	return obj, nil
+}
+
+func (sl *saveLookups) orderType(id uint) (*models.OrderType, error) {
+	if obj, found := sl.orderTypes[id]; found {
+		return obj, nil
+	}
+
+	repo := repository.OrderRepository{DB: sl.db}
+	obj, err := repo.FindType(id)
+	if err != nil {
+		return nil, err
+	}
+
+	sl.orderTypes[id] = obj
+
+	return obj, nil
+}
+
+func (sl *saveLookups) expenseCategory(id uint) (*models.ExpenseCategory, error) {
+	if obj, found := sl.expenseCategories[id]; found {
+		return obj, nil
+	}
+
+	repo := repository.ExpenseCategoryRepository{DB: sl.db}
+	obj, err := repo.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	sl.expenseCategories[id] = obj
+
+	return obj, nil
+}
+
+// expenseCategoryByType maps the enum of the old clients to a built-in category
+func (sl *saveLookups) expenseCategoryByType(expenseType pb.ExpenseType) (*models.ExpenseCategory, error) {
+	if obj, found := sl.expenseTypes[expenseType]; found {
+		return obj, nil
+	}
+
+	repo := repository.ExpenseCategoryRepository{DB: sl.db}
+	obj, err := repo.FindByType(expenseType)
+	if err != nil {
+		return nil, err
+	}
+
+	sl.expenseTypes[expenseType] = obj
+
+	return obj, nil
+}
+
+func (sl *saveLookups) mileage(distance, carID uint, date time.Time) (*models.Mileage, error) {
+	key := mileageKey{
+		distance: distance,
+		carID:    carID,
+		date:     date.Format(time.DateOnly),
+	}
+	if obj, found := sl.mileages[key]; found {
+		return obj, nil
+	}
+
+	repo := repository.MileageRepository{DB: sl.db}
+	obj, err := repo.FindOrCreate(distance, carID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	sl.mileages[key] = obj
+
+	return obj, nil
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/twitchtv/twirp"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+// GetMileageAnomalies scans the mileages of the car for the readings which
+// break the growth, imply an implausible daily distance or repeat a date
+func (cr *CarRepositoryService) GetMileageAnomalies(ctx context.Context, idReq *pb.IdRequest) (*pb.MileageAnomalyCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	readings, err := carReadings(cr.app.DB.WithContext(ctx), user, uint(idReq.GetId()))
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	collection := anomalyCollection(readings)
+
+	cr.app.Info("CarRepositoryService: mileage anomalies", ctx, "car", idReq.GetId(), "cnt", len(collection.Anomalies))
+
+	return collection, nil
+}
+
+func (cr *CarRepositoryService) FixMileage(ctx context.Context, fix *pb.MileageFix) (*pb.MileageAnomalyCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	if fix.GetMileageId() <= 0 {
+		return nil, twirp.InvalidArgument.Error("invalid mileage id")
+	}
+
+	switch fix.GetAction() {
+	case pb.MileageFixAction_MILEAGE_FIX_SET_DISTANCE:
+		if fix.GetDistance() <= 0 {
+			return nil, twirp.InvalidArgument.Error("distance is required")
+		}
+	case pb.MileageFixAction_MILEAGE_FIX_MERGE:
+		if fix.GetTargetId() <= 0 || fix.GetTargetId() == fix.GetMileageId() {
+			return nil, twirp.InvalidArgument.Error("invalid target id")
+		}
+	case pb.MileageFixAction_MILEAGE_FIX_DELETE:
+	default:
+		return nil, twirp.InvalidArgument.Error("invalid action")
+	}
+
+	return idempotent(cr.app, ctx, user.ID, "FixMileage", fix, func() (*pb.MileageAnomalyCollection, error) {
+		return cr.fixMileage(ctx, user, fix)
+	})
+}
+
+// fixMileage applies the fix in one transaction and scans the car again
+func (cr *CarRepositoryService) fixMileage(ctx context.Context, user *security.UserClaims, fix *pb.MileageFix) (*pb.MileageAnomalyCollection, error) {
+	var collection *pb.MileageAnomalyCollection
+	err := cr.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		repo := repository.MileageRepository{DB: tx}
+		mileage, err := repo.Find(uint(fix.GetMileageId()))
+		if err != nil {
+			return err
+		}
+
+		readings, err := carReadings(tx, user, mileage.Car.ID)
+		if err != nil {
+			return err
+		}
+
+		switch fix.GetAction() {
+		case pb.MileageFixAction_MILEAGE_FIX_SET_DISTANCE:
+			distance := uint(fix.GetDistance())
+			if distance == mileage.Distance {
+				return twirp.InvalidArgument.Error("distance is not changed")
+			}
+			if !models.NewMileageHistory(readings).Fits(mileage, distance) {
+				return models.InvalidMileage
+			}
+
+			// the same reading is already there
+			duplicate, err := repo.FindUniq(distance, mileage.Car.ID, mileage.Date)
+			if err == nil {
+				err = repo.Merge(mileage, duplicate)
+			} else if errors.Is(err, models.RecordNotFound) {
+				err = repo.SetDistance(mileage, distance)
+			}
+			if err != nil {
+				return err
+			}
+		case pb.MileageFixAction_MILEAGE_FIX_MERGE:
+			target, err := repo.Find(uint(fix.GetTargetId()))
+			if errors.Is(err, models.RecordNotFound) {
+				return twirp.InvalidArgument.Error("invalid target id")
+			} else if err != nil {
+				return err
+			}
+
+			if target.Car == nil || target.Car.ID != mileage.Car.ID {
+				return twirp.InvalidArgument.Error("target belongs to another car")
+			}
+			if !target.SameDay(mileage) {
+				return twirp.InvalidArgument.Error("target is taken on another date")
+			}
+
+			if err = repo.Merge(mileage, target); err != nil {
+				return err
+			}
+		case pb.MileageFixAction_MILEAGE_FIX_DELETE:
+			tripRepo := repository.TripRepository{DB: tx}
+			used, err := tripRepo.HasMileage(mileage.ID)
+			if err != nil {
+				return err
+			}
+			if used {
+				return twirp.FailedPrecondition.Error("mileage is used by a trip, merge it instead")
+			}
+
+			if err = repo.Delete(mileage); err != nil {
+				return err
+			}
+		}
+
+		readings, err = repo.GetMileagesByCar(mileage.Car.ID)
+		if err != nil {
+			return err
+		}
+
+		collection = anomalyCollection(readings)
+
+		return nil
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	cr.app.Info("CarRepositoryService: mileage fixed", ctx, "id", fix.GetMileageId(), "action", fix.GetAction().String())
+
+	return collection, nil
+}
+
+func anomalyCollection(readings []*models.Mileage) *pb.MileageAnomalyCollection {
+	anomalies := models.NewMileageHistory(readings).Anomalies()
+
+	items := make([]*pb.MileageAnomaly, 0, len(anomalies))
+	for _, anomaly := range anomalies {
+		items = append(items, anomaly.ToRpcMessage())
+	}
+
+	return &pb.MileageAnomalyCollection{Anomalies: items}
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+// EstimateOdometer returns the reading of the car on the date by its mileage history
+func (cr *CarRepositoryService) EstimateOdometer(ctx context.Context, req *pb.OdometerRequest) (*pb.OdometerEstimate, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	db := cr.app.DB.WithContext(ctx)
+	readings, err := carReadings(db, user, uint(req.GetCarId()))
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	if len(readings) == 0 {
+		return nil, twirp.NotFoundError("car has no mileages")
+	}
+
+	date := userToday(ctx)
+	if req.GetDate() != nil {
+		date = req.GetDate().AsTime()
+	}
+
+	units, err := userUnits(cr.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	odometer := models.NewOdometer(readings)
+	distance, method := odometer.Estimate(date)
+
+	cr.app.Info("CarRepositoryService: odometer estimate", ctx, "car", req.GetCarId(), "readings", len(readings))
+
+	return &pb.OdometerEstimate{
+		Date:           timestamppb.New(date),
+		Distance:       int32(math.Round(units.Distance(float64(distance)))),
+		Method:         method,
+		AnnualDistance: int32(math.Round(units.Distance(float64(odometer.AnnualDistance())))),
+		Last:           readings[len(readings)-1].ToRpcMessage(),
+		Units:          units.ToRpcMessage(),
+	}, nil
+}
+
+// carReadings returns the mileage history of a car of the user ordered by date
+func carReadings(db *database.DB, user *security.UserClaims, carID uint) ([]*models.Mileage, error) {
+	if carID == 0 {
+		return nil, twirp.InvalidArgument.Error("car is required")
+	}
+
+	carRepo := repository.CarRepository{DB: db}
+	car, err := carRepo.Find(carID)
+	if errors.Is(err, models.RecordNotFound) {
+		return nil, twirp.InvalidArgument.Error("invalid car")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if car.UserID != user.ID {
+		return nil, twirp.InvalidArgument.Error("invalid car owner")
+	}
+
+	repo := repository.MileageRepository{DB: db}
+
+	return repo.GetMileagesByCar(car.ID)
+}
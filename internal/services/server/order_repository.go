@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/twitchtv/twirp"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -11,6 +13,8 @@ import (
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/filters"
 	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+	"xelbot.com/auto-notes/server/internal/utils/database"
 	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
@@ -29,30 +33,48 @@ func (or *OrderRepositoryService) GetOrders(ctx context.Context, pbFilter *pb.Or
 	}
 
 	filter := filters.NewOrderFilter(pbFilter)
+	if !filter.HasValidSort() {
+		return nil, twirp.InvalidArgument.Error("invalid sort field")
+	}
 
-	repo := repository.OrderRepository{DB: or.app.DB}
-	dbOrders, cntOrders, err := repo.GetOrdersByUser(user.ID, filter)
+	repo := repository.OrderRepository{DB: or.app.DB.WithContext(ctx)}
+	dbOrders, page, err := repo.GetOrdersByUser(user.ID, filter)
 	if err != nil {
 		return nil, toTwirpError(or.app, err, ctx)
 	}
 
-	if pageOutOfRange(filter, cntOrders) {
+	if pageOutOfRange(filter, page) {
 		return nil, twirp.NotFoundError("orders not found")
 	}
 
 	orders := make([]*pb.Order, 0, len(dbOrders))
+	converter, err := newCurrencyConverter(or.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	err = loadRates(converter, dbOrders, func(obj *models.Order) (*models.Cost, time.Time) { return &obj.Cost, obj.Date })
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	tags, err := recordTags(repo.DB, pb.SyncEntity_SYNC_ORDER, dbOrders, func(obj *models.Order) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
 	for _, dbOrder := range dbOrders {
-		orders = append(orders, dbOrder.ToRpcMessage())
+		message := dbOrder.ToRpcMessage()
+		message.Tags = tags[dbOrder.ID]
+		message.ConvertedCost = converter.convert(&dbOrder.Cost, dbOrder.Date)
+		orders = append(orders, message)
 	}
 
 	or.app.Info("OrderRepositoryService: populate orders", ctx, "cnt", len(dbOrders))
 
 	return &pb.OrderCollection{
 		Orders: orders,
-		Meta: &pb.PaginationMeta{
-			Current: int32(filter.GetPage()),
-			Last:    int32(filters.GetLastPage(filter, cntOrders)),
-		},
+		Meta:   paginationMeta(filter, page),
 	}, nil
 }
 
@@ -62,7 +84,7 @@ func (or *OrderRepositoryService) FindOrder(ctx context.Context, idReq *pb.IdReq
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.OrderRepository{DB: or.app.DB}
+	repo := repository.OrderRepository{DB: or.app.DB.WithContext(ctx)}
 	if idReq.GetId() > 0 {
 		ownerId, err := repo.OrderOwner(uint(idReq.GetId()))
 		if err != nil {
@@ -80,7 +102,15 @@ func (or *OrderRepositoryService) FindOrder(ctx context.Context, idReq *pb.IdReq
 		return nil, toTwirpError(or.app, err, ctx)
 	}
 
-	return dbItem.ToRpcMessage(), nil
+	tags, err := recordTags(repo.DB, pb.SyncEntity_SYNC_ORDER, []*models.Order{dbItem}, func(obj *models.Order) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	message := dbItem.ToRpcMessage()
+	message.Tags = tags[dbItem.ID]
+
+	return message, nil
 }
 
 func (or *OrderRepositoryService) GetOrderTypes(ctx context.Context, _ *emptypb.Empty) (*pb.OrderTypeCollection, error) {
@@ -89,7 +119,7 @@ func (or *OrderRepositoryService) GetOrderTypes(ctx context.Context, _ *emptypb.
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.OrderRepository{DB: or.app.DB}
+	repo := repository.OrderRepository{DB: or.app.DB.WithContext(ctx)}
 	dbTypes, err := repo.GetOrderTypes()
 	if err != nil {
 		return nil, toTwirpError(or.app, err, ctx)
@@ -116,6 +146,19 @@ func (or *OrderRepositoryService) SaveOrder(ctx context.Context, order *pb.Order
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
+	return idempotent(or.app, ctx, user.ID, "SaveOrder", order, func() (*pb.Order, error) {
+		return or.saveOrder(ctx, newSaveLookups(or.app.DB.WithContext(ctx)), user, order)
+	})
+}
+
+func (or *OrderRepositoryService) saveOrder(
+	ctx context.Context,
+	lookups *saveLookups,
+	user *security.UserClaims,
+	order *pb.Order,
+) (*pb.Order, error) {
+	var err error
+
 	currencyCode := order.Cost.GetCurrency()
 	if currencyCode == "" {
 		return nil, twirp.InvalidArgument.Error("empty currency code")
@@ -125,7 +168,7 @@ func (or *OrderRepositoryService) SaveOrder(ctx context.Context, order *pb.Order
 		return nil, twirp.InvalidArgument.Error("date is required")
 	}
 
-	orderRepo := repository.OrderRepository{DB: or.app.DB}
+	orderRepo := repository.OrderRepository{DB: lookups.db}
 	if order.GetId() > 0 {
 		ownerId, err := orderRepo.OrderOwner(uint(order.GetId()))
 		if err != nil {
@@ -138,7 +181,7 @@ func (or *OrderRepositoryService) SaveOrder(ctx context.Context, order *pb.Order
 
 	var orderType *models.OrderType
 	if order.Type.GetId() > 0 {
-		orderType, err = orderRepo.FindType(uint(order.Type.GetId()))
+		orderType, err = lookups.orderType(uint(order.Type.GetId()))
 		if err != nil {
 			if errors.Is(err, models.RecordNotFound) {
 				return nil, twirp.InvalidArgument.Error("invalid order type")
@@ -148,8 +191,7 @@ func (or *OrderRepositoryService) SaveOrder(ctx context.Context, order *pb.Order
 		}
 	}
 
-	currencyRepo := repository.CurrencyRepository{DB: or.app.DB}
-	currency, err := currencyRepo.GetCurrencyByCode(currencyCode)
+	currency, err := lookups.currency(currencyCode)
 	if err != nil {
 		if errors.Is(err, models.RecordNotFound) {
 			return nil, twirp.InvalidArgument.Error("invalid currency")
@@ -160,8 +202,7 @@ func (or *OrderRepositoryService) SaveOrder(ctx context.Context, order *pb.Order
 
 	var car *models.Car
 	if order.Car.GetId() > 0 {
-		carRepo := repository.CarRepository{DB: or.app.DB}
-		car, err = carRepo.Find(uint(order.Car.GetId()))
+		car, err = lookups.car(uint(order.Car.GetId()))
 		if err != nil {
 			if errors.Is(err, models.RecordNotFound) {
 				return nil, twirp.InvalidArgument.Error("invalid car")
@@ -177,8 +218,7 @@ func (or *OrderRepositoryService) SaveOrder(ctx context.Context, order *pb.Order
 
 	var mileage *models.Mileage
 	if order.Distance > 0 && car != nil && order.GetUsedAt() != nil {
-		mileageRepo := repository.MileageRepository{DB: or.app.DB}
-		mileage, err = mileageRepo.FindOrCreate(uint(order.Distance), car.ID, order.GetUsedAt().AsTime())
+		mileage, err = lookups.mileage(uint(order.Distance), car.ID, order.GetUsedAt().AsTime())
 		if err != nil {
 			return nil, toTwirpError(or.app, err, ctx)
 		}
@@ -217,10 +257,18 @@ func (or *OrderRepositoryService) SaveOrder(ctx context.Context, order *pb.Order
 		Type:        orderType,
 		UsedAt:      usedAt,
 		Mileage:     mileage,
+		Version:     uint(order.GetVersion()),
 	}
 
 	orderID, err := orderRepo.SaveOrder(&orderModel, user.ID)
-	if err != nil {
+	if errors.Is(err, models.StaleRecord) {
+		current, err := orderRepo.Find(orderModel.ID)
+		if err != nil {
+			return nil, toTwirpError(or.app, err, ctx)
+		}
+
+		return nil, staleRecordError(current.ToRpcMessage())
+	} else if err != nil {
 		return nil, toTwirpError(or.app, err, ctx)
 	}
 
@@ -232,6 +280,147 @@ func (or *OrderRepositoryService) SaveOrder(ctx context.Context, order *pb.Order
 	return dbOrder.ToRpcMessage(), nil
 }
 
+func (or *OrderRepositoryService) BatchSaveOrders(ctx context.Context, batch *pb.OrderBatch) (*pb.OrderBatchResult, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	return idempotent(or.app, ctx, user.ID, "BatchSaveOrders", batch, func() (*pb.OrderBatchResult, error) {
+		saved, errs, err := saveBatch(or.app, ctx, batch.GetMode(), batch.GetOrders(),
+			func(lookups *saveLookups, item *pb.Order) (*pb.Order, error) {
+				return or.saveOrder(ctx, lookups, user, item)
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*pb.OrderBatchItem, 0, len(saved))
+		for i := range saved {
+			items = append(items, &pb.OrderBatchItem{Order: saved[i], Error: errs[i]})
+		}
+
+		or.app.Info("OrderRepositoryService: batch save orders", ctx, "cnt", len(items))
+
+		return &pb.OrderBatchResult{Items: items}, nil
+	})
+}
+
+func (or *OrderRepositoryService) GetOrderInventory(ctx context.Context, pbFilter *pb.OrderInventoryFilter) (*pb.OrderInventory, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	carID, typeID := uint(pbFilter.GetCarId()), uint(pbFilter.GetTypeId())
+
+	repo := repository.OrderRepository{DB: or.app.DB.WithContext(ctx)}
+	unused, err := repo.GetUnusedOrders(user.ID, carID, typeID)
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	installed, err := repo.GetInstalledOrders(user.ID, carID, typeID)
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	inventory := &pb.OrderInventory{}
+	for _, group := range models.NewOrderInventory(unused) {
+		inventory.Unused = append(inventory.Unused, group.ToRpcMessage())
+	}
+	for _, life := range models.NewOrderServiceLife(installed) {
+		inventory.ServiceLife = append(inventory.ServiceLife, life.ToRpcMessage())
+	}
+
+	or.app.Info("OrderRepositoryService: populate inventory", ctx, "unused", len(unused), "installed", len(installed))
+
+	return inventory, nil
+}
+
+func (or *OrderRepositoryService) MarkOrderUsed(ctx context.Context, usage *pb.OrderUsage) (*pb.Order, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	if usage.GetUsedAt() == nil {
+		return nil, twirp.InvalidArgument.Error("used_at is required")
+	}
+
+	return idempotent(or.app, ctx, user.ID, "MarkOrderUsed", usage, func() (*pb.Order, error) {
+		return or.markOrderUsed(ctx, user, usage)
+	})
+}
+
+// markOrderUsed sets the install date and the mileage in one transaction,
+// so a failed update does not leave a mileage without the order
+func (or *OrderRepositoryService) markOrderUsed(ctx context.Context, user *security.UserClaims, usage *pb.OrderUsage) (*pb.Order, error) {
+	var order *models.Order
+	err := or.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		repo := repository.OrderRepository{DB: tx}
+		ownerId, err := repo.OrderOwner(uint(usage.GetId()))
+		if err != nil {
+			return err
+		}
+		if ownerId != user.ID {
+			return twirp.InvalidArgument.Error("invalid order owner")
+		}
+
+		order, err = repo.Find(uint(usage.GetId()))
+		if err != nil {
+			return err
+		}
+
+		order.UsedAt = sql.NullTime{
+			Valid: true,
+			Time:  usage.GetUsedAt().AsTime(),
+		}
+		order.Version = uint(usage.GetVersion())
+
+		// MarkUsed keeps the stored mileage of the order without a distance
+		if usage.GetDistance() > 0 {
+			if order.Car == nil {
+				return twirp.InvalidArgument.Error("order has no car for the distance")
+			}
+
+			mileageRepo := repository.MileageRepository{DB: tx}
+			order.Mileage, err = mileageRepo.FindOrCreate(uint(usage.GetDistance()), order.Car.ID, order.UsedAt.Time)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = repo.MarkUsed(order); err != nil {
+			return err
+		}
+
+		order, err = repo.Find(order.ID)
+
+		return err
+	})
+	if errors.Is(err, models.StaleRecord) {
+		repo := repository.OrderRepository{DB: or.app.DB.WithContext(ctx)}
+		current, err := repo.Find(uint(usage.GetId()))
+		if err != nil {
+			return nil, toTwirpError(or.app, err, ctx)
+		}
+
+		return nil, staleRecordError(current.ToRpcMessage())
+	} else if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	or.app.Info("OrderRepositoryService: order marked as used", ctx, "id", order.ID)
+
+	return order.ToRpcMessage(), nil
+}
+
 func (or *OrderRepositoryService) GetExpenses(ctx context.Context, pbFilter *pb.ExpenseFilter) (*pb.ExpenseCollection, error) {
 	user, err := userClaimsFromContext(ctx)
 	if err != nil {
@@ -239,30 +428,48 @@ func (or *OrderRepositoryService) GetExpenses(ctx context.Context, pbFilter *pb.
 	}
 
 	filter := filters.NewExpenseFilter(pbFilter)
+	if !filter.HasValidSort() {
+		return nil, twirp.InvalidArgument.Error("invalid sort field")
+	}
 
-	repo := repository.ExpenseRepository{DB: or.app.DB}
-	dbExpenses, cntExpenses, err := repo.GetExpensesByUser(user.ID, filter)
+	repo := repository.ExpenseRepository{DB: or.app.DB.WithContext(ctx)}
+	dbExpenses, page, err := repo.GetExpensesByUser(user.ID, filter)
 	if err != nil {
 		return nil, toTwirpError(or.app, err, ctx)
 	}
 
-	if pageOutOfRange(filter, cntExpenses) {
+	if pageOutOfRange(filter, page) {
 		return nil, twirp.NotFoundError("expenses not found")
 	}
 
 	expenses := make([]*pb.Expense, 0, len(dbExpenses))
+	converter, err := newCurrencyConverter(or.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	err = loadRates(converter, dbExpenses, func(obj *models.Expense) (*models.Cost, time.Time) { return &obj.Cost, obj.Date })
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	tags, err := recordTags(repo.DB, pb.SyncEntity_SYNC_EXPENSE, dbExpenses, func(obj *models.Expense) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
 	for _, dbExpense := range dbExpenses {
-		expenses = append(expenses, dbExpense.ToRpcMessage())
+		message := dbExpense.ToRpcMessage()
+		message.Tags = tags[dbExpense.ID]
+		message.ConvertedCost = converter.convert(&dbExpense.Cost, dbExpense.Date)
+		expenses = append(expenses, message)
 	}
 
 	or.app.Info("OrderRepositoryService: populate expenses", ctx, "cnt", len(dbExpenses))
 
 	return &pb.ExpenseCollection{
 		Expenses: expenses,
-		Meta: &pb.PaginationMeta{
-			Current: int32(filter.GetPage()),
-			Last:    int32(filters.GetLastPage(filter, cntExpenses)),
-		},
+		Meta:     paginationMeta(filter, page),
 	}, nil
 }
 
@@ -272,7 +479,7 @@ func (or *OrderRepositoryService) FindExpense(ctx context.Context, idReq *pb.IdR
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.ExpenseRepository{DB: or.app.DB}
+	repo := repository.ExpenseRepository{DB: or.app.DB.WithContext(ctx)}
 	if idReq.GetId() > 0 {
 		ownerId, err := repo.ExpenseOwner(uint(idReq.GetId()))
 		if err != nil {
@@ -290,7 +497,15 @@ func (or *OrderRepositoryService) FindExpense(ctx context.Context, idReq *pb.IdR
 		return nil, toTwirpError(or.app, err, ctx)
 	}
 
-	return dbItem.ToRpcMessage(), nil
+	tags, err := recordTags(repo.DB, pb.SyncEntity_SYNC_EXPENSE, []*models.Expense{dbItem}, func(obj *models.Expense) uint { return obj.ID })
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	message := dbItem.ToRpcMessage()
+	message.Tags = tags[dbItem.ID]
+
+	return message, nil
 }
 
 func (or *OrderRepositoryService) SaveExpense(ctx context.Context, expense *pb.Expense) (*pb.Expense, error) {
@@ -299,20 +514,31 @@ func (or *OrderRepositoryService) SaveExpense(ctx context.Context, expense *pb.E
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
+	return idempotent(or.app, ctx, user.ID, "SaveExpense", expense, func() (*pb.Expense, error) {
+		return or.saveExpense(ctx, newSaveLookups(or.app.DB.WithContext(ctx)), user, expense)
+	})
+}
+
+func (or *OrderRepositoryService) saveExpense(
+	ctx context.Context,
+	lookups *saveLookups,
+	user *security.UserClaims,
+	expense *pb.Expense,
+) (*pb.Expense, error) {
 	currencyCode := expense.Cost.GetCurrency()
 	if currencyCode == "" {
 		return nil, twirp.InvalidArgument.Error("empty currency code")
 	}
 
-	if expense.GetType() == pb.ExpenseType_EMPTY {
-		return nil, twirp.InvalidArgument.Error("expense type is required")
+	if expense.GetType() == pb.ExpenseType_EMPTY && expense.Category.GetId() == 0 {
+		return nil, twirp.InvalidArgument.Error("expense category is required")
 	}
 
 	if expense.GetDate() == nil {
 		return nil, twirp.InvalidArgument.Error("date is required")
 	}
 
-	expenseRepo := repository.ExpenseRepository{DB: or.app.DB}
+	expenseRepo := repository.ExpenseRepository{DB: lookups.db}
 	if expense.GetId() > 0 {
 		ownerId, err := expenseRepo.ExpenseOwner(uint(expense.GetId()))
 		if err != nil {
@@ -323,8 +549,7 @@ func (or *OrderRepositoryService) SaveExpense(ctx context.Context, expense *pb.E
 		}
 	}
 
-	currencyRepo := repository.CurrencyRepository{DB: or.app.DB}
-	currency, err := currencyRepo.GetCurrencyByCode(currencyCode)
+	currency, err := lookups.currency(currencyCode)
 	if err != nil {
 		if errors.Is(err, models.RecordNotFound) {
 			return nil, twirp.InvalidArgument.Error("invalid currency")
@@ -335,8 +560,7 @@ func (or *OrderRepositoryService) SaveExpense(ctx context.Context, expense *pb.E
 
 	var car *models.Car
 	if expense.Car.GetId() > 0 {
-		carRepo := repository.CarRepository{DB: or.app.DB}
-		car, err = carRepo.Find(uint(expense.Car.GetId()))
+		car, err = lookups.car(uint(expense.Car.GetId()))
 		if err != nil {
 			if errors.Is(err, models.RecordNotFound) {
 				return nil, twirp.InvalidArgument.Error("invalid car")
@@ -350,20 +574,46 @@ func (or *OrderRepositoryService) SaveExpense(ctx context.Context, expense *pb.E
 		}
 	}
 
+	var category *models.ExpenseCategory
+	if expense.Category.GetId() > 0 {
+		category, err = lookups.expenseCategory(uint(expense.Category.GetId()))
+		if err == nil && !category.VisibleTo(user.ID) {
+			err = models.RecordNotFound
+		}
+	} else {
+		category, err = or.expenseCategoryByType(lookups, expense)
+	}
+	if err != nil {
+		if errors.Is(err, models.RecordNotFound) {
+			return nil, twirp.InvalidArgument.Error("invalid expense category")
+		}
+
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
 	expenseModel := models.Expense{
-		ID:  uint(expense.GetId()),
-		Car: car,
+		ID:       uint(expense.GetId()),
+		Car:      car,
+		Category: category,
 		Cost: models.Cost{
 			Value:      expense.GetCost().GetValue(),
 			CurrencyID: currency.ID,
 		},
 		Description: expense.GetDescription(),
 		Date:        expense.GetDate().AsTime(),
-		Type:        expense.GetType(),
+		Type:        category.Type,
+		Version:     uint(expense.GetVersion()),
 	}
 
 	expenseID, err := expenseRepo.SaveExpense(&expenseModel, user.ID)
-	if err != nil {
+	if errors.Is(err, models.StaleRecord) {
+		current, err := expenseRepo.Find(expenseModel.ID)
+		if err != nil {
+			return nil, toTwirpError(or.app, err, ctx)
+		}
+
+		return nil, staleRecordError(current.ToRpcMessage())
+	} else if err != nil {
 		return nil, toTwirpError(or.app, err, ctx)
 	}
 
@@ -374,3 +624,165 @@ func (or *OrderRepositoryService) SaveExpense(ctx context.Context, expense *pb.E
 
 	return dbExpense.ToRpcMessage(), nil
 }
+
+// expenseCategoryByType keeps the stored category of the expense when an old
+// client sends the type only, a subcategory is not reset to the built-in one
+func (or *OrderRepositoryService) expenseCategoryByType(lookups *saveLookups, expense *pb.Expense) (*models.ExpenseCategory, error) {
+	if expense.GetId() > 0 {
+		expenseRepo := repository.ExpenseRepository{DB: lookups.db}
+		stored, err := expenseRepo.Find(uint(expense.GetId()))
+		if err != nil {
+			return nil, err
+		}
+
+		if stored.Category != nil && stored.Category.Type == expense.GetType() {
+			return stored.Category, nil
+		}
+	}
+
+	return lookups.expenseCategoryByType(expense.GetType())
+}
+
+func (or *OrderRepositoryService) GetExpenseCategories(ctx context.Context, _ *emptypb.Empty) (*pb.ExpenseCategoryCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	repo := repository.ExpenseCategoryRepository{DB: or.app.DB.WithContext(ctx)}
+	dbCategories, err := repo.GetCategories(user.ID)
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	categories := make([]*pb.ExpenseCategory, 0, len(dbCategories))
+	for _, dbItem := range dbCategories {
+		categories = append(categories, dbItem.ToRpcMessage())
+	}
+
+	or.app.Info("OrderRepositoryService: populate expense categories", ctx, "cnt", len(dbCategories))
+
+	return &pb.ExpenseCategoryCollection{Categories: categories}, nil
+}
+
+// SaveExpenseCategory creates or updates a category of the user. The enum
+// type is inherited from the parent, so the old clients see the expenses
+// of a nested category as its built-in parent, and as OTHER otherwise
+func (or *OrderRepositoryService) SaveExpenseCategory(ctx context.Context, category *pb.ExpenseCategory) (*pb.ExpenseCategory, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	name := strings.TrimSpace(category.GetName())
+	if name == "" {
+		return nil, twirp.RequiredArgumentError("name")
+	}
+
+	var categoryID uint
+	err = or.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		repo := repository.ExpenseCategoryRepository{DB: tx}
+
+		obj := &models.ExpenseCategory{
+			UserID: sql.NullInt32{Int32: int32(user.ID), Valid: true},
+		}
+		if category.GetId() > 0 {
+			obj, err = repo.Find(uint(category.GetId()))
+			if err != nil {
+				return err
+			}
+			if obj.IsSystem() {
+				return twirp.InvalidArgument.Error("built-in categories are read-only")
+			}
+			if !obj.VisibleTo(user.ID) {
+				return twirp.InvalidArgument.Error("invalid category owner")
+			}
+		}
+
+		obj.Name = name
+		obj.Type = pb.ExpenseType_OTHER
+		obj.ParentID = sql.NullInt32{}
+
+		if category.GetParentId() > 0 {
+			parent, err := repo.Find(uint(category.GetParentId()))
+			if errors.Is(err, models.RecordNotFound) || (err == nil && !parent.VisibleTo(user.ID)) {
+				return twirp.InvalidArgument.Error("invalid parent category")
+			} else if err != nil {
+				return err
+			}
+
+			if parent.ID == obj.ID {
+				return twirp.InvalidArgument.Error("category cannot be its own parent")
+			}
+			if parent.ParentID.Valid {
+				return twirp.InvalidArgument.Error("parent must be a root category")
+			}
+
+			if obj.ID > 0 {
+				cnt, err := repo.CountChildren(obj.ID)
+				if err != nil {
+					return err
+				}
+				if cnt > 0 {
+					return twirp.InvalidArgument.Error("category with children cannot be nested")
+				}
+			}
+
+			obj.ParentID = sql.NullInt32{Int32: int32(parent.ID), Valid: true}
+			obj.Type = parent.Type
+		}
+
+		categoryID, err = repo.SaveCategory(obj)
+		if err != nil {
+			return err
+		}
+
+		if obj.ID > 0 {
+			return repo.UpdateChildrenType(obj)
+		}
+
+		return nil
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	repo := repository.ExpenseCategoryRepository{DB: or.app.DB.WithContext(ctx)}
+	dbCategory, err := repo.Find(categoryID)
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	return dbCategory.ToRpcMessage(), nil
+}
+
+func (or *OrderRepositoryService) BatchSaveExpenses(ctx context.Context, batch *pb.ExpenseBatch) (*pb.ExpenseBatchResult, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	return idempotent(or.app, ctx, user.ID, "BatchSaveExpenses", batch, func() (*pb.ExpenseBatchResult, error) {
+		saved, errs, err := saveBatch(or.app, ctx, batch.GetMode(), batch.GetExpenses(),
+			func(lookups *saveLookups, item *pb.Expense) (*pb.Expense, error) {
+				return or.saveExpense(ctx, lookups, user, item)
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*pb.ExpenseBatchItem, 0, len(saved))
+		for i := range saved {
+			items = append(items, &pb.ExpenseBatchItem{Expense: saved[i], Error: errs[i]})
+		}
+
+		or.app.Info("OrderRepositoryService: batch save expenses", ctx, "cnt", len(items))
+
+		return &pb.ExpenseBatchResult{Items: items}, nil
+	})
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/twitchtv/twirp"
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+// checkRecordOwner allows only the records of the user among the record types
+func checkRecordOwner(
+	app application.Container,
+	ctx context.Context,
+	db *database.DB,
+	user *security.UserClaims,
+	entities []pb.SyncEntity,
+	entity pb.SyncEntity,
+	recordID uint,
+) error {
+	if !slices.Contains(entities, entity) {
+		return twirp.InvalidArgument.Error("unsupported record type")
+	}
+
+	if recordID == 0 {
+		return twirp.InvalidArgument.Error("invalid record id")
+	}
+
+	var (
+		ownerID uint
+		err     error
+	)
+
+	switch entity {
+	case pb.SyncEntity_SYNC_FUEL:
+		repo := repository.FuelRepository{DB: db}
+		ownerID, err = repo.FuelOwner(recordID)
+	case pb.SyncEntity_SYNC_ORDER:
+		repo := repository.OrderRepository{DB: db}
+		ownerID, err = repo.OrderOwner(recordID)
+	case pb.SyncEntity_SYNC_EXPENSE:
+		repo := repository.ExpenseRepository{DB: db}
+		ownerID, err = repo.ExpenseOwner(recordID)
+	case pb.SyncEntity_SYNC_SERVICE:
+		repo := repository.ServiceRepository{DB: db}
+		ownerID, err = repo.ServiceOwner(recordID)
+	case pb.SyncEntity_SYNC_MILEAGE:
+		repo := repository.MileageRepository{DB: db}
+		ownerID, err = repo.MileageOwner(recordID)
+	case pb.SyncEntity_SYNC_CAR:
+		repo := repository.CarRepository{DB: db}
+		var car *models.Car
+		if car, err = repo.Find(recordID); err == nil {
+			ownerID = car.UserID
+		}
+	default:
+		return twirp.InvalidArgument.Error("unsupported record type")
+	}
+
+	if err != nil {
+		if errors.Is(err, models.RecordNotFound) {
+			return twirp.InvalidArgument.Error("invalid record id")
+		}
+
+		return toTwirpError(app, err, ctx)
+	}
+
+	if ownerID != user.ID {
+		return twirp.InvalidArgument.Error("invalid record owner")
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const (
+	maxRecurringInterval = 120
+	defaultUpcomingDays  = 30
+	maxUpcomingDays      = 366
+)
+
+func (or *OrderRepositoryService) GetRecurringExpenses(ctx context.Context, _ *emptypb.Empty) (*pb.RecurringExpenseCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	repo := repository.RecurringExpenseRepository{DB: or.app.DB.WithContext(ctx)}
+	dbItems, err := repo.GetRecurringExpensesByUser(user.ID)
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	items := make([]*pb.RecurringExpense, 0, len(dbItems))
+	for _, dbItem := range dbItems {
+		items = append(items, dbItem.ToRpcMessage())
+	}
+
+	or.app.Info("OrderRepositoryService: populate recurring expenses", ctx, "cnt", len(dbItems))
+
+	return &pb.RecurringExpenseCollection{Items: items}, nil
+}
+
+// SaveRecurringExpense creates or updates a template. The scheduler creates
+// the expenses from the current date on, a changed schedule starts over
+// from the current date as well
+func (or *OrderRepositoryService) SaveRecurringExpense(ctx context.Context, recurring *pb.RecurringExpense) (*pb.RecurringExpense, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	currencyCode := recurring.Cost.GetCurrency()
+	if currencyCode == "" {
+		return nil, twirp.InvalidArgument.Error("empty currency code")
+	}
+
+	if recurring.GetStartDate() == nil {
+		return nil, twirp.InvalidArgument.Error("start date is required")
+	}
+
+	if _, found := pb.RecurrenceFrequency_name[int32(recurring.GetFrequency())]; !found {
+		return nil, twirp.InvalidArgument.Error("invalid frequency")
+	}
+
+	interval := int(recurring.GetInterval())
+	if interval == 0 {
+		interval = 1
+	}
+	if interval < 0 || interval > maxRecurringInterval {
+		return nil, twirp.InvalidArgument.Error("invalid interval")
+	}
+
+	startDate := recurring.GetStartDate().AsTime()
+
+	var endDate sql.NullTime
+	if recurring.GetEndDate() != nil {
+		endDate = sql.NullTime{Time: recurring.GetEndDate().AsTime(), Valid: true}
+		if endDate.Time.Before(startDate) {
+			return nil, twirp.InvalidArgument.Error("end date is before start date")
+		}
+	}
+
+	var dbItem *models.RecurringExpense
+	err = or.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		lookups := newSaveLookups(tx)
+		repo := repository.RecurringExpenseRepository{DB: tx}
+
+		// the lock keeps the scheduler from creating the expenses
+		// of the old schedule meanwhile
+		var current *models.RecurringExpense
+		var err error
+		if recurring.GetId() > 0 {
+			current, err = repo.FindForUpdate(uint(recurring.GetId()))
+			if err != nil {
+				return err
+			}
+			if current.UserID != user.ID {
+				return twirp.InvalidArgument.Error("invalid recurring expense owner")
+			}
+		}
+
+		category, err := lookups.expenseCategory(uint(recurring.Category.GetId()))
+		if err == nil && !category.VisibleTo(user.ID) {
+			err = models.RecordNotFound
+		}
+		if err != nil {
+			if errors.Is(err, models.RecordNotFound) {
+				return twirp.InvalidArgument.Error("invalid expense category")
+			}
+
+			return err
+		}
+
+		currency, err := lookups.currency(currencyCode)
+		if err != nil {
+			if errors.Is(err, models.RecordNotFound) {
+				return twirp.InvalidArgument.Error("invalid currency")
+			}
+
+			return err
+		}
+
+		var car *models.Car
+		if recurring.Car.GetId() > 0 {
+			car, err = lookups.car(uint(recurring.Car.GetId()))
+			if err != nil {
+				if errors.Is(err, models.RecordNotFound) {
+					return twirp.InvalidArgument.Error("invalid car")
+				}
+
+				return err
+			}
+
+			if car.UserID != user.ID {
+				return twirp.InvalidArgument.Error("invalid car owner")
+			}
+		}
+
+		obj := models.RecurringExpense{
+			ID:     uint(recurring.GetId()),
+			UserID: user.ID,
+			Cost: models.Cost{
+				Value:      recurring.Cost.GetValue(),
+				CurrencyID: currency.ID,
+			},
+			Description: recurring.GetDescription(),
+			Car:         car,
+			Category:    category,
+			Frequency:   recurring.GetFrequency(),
+			Interval:    interval,
+			StartDate:   startDate,
+			EndDate:     endDate,
+		}
+
+		if current != nil && current.Frequency == obj.Frequency && current.Interval == obj.Interval &&
+			current.StartDate.Format(time.DateOnly) == obj.StartDate.Format(time.DateOnly) {
+			obj.Occurrences = current.Occurrences
+		} else {
+			var created []time.Time
+			if current != nil {
+				created, err = repo.CreatedDates(current.ID)
+				if err != nil {
+					return err
+				}
+			}
+
+			obj.SkipBefore(userToday(ctx), created)
+		}
+
+		id, err := repo.SaveRecurringExpense(&obj)
+		if err != nil {
+			return err
+		}
+
+		dbItem, err = repo.Find(id)
+
+		return err
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	return dbItem.ToRpcMessage(), nil
+}
+
+func (or *OrderRepositoryService) DeleteRecurringExpense(ctx context.Context, idReq *pb.IdRequest) (*emptypb.Empty, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	err = or.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		repo := repository.RecurringExpenseRepository{DB: tx}
+		obj, err := repo.FindForUpdate(uint(idReq.GetId()))
+		if err != nil {
+			return err
+		}
+		if obj.UserID != user.ID {
+			return twirp.InvalidArgument.Error("invalid recurring expense owner")
+		}
+
+		return repo.Delete(obj.ID)
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	or.app.Info("OrderRepositoryService: recurring expense deleted", ctx, "id", idReq.GetId())
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetUpcomingExpenses lists the expenses the scheduler will create
+// in the next days, including the ones due today
+func (or *OrderRepositoryService) GetUpcomingExpenses(ctx context.Context, filter *pb.UpcomingExpenseFilter) (*pb.UpcomingExpenseCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	days := int(filter.GetDays())
+	if days == 0 {
+		days = defaultUpcomingDays
+	}
+	if days < 0 || days > maxUpcomingDays {
+		return nil, twirp.InvalidArgument.Error("invalid days")
+	}
+
+	repo := repository.RecurringExpenseRepository{DB: or.app.DB.WithContext(ctx)}
+	dbItems, err := repo.GetRecurringExpensesByUser(user.ID)
+	if err != nil {
+		return nil, toTwirpError(or.app, err, ctx)
+	}
+
+	until := userToday(ctx).AddDate(0, 0, days)
+	items := make([]*pb.UpcomingExpense, 0)
+	for _, dbItem := range dbItems {
+		message := dbItem.ToRpcMessage()
+		for _, date := range dbItem.DueDates(until) {
+			items = append(items, &pb.UpcomingExpense{
+				Recurring: message,
+				Date:      timestamppb.New(date),
+			})
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Date.AsTime().Before(items[j].Date.AsTime())
+	})
+
+	return &pb.UpcomingExpenseCollection{Items: items}, nil
+}
@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const (
+	syncDefaultLimit = 100
+	syncMaxLimit     = 500
+)
+
+type SyncRepositoryService struct {
+	app application.Container
+}
+
+func NewSyncRepositoryService(app application.Container) *SyncRepositoryService {
+	return &SyncRepositoryService{app: app}
+}
+
+func (sr *SyncRepositoryService) GetChanges(ctx context.Context, req *pb.SyncRequest) (*pb.SyncPage, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	return sr.syncPage(ctx, user.ID, req)
+}
+
+// Sync sends pages of changes to the gRPC stream until the client is up to date
+func (sr *SyncRepositoryService) Sync(req *pb.SyncRequest, stream grpc.ServerStreamingServer[pb.SyncPage]) error {
+	ctx := stream.Context()
+
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return twirp.Unauthenticated.Error(err.Error())
+	}
+
+	for {
+		page, err := sr.syncPage(ctx, user.ID, req)
+		if err != nil {
+			return err
+		}
+
+		if err = stream.Send(page); err != nil {
+			return err
+		}
+
+		if !page.HasMore {
+			return nil
+		}
+
+		req = &pb.SyncRequest{
+			Cursor: page.Cursor,
+			Limit:  req.GetLimit(),
+		}
+	}
+}
+
+func (sr *SyncRepositoryService) syncPage(ctx context.Context, userID uint, req *pb.SyncRequest) (*pb.SyncPage, error) {
+	cursor, err := decodeSyncCursor(req.GetCursor())
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid cursor")
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = syncDefaultLimit
+	} else if limit > syncMaxLimit {
+		limit = syncMaxLimit
+	}
+
+	repo := repository.SyncRepository{DB: sr.app.DB.WithContext(ctx)}
+	dbChanges, err := repo.GetChanges(userID, cursor, limit+1)
+	if err != nil {
+		return nil, toTwirpError(sr.app, err, ctx)
+	}
+
+	page := &pb.SyncPage{
+		Cursor: req.GetCursor(),
+	}
+	if len(dbChanges) > limit {
+		dbChanges = dbChanges[:limit]
+		page.HasMore = true
+	}
+
+	var cars map[uint]*models.Car
+	changes := make([]*pb.SyncChange, 0, len(dbChanges))
+	for _, dbChange := range dbChanges {
+		change := dbChange.ToRpcMessage()
+		if !change.Deleted {
+			if dbChange.Entity == models.SyncEntityCar && cars == nil {
+				cars, err = sr.userCars(ctx, userID)
+				if err != nil {
+					return nil, toTwirpError(sr.app, err, ctx)
+				}
+			}
+
+			err = sr.populateRecord(ctx, userID, change, dbChange, cars)
+			if errors.Is(err, models.RecordNotFound) {
+				change.Deleted = true
+			} else if err != nil {
+				return nil, toTwirpError(sr.app, err, ctx)
+			}
+		}
+
+		changes = append(changes, change)
+		page.Cursor = encodeSyncCursor(dbChange.ID)
+	}
+
+	page.Changes = changes
+
+	sr.app.Info("SyncRepositoryService: populate changes", ctx, "cnt", len(changes), "has_more", page.HasMore)
+
+	return page, nil
+}
+
+func (sr *SyncRepositoryService) populateRecord(
+	ctx context.Context,
+	userID uint,
+	change *pb.SyncChange,
+	dbChange *models.SyncChange,
+	cars map[uint]*models.Car,
+) error {
+	db := sr.app.DB.WithContext(ctx)
+	id := dbChange.RecordID
+
+	// a record moved to another user is removed for the old owner
+	owned := func(ownerID uint, err error) error {
+		if err != nil {
+			return err
+		}
+		if ownerID != userID {
+			return models.RecordNotFound
+		}
+
+		return nil
+	}
+
+	switch dbChange.Entity {
+	case models.SyncEntityFuel:
+		repo := repository.FuelRepository{DB: db}
+		if err := owned(repo.FuelOwner(id)); err != nil {
+			return err
+		}
+		obj, err := repo.Find(id)
+		if err != nil {
+			return err
+		}
+		change.Record = &pb.SyncChange_Fuel{Fuel: obj.ToRpcMessage()}
+	case models.SyncEntityOrder:
+		repo := repository.OrderRepository{DB: db}
+		if err := owned(repo.OrderOwner(id)); err != nil {
+			return err
+		}
+		obj, err := repo.Find(id)
+		if err != nil {
+			return err
+		}
+		change.Record = &pb.SyncChange_Order{Order: obj.ToRpcMessage()}
+	case models.SyncEntityExpense:
+		repo := repository.ExpenseRepository{DB: db}
+		if err := owned(repo.ExpenseOwner(id)); err != nil {
+			return err
+		}
+		obj, err := repo.Find(id)
+		if err != nil {
+			return err
+		}
+		change.Record = &pb.SyncChange_Expense{Expense: obj.ToRpcMessage()}
+	case models.SyncEntityService:
+		repo := repository.ServiceRepository{DB: db}
+		if err := owned(repo.ServiceOwner(id)); err != nil {
+			return err
+		}
+		obj, err := repo.Find(id)
+		if err != nil {
+			return err
+		}
+		change.Record = &pb.SyncChange_Service{Service: obj.ToRpcMessage()}
+	case models.SyncEntityMileage:
+		repo := repository.MileageRepository{DB: db}
+		if err := owned(repo.MileageOwner(id)); err != nil {
+			return err
+		}
+		obj, err := repo.Find(id)
+		if err != nil {
+			return err
+		}
+		change.Record = &pb.SyncChange_Mileage{Mileage: obj.ToRpcMessage()}
+	case models.SyncEntityCar:
+		obj, found := cars[id]
+		if !found {
+			return models.RecordNotFound
+		}
+		change.Record = &pb.SyncChange_Car{Car: obj.ToRpcMessage()}
+	case models.SyncEntityUserSettings:
+		repo := repository.UserSettingRepository{DB: db}
+		obj, err := repo.GetUserSettings(userID)
+		if err != nil {
+			return err
+		}
+		change.Record = &pb.SyncChange_Settings{Settings: obj.ToRpcMessage()}
+	}
+
+	return nil
+}
+
+func (sr *SyncRepositoryService) userCars(ctx context.Context, userID uint) (map[uint]*models.Car, error) {
+	repo := repository.CarRepository{DB: sr.app.DB.WithContext(ctx)}
+	dbCars, err := repo.GetCarsByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cars := make(map[uint]*models.Car, len(dbCars))
+	for _, dbCar := range dbCars {
+		cars[dbCar.ID] = dbCar
+	}
+
+	return cars, nil
+}
+
+func encodeSyncCursor(changeID uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(changeID, 10)))
+}
+
+func decodeSyncCursor(cursor string) (uint64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(string(data), 10, 64)
+}
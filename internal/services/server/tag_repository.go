@@ -0,0 +1,274 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"xelbot.com/auto-notes/server/internal/application"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const maxTagNameSize = 100
+
+// tagEntities are the record types tags can be attached to
+var tagEntities = []pb.SyncEntity{
+	pb.SyncEntity_SYNC_FUEL,
+	pb.SyncEntity_SYNC_ORDER,
+	pb.SyncEntity_SYNC_EXPENSE,
+	pb.SyncEntity_SYNC_SERVICE,
+	pb.SyncEntity_SYNC_MILEAGE,
+}
+
+type TagRepositoryService struct {
+	app application.Container
+}
+
+func NewTagRepositoryService(app application.Container) *TagRepositoryService {
+	return &TagRepositoryService{app: app}
+}
+
+func (tr *TagRepositoryService) GetTags(ctx context.Context, _ *emptypb.Empty) (*pb.TagCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	repo := repository.TagRepository{DB: tr.app.DB.WithContext(ctx)}
+	dbItems, err := repo.GetTagsByUser(user.ID)
+	if err != nil {
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	tr.app.Info("TagRepositoryService: populate tags", ctx, "cnt", len(dbItems))
+
+	return &pb.TagCollection{Tags: tagMessages(dbItems)}, nil
+}
+
+func (tr *TagRepositoryService) SaveTag(ctx context.Context, tag *pb.Tag) (*pb.Tag, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	name := strings.TrimSpace(tag.GetName())
+	if name == "" {
+		return nil, twirp.RequiredArgumentError("name")
+	}
+	if utf8.RuneCountInString(name) > maxTagNameSize {
+		return nil, twirp.InvalidArgument.Error(fmt.Sprintf("name is longer than %d characters", maxTagNameSize))
+	}
+
+	repo := repository.TagRepository{DB: tr.app.DB.WithContext(ctx)}
+
+	obj := &models.Tag{UserID: user.ID}
+	if tag.GetId() > 0 {
+		obj, err = tr.findTag(ctx, repo, user, uint(tag.GetId()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	same, err := repo.FindByName(user.ID, name)
+	if err == nil && same.ID != obj.ID {
+		return nil, twirp.AlreadyExists.Error("tag with this name already exists")
+	} else if err != nil && !errors.Is(err, models.RecordNotFound) {
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	obj.Name = name
+
+	tagID, err := repo.SaveTag(obj)
+	if err != nil {
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	dbItem, err := repo.Find(tagID)
+	if err != nil {
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	tr.app.Info("TagRepositoryService: tag saved", ctx, "id", tagID)
+
+	return dbItem.ToRpcMessage(), nil
+}
+
+func (tr *TagRepositoryService) DeleteTag(ctx context.Context, idReq *pb.IdRequest) (*emptypb.Empty, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	err = tr.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		repo := repository.TagRepository{DB: tx}
+		obj, err := tr.findTag(ctx, repo, user, uint(idReq.GetId()))
+		if err != nil {
+			return err
+		}
+
+		return repo.Delete(obj.ID)
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	tr.app.Info("TagRepositoryService: tag deleted", ctx, "id", idReq.GetId())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (tr *TagRepositoryService) SetRecordTags(ctx context.Context, req *pb.RecordTags) (*pb.TagCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	tagIDs := make([]uint, 0, len(req.GetTagIds()))
+	for _, id := range req.GetTagIds() {
+		if id <= 0 {
+			return nil, twirp.InvalidArgument.Error("invalid tag id")
+		}
+		tagIDs = append(tagIDs, uint(id))
+	}
+	slices.Sort(tagIDs)
+	tagIDs = slices.Compact(tagIDs)
+
+	var tags []*models.Tag
+	err = tr.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		err := checkRecordOwner(tr.app, ctx, tx, user, tagEntities, req.GetEntity(), uint(req.GetRecordId()))
+		if err != nil {
+			return err
+		}
+
+		repo := repository.TagRepository{DB: tx}
+		if len(tagIDs) > 0 {
+			tags, err = repo.GetTagsByIDs(user.ID, tagIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		// a missing tag does not exist or belongs to another user
+		if len(tags) != len(tagIDs) {
+			return twirp.InvalidArgument.Error("invalid tag id")
+		}
+
+		return repo.SetRecordTags(req.GetEntity(), uint(req.GetRecordId()), tagIDs)
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	tr.app.Info("TagRepositoryService: record tags saved", ctx, "entity", req.GetEntity().String(), "id", req.GetRecordId(), "cnt", len(tags))
+
+	return &pb.TagCollection{Tags: tagMessages(tags)}, nil
+}
+
+func (tr *TagRepositoryService) GetTagSummary(ctx context.Context, idReq *pb.IdRequest) (*pb.TagSummary, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	repo := repository.TagRepository{DB: tr.app.DB.WithContext(ctx)}
+	tag, err := tr.findTag(ctx, repo, user, uint(idReq.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := repo.GetTaggedRecords(tag.ID)
+	if err != nil {
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	converter, err := newCurrencyConverter(tr.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	err = loadRates(converter, records, func(obj *models.TaggedRecord) (*models.Cost, time.Time) { return obj.Cost, obj.Date })
+	if err != nil {
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	var convert func(*models.Cost, time.Time) *pb.Cost
+	if converter != nil {
+		convert = converter.convert
+	}
+
+	tr.app.Info("TagRepositoryService: tag summary", ctx, "id", tag.ID, "cnt", len(records))
+
+	return models.NewTagSummary(tag, records, convert), nil
+}
+
+func (tr *TagRepositoryService) findTag(
+	ctx context.Context,
+	repo repository.TagRepository,
+	user *security.UserClaims,
+	id uint,
+) (*models.Tag, error) {
+	if id == 0 {
+		return nil, twirp.InvalidArgument.Error("invalid id")
+	}
+
+	obj, err := repo.Find(id)
+	if err != nil {
+		return nil, toTwirpError(tr.app, err, ctx)
+	}
+
+	if obj.UserID != user.ID {
+		return nil, twirp.InvalidArgument.Error("invalid tag owner")
+	}
+
+	return obj, nil
+}
+
+// recordTags loads the tags of the listed records by the record id
+func recordTags[T any](db *database.DB, entity pb.SyncEntity, items []T, id func(T) uint) (map[uint][]*pb.Tag, error) {
+	recordIDs := make([]uint, 0, len(items))
+	for _, item := range items {
+		recordIDs = append(recordIDs, id(item))
+	}
+
+	repo := repository.TagRepository{DB: db}
+	dbTags, err := repo.GetRecordTags(entity, recordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint][]*pb.Tag, len(dbTags))
+	for recordID, items := range dbTags {
+		result[recordID] = tagMessages(items)
+	}
+
+	return result, nil
+}
+
+func tagMessages(items []*models.Tag) []*pb.Tag {
+	tags := make([]*pb.Tag, 0, len(items))
+	for _, item := range items {
+		tags = append(tags, item.ToRpcMessage())
+	}
+
+	return tags
+}
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"xelbot.com/auto-notes/server/internal/models"
+	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/security"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	pb "xelbot.com/auto-notes/server/rpc/server"
+)
+
+const maxTripNameSize = 100
+
+func (cr *CarRepositoryService) GetTrips(ctx context.Context, filter *pb.TripFilter) (*pb.TripCollection, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	repo := repository.TripRepository{DB: cr.app.DB.WithContext(ctx)}
+	dbItems, err := repo.GetTripsByUser(user.ID, uint(filter.GetCarId()), filter.GetOpen())
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	items := make([]*pb.Trip, 0, len(dbItems))
+	for _, dbItem := range dbItems {
+		items = append(items, dbItem.ToRpcMessage())
+	}
+
+	cr.app.Info("CarRepositoryService: populate trips", ctx, "cnt", len(dbItems))
+
+	return &pb.TripCollection{Trips: items}, nil
+}
+
+func (cr *CarRepositoryService) StartTrip(ctx context.Context, start *pb.TripStart) (*pb.Trip, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	name := strings.TrimSpace(start.GetName())
+	if name == "" {
+		return nil, twirp.RequiredArgumentError("name")
+	}
+	if utf8.RuneCountInString(name) > maxTripNameSize {
+		return nil, twirp.InvalidArgument.Error(fmt.Sprintf("name is longer than %d characters", maxTripNameSize))
+	}
+
+	if start.GetDistance() <= 0 {
+		return nil, twirp.InvalidArgument.Error("distance is required")
+	}
+
+	if start.GetDate() == nil {
+		return nil, twirp.InvalidArgument.Error("date is required")
+	}
+
+	return idempotent(cr.app, ctx, user.ID, "StartTrip", start, func() (*pb.Trip, error) {
+		return cr.startTrip(ctx, user, name, start)
+	})
+}
+
+// startTrip creates the start mileage and the trip in one transaction,
+// a car has one open trip at a time
+func (cr *CarRepositoryService) startTrip(ctx context.Context, user *security.UserClaims, name string, start *pb.TripStart) (*pb.Trip, error) {
+	var trip *models.Trip
+	err := cr.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		carRepo := repository.CarRepository{DB: tx}
+		car, err := carRepo.FindForUpdate(uint(start.GetCarId()))
+		if errors.Is(err, models.RecordNotFound) {
+			return twirp.InvalidArgument.Error("invalid car")
+		} else if err != nil {
+			return err
+		}
+		if car.UserID != user.ID {
+			return twirp.InvalidArgument.Error("invalid car owner")
+		}
+
+		// the car lock holds until the trip is saved
+		repo := repository.TripRepository{DB: tx}
+		if _, err = repo.FindOpen(car.ID); err == nil {
+			return twirp.FailedPrecondition.Error("car has an open trip")
+		} else if !errors.Is(err, models.RecordNotFound) {
+			return err
+		}
+
+		mileageRepo := repository.MileageRepository{DB: tx}
+		mileage, err := mileageRepo.FindOrCreate(uint(start.GetDistance()), car.ID, start.GetDate().AsTime())
+		if err != nil {
+			return err
+		}
+
+		tripID, err := repo.SaveTrip(&models.Trip{
+			UserID: user.ID,
+			Name:   name,
+			Car:    car,
+			Start:  mileage,
+		})
+		if err != nil {
+			return err
+		}
+
+		trip, err = repo.Find(tripID)
+
+		return err
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	cr.app.Info("CarRepositoryService: trip started", ctx, "id", trip.ID)
+
+	return trip.ToRpcMessage(), nil
+}
+
+func (cr *CarRepositoryService) CloseTrip(ctx context.Context, tripClose *pb.TripClose) (*pb.Trip, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	if tripClose.GetDistance() <= 0 {
+		return nil, twirp.InvalidArgument.Error("distance is required")
+	}
+
+	if tripClose.GetDate() == nil {
+		return nil, twirp.InvalidArgument.Error("date is required")
+	}
+
+	return idempotent(cr.app, ctx, user.ID, "CloseTrip", tripClose, func() (*pb.Trip, error) {
+		return cr.closeTrip(ctx, user, tripClose)
+	})
+}
+
+func (cr *CarRepositoryService) closeTrip(ctx context.Context, user *security.UserClaims, tripClose *pb.TripClose) (*pb.Trip, error) {
+	var trip *models.Trip
+	err := cr.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		repo := repository.TripRepository{DB: tx}
+		obj, err := cr.findTrip(ctx, repo, user, uint(tripClose.GetId()))
+		if err != nil {
+			return err
+		}
+
+		if !obj.IsOpen() {
+			return twirp.FailedPrecondition.Error("trip is already closed")
+		}
+
+		// the dates are compared by day: the request date is the midnight in UTC,
+		// the stored one is the midnight in the server timezone
+		date := tripClose.GetDate().AsTime()
+		if uint(tripClose.GetDistance()) < obj.Start.Distance || date.Format(time.DateOnly) < obj.Start.Date.Format(time.DateOnly) {
+			return twirp.InvalidArgument.Error("trip cannot end before its start")
+		}
+
+		mileageRepo := repository.MileageRepository{DB: tx}
+		obj.End, err = mileageRepo.FindOrCreate(uint(tripClose.GetDistance()), obj.Car.ID, date)
+		if err != nil {
+			return err
+		}
+
+		if _, err = repo.SaveTrip(obj); err != nil {
+			return err
+		}
+
+		trip, err = repo.Find(obj.ID)
+
+		return err
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return nil, err
+		}
+
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	cr.app.Info("CarRepositoryService: trip closed", ctx, "id", trip.ID)
+
+	return trip.ToRpcMessage(), nil
+}
+
+func (cr *CarRepositoryService) DeleteTrip(ctx context.Context, idReq *pb.IdRequest) (*emptypb.Empty, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	repo := repository.TripRepository{DB: cr.app.DB.WithContext(ctx)}
+	obj, err := cr.findTrip(ctx, repo, user, uint(idReq.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = repo.Delete(obj.ID); err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	cr.app.Info("CarRepositoryService: trip deleted", ctx, "id", obj.ID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetTripReport sums the costs of the trip, an open trip lasts until today
+// and its distance is taken from the last mileage of the car
+func (cr *CarRepositoryService) GetTripReport(ctx context.Context, idReq *pb.IdRequest) (*pb.TripReport, error) {
+	user, err := userClaimsFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error(err.Error())
+	}
+
+	db := cr.app.DB.WithContext(ctx)
+	repo := repository.TripRepository{DB: db}
+	trip, err := cr.findTrip(ctx, repo, user, uint(idReq.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	end := trip.End
+	endDate := userToday(ctx)
+	if end != nil {
+		endDate = end.Date
+	} else {
+		mileageRepo := repository.MileageRepository{DB: db}
+		end, err = mileageRepo.FindLast(trip.Car.ID)
+		if err != nil {
+			return nil, toTwirpError(cr.app, err, ctx)
+		}
+	}
+
+	var distance int32
+	if end.Distance > trip.Start.Distance {
+		distance = int32(end.Distance - trip.Start.Distance)
+	}
+
+	records, err := repo.GetTripRecords(trip, endDate)
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	converter, err := newCurrencyConverter(cr.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	err = loadRates(converter, records, func(obj *models.TripRecord) (*models.Cost, time.Time) { return obj.Cost, obj.Date })
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	var convert func(*models.Cost, time.Time) *pb.Cost
+	if converter != nil {
+		convert = converter.convert
+	}
+
+	units, err := userUnits(cr.app, ctx, user.ID)
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	cr.app.Info("CarRepositoryService: trip report", ctx, "id", trip.ID, "cnt", len(records))
+
+	return models.NewTripReport(trip, distance, records, convert, units), nil
+}
+
+func (cr *CarRepositoryService) findTrip(
+	ctx context.Context,
+	repo repository.TripRepository,
+	user *security.UserClaims,
+	id uint,
+) (*models.Trip, error) {
+	if id == 0 {
+		return nil, twirp.InvalidArgument.Error("invalid id")
+	}
+
+	obj, err := repo.Find(id)
+	if err != nil {
+		return nil, toTwirpError(cr.app, err, ctx)
+	}
+
+	if obj.UserID != user.ID {
+		return nil, twirp.InvalidArgument.Error("invalid trip owner")
+	}
+
+	return obj, nil
+}
@@ -3,6 +3,8 @@ package server
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/twitchtv/twirp"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -10,6 +12,8 @@ import (
 	"xelbot.com/auto-notes/server/internal/application"
 	"xelbot.com/auto-notes/server/internal/models"
 	"xelbot.com/auto-notes/server/internal/models/repository"
+	"xelbot.com/auto-notes/server/internal/utils/database"
+	"xelbot.com/auto-notes/server/internal/utils/exchangerates"
 	pb "xelbot.com/auto-notes/server/rpc/server"
 )
 
@@ -27,7 +31,7 @@ func (ur *UserRepositoryService) GetCars(ctx context.Context, _ *emptypb.Empty)
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.CarRepository{DB: ur.app.DB}
+	repo := repository.CarRepository{DB: ur.app.DB.WithContext(ctx)}
 	dbCars, err := repo.GetCarsByUser(user.ID)
 	if err != nil {
 		return nil, toTwirpError(ur.app, err, ctx)
@@ -35,21 +39,7 @@ func (ur *UserRepositoryService) GetCars(ctx context.Context, _ *emptypb.Empty)
 
 	cars := make([]*pb.Car, 0, len(dbCars))
 	for _, dbCar := range dbCars {
-		car := &pb.Car{
-			Id:        int32(dbCar.ID),
-			Name:      dbCar.Brand + " " + dbCar.Model,
-			Default:   dbCar.Default,
-			CreatedAt: timestamppb.New(dbCar.CreatedAt),
-		}
-
-		if dbCar.Vin.Valid {
-			car.Vin = dbCar.Vin.String
-		}
-		if dbCar.Year.Valid {
-			car.Year = dbCar.Year.Int32
-		}
-
-		cars = append(cars, car)
+		cars = append(cars, dbCar.ToRpcMessage())
 	}
 
 	ur.app.Info("UserRepositoryService: populate cars", ctx, "cnt", len(dbCars))
@@ -63,7 +53,7 @@ func (ur *UserRepositoryService) GetCurrencies(ctx context.Context, _ *emptypb.E
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.CurrencyRepository{DB: ur.app.DB}
+	repo := repository.CurrencyRepository{DB: ur.app.DB.WithContext(ctx)}
 	dbCurrencies, err := repo.GetCurrencies(user.ID)
 	if err != nil {
 		return nil, toTwirpError(ur.app, err, ctx)
@@ -93,7 +83,7 @@ func (ur *UserRepositoryService) GetDefaultCurrency(ctx context.Context, _ *empt
 		return nil, twirp.Unauthenticated.Error(err.Error())
 	}
 
-	repo := repository.CurrencyRepository{DB: ur.app.DB}
+	repo := repository.CurrencyRepository{DB: ur.app.DB.WithContext(ctx)}
 	dbCurrencies, err := repo.GetCurrencies(user.ID)
 	if err != nil {
 		return nil, toTwirpError(ur.app, err, ctx)
@@ -137,7 +127,7 @@ func (ur *UserRepositoryService) SaveUserSettings(ctx context.Context, settingsR
 
 	// TODO check car owner
 
-	repo := repository.UserSettingRepository{DB: ur.app.DB}
+	repo := repository.UserSettingRepository{DB: ur.app.DB.WithContext(ctx)}
 
 	settings := models.UserSetting{
 		ID: uint(settingsReq.Id),
@@ -165,16 +155,51 @@ func (ur *UserRepositoryService) SaveUserSettings(ctx context.Context, settingsR
 		settings.FuelTypeID.Int32 = settingsReq.DefaultFuelType.GetId()
 	}
 
+	// the units and the timezone are kept when the request leaves them unset,
+	// old clients do not know about them
+	stored, err := repo.GetUserSettings(user.ID)
+	if err == nil {
+		settings.DistanceUnit = stored.DistanceUnit
+		settings.VolumeUnit = stored.VolumeUnit
+		settings.Timezone = stored.Timezone
+	} else if !errors.Is(err, models.RecordNotFound) {
+		return nil, toTwirpError(ur.app, err, ctx)
+	}
+
+	if settingsReq.DistanceUnit != nil {
+		if _, found := pb.DistanceUnit_name[int32(settingsReq.GetDistanceUnit())]; !found {
+			return nil, twirp.InvalidArgument.Error("invalid distance unit")
+		}
+		settings.DistanceUnit = settingsReq.GetDistanceUnit()
+	}
+	if settingsReq.VolumeUnit != nil {
+		if _, found := pb.VolumeUnit_name[int32(settingsReq.GetVolumeUnit())]; !found {
+			return nil, twirp.InvalidArgument.Error("invalid volume unit")
+		}
+		settings.VolumeUnit = settingsReq.GetVolumeUnit()
+	}
+
+	if settingsReq.Timezone != nil {
+		settings.Timezone = strings.TrimSpace(settingsReq.GetTimezone())
+		if settings.Timezone != "" {
+			if _, err = time.LoadLocation(settings.Timezone); err != nil {
+				return nil, twirp.InvalidArgument.Error("invalid timezone")
+			}
+		}
+	}
+
 	err = repo.SaveUserSettings(&settings, user.ID)
 	if err != nil {
 		return nil, toTwirpError(ur.app, err, ctx)
 	}
 
+	application.ForgetUserLocation(user.ID)
+
 	return ur.userSettingsFromDB(ctx, user.ID)
 }
 
 func (ur *UserRepositoryService) userSettingsFromDB(ctx context.Context, userID uint) (*pb.UserSettings, error) {
-	repo := repository.UserSettingRepository{DB: ur.app.DB}
+	repo := repository.UserSettingRepository{DB: ur.app.DB.WithContext(ctx)}
 	dbUserSettings, err := repo.GetUserSettings(userID)
 	if err != nil {
 		if errors.Is(err, models.RecordNotFound) {
@@ -186,38 +211,129 @@ func (ur *UserRepositoryService) userSettingsFromDB(ctx context.Context, userID
 		return nil, toTwirpError(ur.app, err, ctx)
 	}
 
-	settings := pb.UserSettings{
-		Id:        int32(dbUserSettings.ID),
-		CreatedAt: timestamppb.New(dbUserSettings.CreatedAt),
-	}
+	settings := dbUserSettings.ToRpcMessage()
 
-	if dbUserSettings.CarID.Valid {
-		settings.DefaultCar = &pb.Car{
-			Id:   dbUserSettings.CarID.Int32,
-			Name: dbUserSettings.CarBrand.String + " " + dbUserSettings.CarModel.String,
-		}
+	ur.app.Info("UserRepositoryService: get user settings", ctx, "user_settings_id", settings.Id)
+
+	return settings, nil
+}
+
+func (ur *UserRepositoryService) SaveExchangeRates(ctx context.Context, req *pb.ExchangeRateCollection) (*pb.ExchangeRateCollection, error) {
+	// the rates are shared by every user
+	if _, err := adminClaimsFromContext(ctx); err != nil {
+		return nil, err
 	}
-	if dbUserSettings.CurrencyID.Valid {
-		settings.DefaultCurrency = &pb.Currency{
-			Id:   dbUserSettings.CurrencyID.Int32,
-			Name: dbUserSettings.CurrencyName.String,
-			Code: dbUserSettings.CurrencyCode.String,
+
+	rates := make([]*models.ExchangeRate, 0, len(req.GetRates()))
+	for _, rate := range req.GetRates() {
+		if rate.GetDate() == nil {
+			return nil, twirp.InvalidArgument.Error("date is required")
 		}
-		if dbUserSettings.CurrencyCreatedAt.Valid {
-			settings.DefaultCurrency.CreatedAt = timestamppb.New(dbUserSettings.CurrencyCreatedAt.Time)
+		if rate.GetRate() <= 0 {
+			return nil, twirp.InvalidArgument.Error("rate must be positive")
 		}
+
+		rates = append(rates, &models.ExchangeRate{
+			CurrencyCode: strings.ToUpper(rate.GetCurrency()),
+			BaseCode:     strings.ToUpper(rate.GetBase()),
+			Date:         rate.GetDate().AsTime(),
+			Rate:         rate.GetRate(),
+		})
 	}
-	if dbUserSettings.FuelTypeID.Valid {
-		settings.DefaultFuelType = &pb.FuelType{
-			Id:   dbUserSettings.FuelTypeID.Int32,
-			Name: dbUserSettings.FuelTypeName.String,
-		}
+
+	_, err := ur.saveExchangeRates(ctx, rates, false)
+	if err != nil {
+		return nil, err
 	}
-	if dbUserSettings.UpdatedAt.Valid {
-		settings.UpdatedAt = timestamppb.New(dbUserSettings.UpdatedAt.Time)
+
+	items := make([]*pb.ExchangeRate, 0, len(rates))
+	for _, rate := range rates {
+		items = append(items, rate.ToRpcMessage())
 	}
 
-	ur.app.Info("UserRepositoryService: get user settings", ctx, "user_settings_id", settings.Id)
+	ur.app.Info("UserRepositoryService: save exchange rates", ctx, "cnt", len(items))
+
+	return &pb.ExchangeRateCollection{Rates: items}, nil
+}
+
+func (ur *UserRepositoryService) ImportExchangeRates(ctx context.Context, req *pb.ExchangeRateImport) (*pb.ExchangeRateImportResult, error) {
+	// the rates are shared by every user
+	if _, err := adminClaimsFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	parsed, err := exchangerates.Parse(req.GetData())
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	rates := make([]*models.ExchangeRate, 0, len(parsed))
+	for _, rate := range parsed {
+		rates = append(rates, &models.ExchangeRate{
+			CurrencyCode: rate.Currency,
+			BaseCode:     rate.Base,
+			Date:         rate.Date,
+			Rate:         rate.Rate,
+		})
+	}
+
+	imported, err := ur.saveExchangeRates(ctx, rates, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ur.app.Info("UserRepositoryService: import exchange rates", ctx, "imported", imported, "cnt", len(rates))
+
+	return &pb.ExchangeRateImportResult{
+		Imported: int32(imported),
+		Skipped:  int32(len(rates) - imported),
+	}, nil
+}
+
+// saveExchangeRates stores the rates in a transaction, rates of unknown
+// currencies are either skipped or rejected
+func (ur *UserRepositoryService) saveExchangeRates(ctx context.Context, rates []*models.ExchangeRate, skipUnknown bool) (int, error) {
+	var saved int
+	err := ur.app.DB.WithContext(ctx).Transaction(func(tx *database.DB) error {
+		lookups := newSaveLookups(tx)
+		repo := repository.ExchangeRateRepository{DB: tx}
+		for _, rate := range rates {
+			if rate.CurrencyCode == rate.BaseCode {
+				return twirp.InvalidArgument.Error("currency and base must differ")
+			}
+
+			currency, err := lookups.currency(rate.CurrencyCode)
+			if err == nil {
+				rate.CurrencyID = currency.ID
+				currency, err = lookups.currency(rate.BaseCode)
+			}
+			if errors.Is(err, models.RecordNotFound) {
+				if skipUnknown {
+					continue
+				}
+
+				return twirp.InvalidArgument.Error("invalid currency")
+			} else if err != nil {
+				return err
+			}
+			rate.BaseCurrencyID = currency.ID
+
+			if err = repo.SaveRate(rate); err != nil {
+				return err
+			}
+			saved++
+		}
+
+		return nil
+	})
+	if err != nil {
+		var twerr twirp.Error
+		if errors.As(err, &twerr) {
+			return 0, err
+		}
+
+		return 0, toTwirpError(ur.app, err, ctx)
+	}
 
-	return &settings, nil
+	return saved, nil
 }
@@ -1,39 +1,146 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const tracerName = "xelbot.com/auto-notes/server/internal/utils/database"
+
 var regSpaces = regexp.MustCompile(`\s+`)
 
+type conn interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
 type DB struct {
 	db     *sql.DB
+	conn   conn
 	logger *slog.Logger
+	ctx    context.Context
 }
 
 func Wrap(db *sql.DB, logger *slog.Logger) *DB {
 	return &DB{
 		db:     db,
+		conn:   db,
 		logger: logger,
+		ctx:    context.Background(),
 	}
 }
 
-func (dbw *DB) Query(query string, args ...any) (*sql.Rows, error) {
+// WithContext returns a copy of the wrapper bound to the request context,
+// so queries are cancelled with the request and traced as its child spans
+func (dbw *DB) WithContext(ctx context.Context) *DB {
+	return &DB{
+		db:     dbw.db,
+		conn:   dbw.conn,
+		logger: dbw.logger,
+		ctx:    ctx,
+	}
+}
+
+// Transaction runs fn with a wrapper bound to a new transaction, which is
+// committed when fn returns nil and rolled back otherwise. Nested calls
+// reuse the outer transaction
+func (dbw *DB) Transaction(fn func(tx *DB) error) (err error) {
+	if _, ok := dbw.conn.(*sql.Tx); ok {
+		return fn(dbw)
+	}
+
+	tx, err := dbw.db.BeginTx(dbw.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	err = fn(&DB{
+		db:     dbw.db,
+		conn:   tx,
+		logger: dbw.logger,
+		ctx:    dbw.ctx,
+	})
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			dbw.logger.Error("[SQL] rollback failed", "error", rbErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Rows ends the span of the query when the rows are read to the end
+// or closed, so the span covers fetching the rows and their errors
+type Rows struct {
+	*sql.Rows
+	span trace.Span
+	once sync.Once
+}
+
+func (r *Rows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+
+	r.endSpan(nil)
+
+	return false
+}
+
+func (r *Rows) Close() error {
+	err := r.Rows.Close()
+	r.endSpan(err)
+
+	return err
+}
+
+func (r *Rows) endSpan(err error) {
+	r.once.Do(func() {
+		endSpan(r.span, errors.Join(r.Rows.Err(), err))
+	})
+}
+
+func (dbw *DB) Query(query string, args ...any) (*Rows, error) {
 	start := time.Now()
-	rows, err := dbw.db.Query(query, args...)
+	ctx, span := dbw.startSpan(query)
+	rows, err := dbw.conn.QueryContext(ctx, query, args...)
 	dbw.logQuery(start, query, args...)
+	if err != nil {
+		endSpan(span, err)
+
+		return nil, err
+	}
 
-	return rows, err
+	return &Rows{Rows: rows, span: span}, nil
 }
 
 func (dbw *DB) QueryRow(query string, args ...any) *sql.Row {
 	start := time.Now()
-	row := dbw.db.QueryRow(query, args...)
+	ctx, span := dbw.startSpan(query)
+	row := dbw.conn.QueryRowContext(ctx, query, args...)
+	endSpan(span, row.Err())
 	dbw.logQuery(start, query, args...)
 
 	return row
@@ -41,12 +148,20 @@ func (dbw *DB) QueryRow(query string, args ...any) *sql.Row {
 
 func (dbw *DB) Exec(query string, args ...any) (sql.Result, error) {
 	start := time.Now()
-	result, err := dbw.db.Exec(query, args...)
+	ctx, span := dbw.startSpan(query)
+	result, err := dbw.conn.ExecContext(ctx, query, args...)
+	endSpan(span, err)
 	dbw.logQuery(start, query, args...)
 
 	return result, err
 }
 
+func (dbw *DB) SetPool(maxOpen, maxIdle int, lifetime time.Duration) {
+	dbw.db.SetMaxOpenConns(maxOpen)
+	dbw.db.SetMaxIdleConns(maxIdle)
+	dbw.db.SetConnMaxLifetime(lifetime)
+}
+
 func (dbw *DB) Close() error {
 	return dbw.db.Close()
 }
@@ -55,6 +170,32 @@ func (dbw *DB) logQuery(t time.Time, query string, args ...any) {
 	dbw.logger.Debug("[SQL]", "query", cleanQueryString(query), "params", fmt.Sprintf("%+v", args), "duration", time.Since(t))
 }
 
+func (dbw *DB) startSpan(query string) (context.Context, trace.Span) {
+	query = cleanQueryString(query)
+	operation, _, _ := strings.Cut(query, " ")
+	operation = strings.ToUpper(operation)
+
+	return otel.Tracer(tracerName).Start(
+		dbw.ctx,
+		"SQL "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNameMySQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(query),
+		),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
 func cleanQueryString(query string) string {
 	query = strings.ReplaceAll(query, "\n", " ")
 	query = strings.ReplaceAll(query, "\t", " ")
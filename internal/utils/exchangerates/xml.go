@@ -0,0 +1,166 @@
+package exchangerates
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+var UnknownFormat = errors.New("exchangerates: unknown XML format")
+
+// Rate is a price of one unit of the currency in units of the base currency
+type Rate struct {
+	Currency string
+	Base     string
+	Date     time.Time
+	Rate     float64
+}
+
+type cbrRates struct {
+	Date    string `xml:"Date,attr"`
+	Valutes []struct {
+		CharCode string `xml:"CharCode"`
+		Nominal  string `xml:"Nominal"`
+		Value    string `xml:"Value"`
+	} `xml:"Valute"`
+}
+
+type ecbRates struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// Parse reads the daily rates of the Central Bank of Russia (ValCurs,
+// base RUB) or of the European Central Bank (eurofxref, base EUR)
+func Parse(data []byte) ([]Rate, error) {
+	root, err := rootElement(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch root {
+	case "ValCurs":
+		return parseCbr(data)
+	case "Envelope":
+		return parseEcb(data)
+	}
+
+	return nil, UnknownFormat
+}
+
+func parseCbr(data []byte) ([]Rate, error) {
+	var doc cbrRates
+	if err := newDecoder(data).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	date, err := time.Parse("02.01.2006", doc.Date)
+	if err != nil {
+		return nil, fmt.Errorf("exchangerates: invalid date %q", doc.Date)
+	}
+
+	rates := make([]Rate, 0, len(doc.Valutes))
+	for _, item := range doc.Valutes {
+		value, err := parseNumber(item.Value)
+		if err != nil {
+			return nil, fmt.Errorf("exchangerates: invalid rate of %s", item.CharCode)
+		}
+
+		nominal, err := parseNumber(item.Nominal)
+		if err != nil || nominal <= 0 {
+			return nil, fmt.Errorf("exchangerates: invalid nominal of %s", item.CharCode)
+		}
+
+		rates = append(rates, Rate{
+			Currency: strings.TrimSpace(item.CharCode),
+			Base:     "RUB",
+			Date:     date,
+			Rate:     value / nominal,
+		})
+	}
+
+	return rates, nil
+}
+
+func parseEcb(data []byte) ([]Rate, error) {
+	var doc ecbRates
+	if err := newDecoder(data).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	rates := make([]Rate, 0)
+	for _, day := range doc.Days {
+		date, err := time.Parse(time.DateOnly, day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("exchangerates: invalid date %q", day.Time)
+		}
+
+		for _, item := range day.Rates {
+			value, err := parseNumber(item.Rate)
+			if err != nil || value <= 0 {
+				return nil, fmt.Errorf("exchangerates: invalid rate of %s", item.Currency)
+			}
+
+			// ECB gives the price of one euro in the currency
+			rates = append(rates, Rate{
+				Currency: strings.TrimSpace(item.Currency),
+				Base:     "EUR",
+				Date:     date,
+				Rate:     1 / value,
+			})
+		}
+	}
+
+	return rates, nil
+}
+
+func rootElement(data []byte) (string, error) {
+	decoder := newDecoder(data)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", UnknownFormat
+			}
+
+			return "", err
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+func newDecoder(data []byte) *xml.Decoder {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		switch strings.ToLower(label) {
+		case "windows-1251", "cp1251":
+			return charmap.Windows1251.NewDecoder().Reader(input), nil
+		case "utf-8", "us-ascii":
+			return input, nil
+		}
+
+		return nil, fmt.Errorf("exchangerates: unsupported charset %q", label)
+	}
+
+	return decoder
+}
+
+// parseNumber accepts both decimal separators, CBR uses a comma
+func parseNumber(value string) (float64, error) {
+	return strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(value), ",", "."), 64)
+}
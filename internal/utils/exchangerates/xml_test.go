@@ -0,0 +1,78 @@
+package exchangerates
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+const cbrXml = `<?xml version="1.0" encoding="windows-1251"?>
+<ValCurs Date="02.03.2024" name="Foreign Currency Market">
+<Valute ID="R01235"><NumCode>840</NumCode><CharCode>USD</CharCode><Nominal>1</Nominal><Name>US Dollar</Name><Value>91,3336</Value></Valute>
+<Valute ID="R01375"><NumCode>156</NumCode><CharCode>CNY</CharCode><Nominal>10</Nominal><Name>Yuan</Name><Value>126,5890</Value></Valute>
+</ValCurs>`
+
+const ecbXml = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2024-03-01">
+			<Cube currency="USD" rate="1.0808"/>
+			<Cube currency="GBP" rate="0.8556"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []Rate
+	}{
+		{
+			name: "cbr",
+			data: cbrXml,
+			want: []Rate{
+				{Currency: "USD", Base: "RUB", Date: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), Rate: 91.3336},
+				{Currency: "CNY", Base: "RUB", Date: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), Rate: 12.6589},
+			},
+		},
+		{
+			name: "ecb",
+			data: ecbXml,
+			want: []Rate{
+				{Currency: "USD", Base: "EUR", Date: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Rate: 1 / 1.0808},
+				{Currency: "GBP", Base: "EUR", Date: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Rate: 1 / 0.8556},
+			},
+		},
+	}
+
+	for _, item := range tests {
+		t.Run(item.name, func(t *testing.T) {
+			rates, err := Parse([]byte(item.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(rates) != len(item.want) {
+				t.Fatalf("got %d rates; want %d", len(rates), len(item.want))
+			}
+
+			for idx, rate := range rates {
+				want := item.want[idx]
+				if rate.Currency != want.Currency || rate.Base != want.Base || !rate.Date.Equal(want.Date) {
+					t.Errorf("%d: got %+v; want %+v", idx, rate, want)
+				}
+				if math.Abs(rate.Rate-want.Rate) > 1e-9 {
+					t.Errorf("%d: got rate %f; want %f", idx, rate.Rate, want.Rate)
+				}
+			}
+		})
+	}
+}
+
+func TestParseUnknown(t *testing.T) {
+	if _, err := Parse([]byte(`<rates/>`)); err != UnknownFormat {
+		t.Errorf("got %v; want %v", err, UnknownFormat)
+	}
+}
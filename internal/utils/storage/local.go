@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var InvalidKey = errors.New("storage: invalid key")
+
+// Local keeps the files in a directory, keys are paths relative to the root
+type Local struct {
+	root string
+}
+
+func NewLocal(root string) *Local {
+	return &Local{root: root}
+}
+
+// NewKey returns a random key in the directory of the prefix
+func NewKey(prefix, ext string) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return filepath.ToSlash(filepath.Join(prefix, hex.EncodeToString(buf)+ext)), nil
+}
+
+func (l *Local) Save(key string, data []byte) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so a reader never sees a partial file
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0o640); err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+	}
+
+	return err
+}
+
+func (l *Local) Read(key string) ([]byte, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(path)
+}
+
+// Delete removes the file, a missing file is not an error
+func (l *Local) Delete(key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (l *Local) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", InvalidKey
+	}
+
+	return filepath.Join(l.root, cleaned), nil
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestLocal(t *testing.T) {
+	store := NewLocal(t.TempDir())
+
+	key, err := NewKey("1/2024", ".pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Save(key, []byte("%PDF-1.4")); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := store.Read(key)
+	if err != nil || string(data) != "%PDF-1.4" {
+		t.Errorf("got %q, %v; want %q", data, err, "%PDF-1.4")
+	}
+
+	if err = store.Delete(key); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Delete(key); err != nil {
+		t.Errorf("second delete: %v", err)
+	}
+
+	for _, key = range []string{"", "../secret", "/etc/passwd", "a/../../b"} {
+		if _, err = store.Read(key); err != InvalidKey {
+			t.Errorf("%q: got %v; want %v", key, err, InvalidKey)
+		}
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 400, 100))); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := Thumbnail(buf.Bytes(), 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Width != 200 || cfg.Height != 50 {
+		t.Errorf("got %dx%d; want 200x50", cfg.Width, cfg.Height)
+	}
+}
+
+func TestThumbnailTooLarge(t *testing.T) {
+	// a GIF header of 10000x10000 pixels without the image data
+	data := []byte("GIF89a\x10\x27\x10\x27\x00\x00\x00")
+
+	if _, err := Thumbnail(data, 200); err != ImageTooLarge {
+		t.Errorf("got %v; want %v", err, ImageTooLarge)
+	}
+}
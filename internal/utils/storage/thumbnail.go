@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+
+	_ "image/gif"
+	_ "image/png"
+)
+
+const (
+	thumbnailQuality = 80
+	// a decoded image takes 4-8 bytes a pixel, a few KB of a file
+	// may claim a huge one
+	maxThumbnailPixels = 40_000_000
+)
+
+var ImageTooLarge = errors.New("storage: image is too large")
+
+// Thumbnail scales the image down to fit the size and encodes it as JPEG,
+// every pixel of the thumbnail is the average of the source pixels it covers.
+// Transparent pixels are put on a white background
+func Thumbnail(data []byte, size int) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width*cfg.Height > maxThumbnailPixels {
+		return nil, ImageTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/bounds.Dx())
+		} else {
+			width, height = max(1, width*size/bounds.Dy()), size
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+
+			// the colors are premultiplied by alpha, the rest is white
+			white := 0xffff*n - a
+			dst.Set(x, y, color.RGBA64{
+				R: uint16((r + white) / n),
+				G: uint16((g + white) / n),
+				B: uint16((b + white) / n),
+				A: 0xffff,
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,93 @@
+-- Change log for SyncRepository.GetChanges and SyncStream.Sync.
+-- Every insert, update and delete is recorded by triggers, so changes made
+-- by other clients of the database are synced as well.
+
+CREATE TABLE sync_changes (
+  id BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+  user_id INT NOT NULL,
+  entity VARCHAR(16) NOT NULL,
+  record_id INT NOT NULL,
+  deleted TINYINT(1) DEFAULT 0 NOT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  INDEX idx_sync_changes_user (user_id, id),
+  INDEX idx_sync_changes_record (entity, record_id, id),
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
+
+INSERT INTO sync_changes (user_id, entity, record_id)
+SELECT t.user_id, 'FUEL', t.id FROM fuels AS t ORDER BY t.id;
+INSERT INTO sync_changes (user_id, entity, record_id)
+SELECT t.user_id, 'ORDER', t.id FROM orders AS t ORDER BY t.id;
+INSERT INTO sync_changes (user_id, entity, record_id)
+SELECT t.user_id, 'EXPENSE', t.id FROM expenses AS t ORDER BY t.id;
+INSERT INTO sync_changes (user_id, entity, record_id)
+SELECT c.user_id, 'SERVICE', t.id FROM services AS t INNER JOIN cars AS c ON c.id = t.car_id ORDER BY t.id;
+INSERT INTO sync_changes (user_id, entity, record_id)
+SELECT c.user_id, 'MILEAGE', t.id FROM mileages AS t INNER JOIN cars AS c ON c.id = t.car_id ORDER BY t.id;
+INSERT INTO sync_changes (user_id, entity, record_id)
+SELECT t.user_id, 'CAR', t.id FROM cars AS t ORDER BY t.id;
+INSERT INTO sync_changes (user_id, entity, record_id)
+SELECT t.user_id, 'USER_SETTINGS', t.id FROM user_settings AS t ORDER BY t.id;
+
+CREATE TRIGGER sync_fuels_insert AFTER INSERT ON fuels FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'FUEL', NEW.id);
+
+CREATE TRIGGER sync_fuels_update AFTER UPDATE ON fuels FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'FUEL', NEW.id);
+
+CREATE TRIGGER sync_fuels_delete AFTER DELETE ON fuels FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id, deleted) VALUES (OLD.user_id, 'FUEL', OLD.id, 1);
+
+CREATE TRIGGER sync_orders_insert AFTER INSERT ON orders FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'ORDER', NEW.id);
+
+CREATE TRIGGER sync_orders_update AFTER UPDATE ON orders FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'ORDER', NEW.id);
+
+CREATE TRIGGER sync_orders_delete AFTER DELETE ON orders FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id, deleted) VALUES (OLD.user_id, 'ORDER', OLD.id, 1);
+
+CREATE TRIGGER sync_expenses_insert AFTER INSERT ON expenses FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'EXPENSE', NEW.id);
+
+CREATE TRIGGER sync_expenses_update AFTER UPDATE ON expenses FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'EXPENSE', NEW.id);
+
+CREATE TRIGGER sync_expenses_delete AFTER DELETE ON expenses FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id, deleted) VALUES (OLD.user_id, 'EXPENSE', OLD.id, 1);
+
+CREATE TRIGGER sync_services_insert AFTER INSERT ON services FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (IFNULL((SELECT c.user_id FROM cars AS c WHERE c.id = NEW.car_id), 0), 'SERVICE', NEW.id);
+
+CREATE TRIGGER sync_services_update AFTER UPDATE ON services FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (IFNULL((SELECT c.user_id FROM cars AS c WHERE c.id = NEW.car_id), 0), 'SERVICE', NEW.id);
+
+CREATE TRIGGER sync_services_delete AFTER DELETE ON services FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id, deleted) VALUES (IFNULL((SELECT c.user_id FROM cars AS c WHERE c.id = OLD.car_id), 0), 'SERVICE', OLD.id, 1);
+
+CREATE TRIGGER sync_mileages_insert AFTER INSERT ON mileages FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (IFNULL((SELECT c.user_id FROM cars AS c WHERE c.id = NEW.car_id), 0), 'MILEAGE', NEW.id);
+
+CREATE TRIGGER sync_mileages_update AFTER UPDATE ON mileages FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (IFNULL((SELECT c.user_id FROM cars AS c WHERE c.id = NEW.car_id), 0), 'MILEAGE', NEW.id);
+
+CREATE TRIGGER sync_mileages_delete AFTER DELETE ON mileages FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id, deleted) VALUES (IFNULL((SELECT c.user_id FROM cars AS c WHERE c.id = OLD.car_id), 0), 'MILEAGE', OLD.id, 1);
+
+CREATE TRIGGER sync_cars_insert AFTER INSERT ON cars FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'CAR', NEW.id);
+
+CREATE TRIGGER sync_cars_update AFTER UPDATE ON cars FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'CAR', NEW.id);
+
+CREATE TRIGGER sync_cars_delete AFTER DELETE ON cars FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id, deleted) VALUES (OLD.user_id, 'CAR', OLD.id, 1);
+
+CREATE TRIGGER sync_user_settings_insert AFTER INSERT ON user_settings FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'USER_SETTINGS', NEW.id);
+
+CREATE TRIGGER sync_user_settings_update AFTER UPDATE ON user_settings FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id) VALUES (NEW.user_id, 'USER_SETTINGS', NEW.id);
+
+CREATE TRIGGER sync_user_settings_delete AFTER DELETE ON user_settings FOR EACH ROW
+  INSERT INTO sync_changes (user_id, entity, record_id, deleted) VALUES (OLD.user_id, 'USER_SETTINGS', OLD.id, 1);
@@ -0,0 +1,14 @@
+-- Results of Save* calls with an Idempotency-Key, replayed on retries
+
+CREATE TABLE idempotency_keys (
+  id INT AUTO_INCREMENT NOT NULL,
+  user_id INT NOT NULL,
+  idempotency_key VARCHAR(255) NOT NULL,
+  method VARCHAR(64) NOT NULL,
+  request_hash BINARY(32) NOT NULL,
+  response MEDIUMBLOB DEFAULT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  UNIQUE INDEX uniq_idempotency_key (user_id, idempotency_key),
+  INDEX idx_idempotency_created (created_at),
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
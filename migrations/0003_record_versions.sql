@@ -0,0 +1,7 @@
+-- Row versions for optimistic concurrency control in SaveFuel, SaveOrder
+-- and SaveExpense: every update increases the version, and an update
+-- based on an outdated version is rejected.
+
+ALTER TABLE fuels ADD version INT UNSIGNED DEFAULT 1 NOT NULL;
+ALTER TABLE orders ADD version INT UNSIGNED DEFAULT 1 NOT NULL;
+ALTER TABLE expenses ADD version INT UNSIGNED DEFAULT 1 NOT NULL;
@@ -0,0 +1,15 @@
+-- Exchange rates per date: one unit of the currency costs `rate` units
+-- of the base currency. Amounts are converted by the latest rate on or
+-- before the date of the record, directly or through a common base.
+
+CREATE TABLE exchange_rates (
+  id INT AUTO_INCREMENT NOT NULL,
+  currency_id INT NOT NULL,
+  base_currency_id INT NOT NULL,
+  date DATE NOT NULL,
+  rate DECIMAL(20, 10) NOT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  UNIQUE INDEX uniq_exchange_rate (currency_id, base_currency_id, date),
+  INDEX idx_exchange_rate_base (base_currency_id, date),
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
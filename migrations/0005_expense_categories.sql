@@ -0,0 +1,33 @@
+-- User-defined expense categories. Categories without a user are built in,
+-- shared by everyone and mapped to the old ExpenseType enum, so expenses.type
+-- is still filled for the clients that know only the enum. Categories are
+-- nested one level deep.
+
+CREATE TABLE expense_categories (
+  id INT AUTO_INCREMENT NOT NULL,
+  user_id INT DEFAULT NULL,
+  parent_id INT DEFAULT NULL,
+  name VARCHAR(255) NOT NULL,
+  type SMALLINT NOT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  INDEX idx_expense_category_user (user_id),
+  INDEX idx_expense_category_parent (parent_id),
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
+
+INSERT INTO expense_categories (name, type) VALUES
+  ('Гараж', 1),
+  ('Инструменты', 2),
+  ('Налоги', 3),
+  ('Страховка', 4),
+  ('Дороги', 5),
+  ('Мойка', 6),
+  ('Парковка', 7),
+  ('Прочее', 99);
+
+ALTER TABLE expenses ADD category_id INT DEFAULT NULL;
+CREATE INDEX idx_expense_category ON expenses (category_id);
+
+UPDATE expenses AS e
+  INNER JOIN expense_categories AS ec ON ec.type = e.type AND ec.user_id IS NULL
+SET e.category_id = ec.id;
@@ -0,0 +1,28 @@
+-- Templates of expenses repeated every `interval` months or years.
+-- The scheduler creates an expense for every occurrence up to the current
+-- date; `occurrences` counts the created ones and `next_date` is the next
+-- date to create (NULL after the end date). The unique index on
+-- expenses(recurring_id, date) prevents duplicates.
+
+CREATE TABLE recurring_expenses (
+  id INT AUTO_INCREMENT NOT NULL,
+  user_id INT NOT NULL,
+  car_id INT DEFAULT NULL,
+  category_id INT NOT NULL,
+  currency_id INT NOT NULL,
+  cost NUMERIC(8, 2) NOT NULL,
+  description VARCHAR(255) NOT NULL,
+  frequency SMALLINT NOT NULL,
+  `interval` SMALLINT DEFAULT 1 NOT NULL,
+  start_date DATE NOT NULL,
+  end_date DATE DEFAULT NULL,
+  occurrences INT UNSIGNED DEFAULT 0 NOT NULL,
+  next_date DATE DEFAULT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  INDEX idx_recurring_expense_user (user_id),
+  INDEX idx_recurring_expense_next (next_date),
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
+
+ALTER TABLE expenses ADD recurring_id INT DEFAULT NULL;
+CREATE UNIQUE INDEX uniq_expense_recurring ON expenses (recurring_id, date);
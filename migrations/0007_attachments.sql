@@ -0,0 +1,18 @@
+-- Files attached to records. `entity` is the SyncEntity value of the record
+-- type, the files are kept in the attachments.root directory under `path`.
+
+CREATE TABLE attachments (
+  id INT AUTO_INCREMENT NOT NULL,
+  user_id INT NOT NULL,
+  entity SMALLINT NOT NULL,
+  record_id INT NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  mime_type VARCHAR(100) NOT NULL,
+  size INT UNSIGNED NOT NULL,
+  path VARCHAR(255) NOT NULL,
+  thumbnail_path VARCHAR(255) DEFAULT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  INDEX idx_attachment_record (entity, record_id),
+  INDEX idx_attachment_user (user_id),
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
@@ -0,0 +1,22 @@
+-- User-defined tags for grouping records of different types, for example
+-- all the costs of a trip. `entity` is the SyncEntity value of the record
+-- type as in the attachments table. Fuels get a description for notes.
+
+CREATE TABLE tags (
+  id INT AUTO_INCREMENT NOT NULL,
+  user_id INT NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  UNIQUE INDEX uniq_tag_name (user_id, name),
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
+
+CREATE TABLE record_tags (
+  tag_id INT NOT NULL,
+  entity SMALLINT NOT NULL,
+  record_id INT NOT NULL,
+  INDEX idx_record_tag_record (entity, record_id),
+  PRIMARY KEY (tag_id, entity, record_id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
+
+ALTER TABLE fuels ADD description VARCHAR(255) NOT NULL DEFAULT '';
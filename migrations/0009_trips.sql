@@ -0,0 +1,15 @@
+-- Trips between two odometer readings of a car, `end_mileage_id` is empty
+-- while the trip is open.
+
+CREATE TABLE trips (
+  id INT AUTO_INCREMENT NOT NULL,
+  user_id INT NOT NULL,
+  car_id INT NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  start_mileage_id INT NOT NULL,
+  end_mileage_id INT DEFAULT NULL,
+  created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  INDEX idx_trip_car (car_id),
+  INDEX idx_trip_user (user_id),
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE `utf8mb4_unicode_ci` ENGINE = InnoDB;
@@ -0,0 +1,6 @@
+-- Units of the statistics and the reports and the timezone of the user,
+-- the records are stored in km and litres regardless of them.
+
+ALTER TABLE user_settings ADD distance_unit SMALLINT DEFAULT 0 NOT NULL;
+ALTER TABLE user_settings ADD volume_unit SMALLINT DEFAULT 0 NOT NULL;
+ALTER TABLE user_settings ADD timezone VARCHAR(64) DEFAULT '' NOT NULL;
@@ -23,6 +23,243 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DistanceUnit int32
+
+const (
+	DistanceUnit_DISTANCE_KM    DistanceUnit = 0
+	DistanceUnit_DISTANCE_MILES DistanceUnit = 1
+)
+
+// Enum value maps for DistanceUnit.
+var (
+	DistanceUnit_name = map[int32]string{
+		0: "DISTANCE_KM",
+		1: "DISTANCE_MILES",
+	}
+	DistanceUnit_value = map[string]int32{
+		"DISTANCE_KM":    0,
+		"DISTANCE_MILES": 1,
+	}
+)
+
+func (x DistanceUnit) Enum() *DistanceUnit {
+	p := new(DistanceUnit)
+	*p = x
+	return p
+}
+
+func (x DistanceUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DistanceUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[0].Descriptor()
+}
+
+func (DistanceUnit) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[0]
+}
+
+func (x DistanceUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DistanceUnit.Descriptor instead.
+func (DistanceUnit) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{0}
+}
+
+type VolumeUnit int32
+
+const (
+	VolumeUnit_VOLUME_LITRES           VolumeUnit = 0
+	VolumeUnit_VOLUME_US_GALLONS       VolumeUnit = 1
+	VolumeUnit_VOLUME_IMPERIAL_GALLONS VolumeUnit = 2
+)
+
+// Enum value maps for VolumeUnit.
+var (
+	VolumeUnit_name = map[int32]string{
+		0: "VOLUME_LITRES",
+		1: "VOLUME_US_GALLONS",
+		2: "VOLUME_IMPERIAL_GALLONS",
+	}
+	VolumeUnit_value = map[string]int32{
+		"VOLUME_LITRES":           0,
+		"VOLUME_US_GALLONS":       1,
+		"VOLUME_IMPERIAL_GALLONS": 2,
+	}
+)
+
+func (x VolumeUnit) Enum() *VolumeUnit {
+	p := new(VolumeUnit)
+	*p = x
+	return p
+}
+
+func (x VolumeUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VolumeUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[1].Descriptor()
+}
+
+func (VolumeUnit) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[1]
+}
+
+func (x VolumeUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VolumeUnit.Descriptor instead.
+func (VolumeUnit) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{1}
+}
+
+type ConsumptionUnit int32
+
+const (
+	// volume per 100 distance units, e.g. litres per 100 km
+	ConsumptionUnit_CONSUMPTION_PER_100 ConsumptionUnit = 0
+	// miles per gallon, for miles with gallons
+	ConsumptionUnit_CONSUMPTION_MPG ConsumptionUnit = 1
+)
+
+// Enum value maps for ConsumptionUnit.
+var (
+	ConsumptionUnit_name = map[int32]string{
+		0: "CONSUMPTION_PER_100",
+		1: "CONSUMPTION_MPG",
+	}
+	ConsumptionUnit_value = map[string]int32{
+		"CONSUMPTION_PER_100": 0,
+		"CONSUMPTION_MPG":     1,
+	}
+)
+
+func (x ConsumptionUnit) Enum() *ConsumptionUnit {
+	p := new(ConsumptionUnit)
+	*p = x
+	return p
+}
+
+func (x ConsumptionUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsumptionUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[2].Descriptor()
+}
+
+func (ConsumptionUnit) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[2]
+}
+
+func (x ConsumptionUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsumptionUnit.Descriptor instead.
+func (ConsumptionUnit) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{2}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DESC SortDirection = 0
+	SortDirection_SORT_ASC  SortDirection = 1
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DESC",
+		1: "SORT_ASC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DESC": 0,
+		"SORT_ASC":  1,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[3].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[3]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{3}
+}
+
+type BatchMode int32
+
+const (
+	// the batch is saved in a transaction, the first failed item rolls it back
+	BatchMode_BATCH_ALL_OR_NOTHING BatchMode = 0
+	// every item is saved on its own, failed items are reported in the results
+	BatchMode_BATCH_BEST_EFFORT BatchMode = 1
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_ALL_OR_NOTHING",
+		1: "BATCH_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_ALL_OR_NOTHING": 0,
+		"BATCH_BEST_EFFORT":    1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[4].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[4]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{4}
+}
+
 type ExpenseType int32
 
 const (
@@ -74,11 +311,11 @@ func (x ExpenseType) String() string {
 }
 
 func (ExpenseType) Descriptor() protoreflect.EnumDescriptor {
-	return file_server_proto_enumTypes[0].Descriptor()
+	return file_server_proto_enumTypes[5].Descriptor()
 }
 
 func (ExpenseType) Type() protoreflect.EnumType {
-	return &file_server_proto_enumTypes[0]
+	return &file_server_proto_enumTypes[5]
 }
 
 func (x ExpenseType) Number() protoreflect.EnumNumber {
@@ -87,149 +324,427 @@ func (x ExpenseType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExpenseType.Descriptor instead.
 func (ExpenseType) EnumDescriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{0}
+	return file_server_proto_rawDescGZIP(), []int{5}
 }
 
-type ErrorCode int32
+type RecurrenceFrequency int32
 
 const (
-	ErrorCode_E001 ErrorCode = 0 // record not found
-	ErrorCode_E002 ErrorCode = 1 // invalid mileage
+	RecurrenceFrequency_RECURRENCE_MONTHLY RecurrenceFrequency = 0
+	RecurrenceFrequency_RECURRENCE_YEARLY  RecurrenceFrequency = 1
 )
 
-// Enum value maps for ErrorCode.
+// Enum value maps for RecurrenceFrequency.
 var (
-	ErrorCode_name = map[int32]string{
-		0: "E001",
-		1: "E002",
+	RecurrenceFrequency_name = map[int32]string{
+		0: "RECURRENCE_MONTHLY",
+		1: "RECURRENCE_YEARLY",
 	}
-	ErrorCode_value = map[string]int32{
-		"E001": 0,
-		"E002": 1,
+	RecurrenceFrequency_value = map[string]int32{
+		"RECURRENCE_MONTHLY": 0,
+		"RECURRENCE_YEARLY":  1,
 	}
 )
 
-func (x ErrorCode) Enum() *ErrorCode {
-	p := new(ErrorCode)
+func (x RecurrenceFrequency) Enum() *RecurrenceFrequency {
+	p := new(RecurrenceFrequency)
 	*p = x
 	return p
 }
 
-func (x ErrorCode) String() string {
+func (x RecurrenceFrequency) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_server_proto_enumTypes[1].Descriptor()
+func (RecurrenceFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[6].Descriptor()
 }
 
-func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_server_proto_enumTypes[1]
+func (RecurrenceFrequency) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[6]
 }
 
-func (x ErrorCode) Number() protoreflect.EnumNumber {
+func (x RecurrenceFrequency) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ErrorCode.Descriptor instead.
-func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RecurrenceFrequency.Descriptor instead.
+func (RecurrenceFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{6}
 }
 
-type Cost struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// integer value, decimal(8, 2) in MySQL
-	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	// currency code, for example RUB
-	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+type OdometerMethod int32
 
-func (x *Cost) Reset() {
-	*x = Cost{}
-	mi := &file_server_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+const (
+	OdometerMethod_ODOMETER_UNKNOWN OdometerMethod = 0
+	// there is a reading on the date
+	OdometerMethod_ODOMETER_EXACT OdometerMethod = 1
+	// between two readings
+	OdometerMethod_ODOMETER_INTERPOLATED OdometerMethod = 2
+	// before the first or after the last reading by the trend
+	OdometerMethod_ODOMETER_EXTRAPOLATED OdometerMethod = 3
+)
+
+// Enum value maps for OdometerMethod.
+var (
+	OdometerMethod_name = map[int32]string{
+		0: "ODOMETER_UNKNOWN",
+		1: "ODOMETER_EXACT",
+		2: "ODOMETER_INTERPOLATED",
+		3: "ODOMETER_EXTRAPOLATED",
+	}
+	OdometerMethod_value = map[string]int32{
+		"ODOMETER_UNKNOWN":      0,
+		"ODOMETER_EXACT":        1,
+		"ODOMETER_INTERPOLATED": 2,
+		"ODOMETER_EXTRAPOLATED": 3,
+	}
+)
+
+func (x OdometerMethod) Enum() *OdometerMethod {
+	p := new(OdometerMethod)
+	*p = x
+	return p
 }
 
-func (x *Cost) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x OdometerMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Cost) ProtoMessage() {}
+func (OdometerMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[7].Descriptor()
+}
 
-func (x *Cost) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (OdometerMethod) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[7]
 }
 
-// Deprecated: Use Cost.ProtoReflect.Descriptor instead.
-func (*Cost) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{0}
+func (x OdometerMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Cost) GetValue() int32 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
+// Deprecated: Use OdometerMethod.Descriptor instead.
+func (OdometerMethod) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Cost) GetCurrency() string {
-	if x != nil {
-		return x.Currency
+type MileageAnomalyKind int32
+
+const (
+	MileageAnomalyKind_MILEAGE_ANOMALY_UNKNOWN MileageAnomalyKind = 0
+	// breaks the growth of the readings, e.g. a typo of 12500 instead of 125000
+	MileageAnomalyKind_MILEAGE_NON_MONOTONIC MileageAnomalyKind = 1
+	// more than 2000 km a day from the previous or to the next reading
+	MileageAnomalyKind_MILEAGE_IMPLAUSIBLE_DISTANCE MileageAnomalyKind = 2
+	// a lower reading on the date of another one
+	MileageAnomalyKind_MILEAGE_DUPLICATE_DATE MileageAnomalyKind = 3
+)
+
+// Enum value maps for MileageAnomalyKind.
+var (
+	MileageAnomalyKind_name = map[int32]string{
+		0: "MILEAGE_ANOMALY_UNKNOWN",
+		1: "MILEAGE_NON_MONOTONIC",
+		2: "MILEAGE_IMPLAUSIBLE_DISTANCE",
+		3: "MILEAGE_DUPLICATE_DATE",
+	}
+	MileageAnomalyKind_value = map[string]int32{
+		"MILEAGE_ANOMALY_UNKNOWN":      0,
+		"MILEAGE_NON_MONOTONIC":        1,
+		"MILEAGE_IMPLAUSIBLE_DISTANCE": 2,
+		"MILEAGE_DUPLICATE_DATE":       3,
 	}
-	return ""
-}
+)
 
-type Car struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Vin           string                 `protobuf:"bytes,3,opt,name=vin,proto3" json:"vin,omitempty"`
-	Year          int32                  `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
-	Default       bool                   `protobuf:"varint,5,opt,name=default,proto3" json:"default,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x MileageAnomalyKind) Enum() *MileageAnomalyKind {
+	p := new(MileageAnomalyKind)
+	*p = x
+	return p
 }
 
-func (x *Car) Reset() {
-	*x = Car{}
-	mi := &file_server_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x MileageAnomalyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Car) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (MileageAnomalyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[8].Descriptor()
 }
 
-func (*Car) ProtoMessage() {}
+func (MileageAnomalyKind) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[8]
+}
 
-func (x *Car) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x MileageAnomalyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Car.ProtoReflect.Descriptor instead.
-func (*Car) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MileageAnomalyKind.Descriptor instead.
+func (MileageAnomalyKind) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{8}
+}
+
+type MileageFixAction int32
+
+const (
+	MileageFixAction_MILEAGE_FIX_UNKNOWN MileageFixAction = 0
+	// changes the distance of the reading
+	MileageFixAction_MILEAGE_FIX_SET_DISTANCE MileageFixAction = 1
+	// moves the fuels, orders, services and trips to the target and removes the reading
+	MileageFixAction_MILEAGE_FIX_MERGE MileageFixAction = 2
+	// removes the reading, the fuels, orders and services lose their mileage
+	MileageFixAction_MILEAGE_FIX_DELETE MileageFixAction = 3
+)
+
+// Enum value maps for MileageFixAction.
+var (
+	MileageFixAction_name = map[int32]string{
+		0: "MILEAGE_FIX_UNKNOWN",
+		1: "MILEAGE_FIX_SET_DISTANCE",
+		2: "MILEAGE_FIX_MERGE",
+		3: "MILEAGE_FIX_DELETE",
+	}
+	MileageFixAction_value = map[string]int32{
+		"MILEAGE_FIX_UNKNOWN":      0,
+		"MILEAGE_FIX_SET_DISTANCE": 1,
+		"MILEAGE_FIX_MERGE":        2,
+		"MILEAGE_FIX_DELETE":       3,
+	}
+)
+
+func (x MileageFixAction) Enum() *MileageFixAction {
+	p := new(MileageFixAction)
+	*p = x
+	return p
+}
+
+func (x MileageFixAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MileageFixAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[9].Descriptor()
+}
+
+func (MileageFixAction) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[9]
+}
+
+func (x MileageFixAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MileageFixAction.Descriptor instead.
+func (MileageFixAction) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{9}
+}
+
+type ErrorCode int32
+
+const (
+	ErrorCode_E001 ErrorCode = 0 // record not found
+	ErrorCode_E002 ErrorCode = 1 // invalid mileage
+	ErrorCode_E003 ErrorCode = 2 // record was modified by another client
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "E001",
+		1: "E002",
+		2: "E003",
+	}
+	ErrorCode_value = map[string]int32{
+		"E001": 0,
+		"E002": 1,
+		"E003": 2,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[10].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[10]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{10}
+}
+
+type SyncEntity int32
+
+const (
+	SyncEntity_SYNC_UNKNOWN       SyncEntity = 0
+	SyncEntity_SYNC_FUEL          SyncEntity = 1
+	SyncEntity_SYNC_ORDER         SyncEntity = 2
+	SyncEntity_SYNC_EXPENSE       SyncEntity = 3
+	SyncEntity_SYNC_SERVICE       SyncEntity = 4
+	SyncEntity_SYNC_MILEAGE       SyncEntity = 5
+	SyncEntity_SYNC_CAR           SyncEntity = 6
+	SyncEntity_SYNC_USER_SETTINGS SyncEntity = 7
+)
+
+// Enum value maps for SyncEntity.
+var (
+	SyncEntity_name = map[int32]string{
+		0: "SYNC_UNKNOWN",
+		1: "SYNC_FUEL",
+		2: "SYNC_ORDER",
+		3: "SYNC_EXPENSE",
+		4: "SYNC_SERVICE",
+		5: "SYNC_MILEAGE",
+		6: "SYNC_CAR",
+		7: "SYNC_USER_SETTINGS",
+	}
+	SyncEntity_value = map[string]int32{
+		"SYNC_UNKNOWN":       0,
+		"SYNC_FUEL":          1,
+		"SYNC_ORDER":         2,
+		"SYNC_EXPENSE":       3,
+		"SYNC_SERVICE":       4,
+		"SYNC_MILEAGE":       5,
+		"SYNC_CAR":           6,
+		"SYNC_USER_SETTINGS": 7,
+	}
+)
+
+func (x SyncEntity) Enum() *SyncEntity {
+	p := new(SyncEntity)
+	*p = x
+	return p
+}
+
+func (x SyncEntity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncEntity) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[11].Descriptor()
+}
+
+func (SyncEntity) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[11]
+}
+
+func (x SyncEntity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncEntity.Descriptor instead.
+func (SyncEntity) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{11}
+}
+
+type Cost struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// integer value, decimal(8, 2) in MySQL
+	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// currency code, for example RUB
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cost) Reset() {
+	*x = Cost{}
+	mi := &file_server_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cost) ProtoMessage() {}
+
+func (x *Cost) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cost.ProtoReflect.Descriptor instead.
+func (*Cost) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cost) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Cost) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Car struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Vin           string                 `protobuf:"bytes,3,opt,name=vin,proto3" json:"vin,omitempty"`
+	Year          int32                  `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	Default       bool                   `protobuf:"varint,5,opt,name=default,proto3" json:"default,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Car) Reset() {
+	*x = Car{}
+	mi := &file_server_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Car) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Car) ProtoMessage() {}
+
+func (x *Car) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Car.ProtoReflect.Descriptor instead.
+func (*Car) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Car) GetId() int32 {
@@ -281,67 +796,4365 @@ type CarCollection struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CarCollection) Reset() {
-	*x = CarCollection{}
-	mi := &file_server_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CarCollection) Reset() {
+	*x = CarCollection{}
+	mi := &file_server_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarCollection) ProtoMessage() {}
+
+func (x *CarCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarCollection.ProtoReflect.Descriptor instead.
+func (*CarCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CarCollection) GetCars() []*Car {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+type FillingStation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillingStation) Reset() {
+	*x = FillingStation{}
+	mi := &file_server_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillingStation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillingStation) ProtoMessage() {}
+
+func (x *FillingStation) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillingStation.ProtoReflect.Descriptor instead.
+func (*FillingStation) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FillingStation) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FillingStation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FillingStation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type FillingStationCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stations      []*FillingStation      `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillingStationCollection) Reset() {
+	*x = FillingStationCollection{}
+	mi := &file_server_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillingStationCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillingStationCollection) ProtoMessage() {}
+
+func (x *FillingStationCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillingStationCollection.ProtoReflect.Descriptor instead.
+func (*FillingStationCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FillingStationCollection) GetStations() []*FillingStation {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+type FuelType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 0 for a root type, types are nested one level deep
+	ParentId int32 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// filled only in FuelTypeCollection.tree
+	Children      []*FuelType `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelType) Reset() {
+	*x = FuelType{}
+	mi := &file_server_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelType) ProtoMessage() {}
+
+func (x *FuelType) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelType.ProtoReflect.Descriptor instead.
+func (*FuelType) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FuelType) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FuelType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FuelType) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *FuelType) GetChildren() []*FuelType {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type FuelTypeCollection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// flat list of all types
+	Types []*FuelType `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// root types with their children
+	Tree          []*FuelType `protobuf:"bytes,2,rep,name=tree,proto3" json:"tree,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelTypeCollection) Reset() {
+	*x = FuelTypeCollection{}
+	mi := &file_server_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelTypeCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelTypeCollection) ProtoMessage() {}
+
+func (x *FuelTypeCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelTypeCollection.ProtoReflect.Descriptor instead.
+func (*FuelTypeCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FuelTypeCollection) GetTypes() []*FuelType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *FuelTypeCollection) GetTree() []*FuelType {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+type FuelTypeMerge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type to remove, its fuels and settings are moved to the target
+	SourceId      int32 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      int32 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelTypeMerge) Reset() {
+	*x = FuelTypeMerge{}
+	mi := &file_server_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelTypeMerge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelTypeMerge) ProtoMessage() {}
+
+func (x *FuelTypeMerge) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelTypeMerge.ProtoReflect.Descriptor instead.
+func (*FuelTypeMerge) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FuelTypeMerge) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *FuelTypeMerge) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type Fuel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cost  *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// integer value, decimal(8, 2) in MySQL
+	Value     int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Station   *FillingStation        `protobuf:"bytes,4,opt,name=station,proto3" json:"station,omitempty"`
+	Date      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Distance  int32                  `protobuf:"varint,6,opt,name=distance,proto3" json:"distance,omitempty"`
+	Car       *Car                   `protobuf:"bytes,7,opt,name=car,proto3" json:"car,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type      *FuelType              `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
+	// row version, send it back on update to reject stale writes (0 skips the check)
+	Version int32 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	// cost in the default currency of the user, empty without an exchange rate
+	ConvertedCost *Cost `protobuf:"bytes,11,opt,name=converted_cost,json=convertedCost,proto3" json:"converted_cost,omitempty"`
+	// free-form note
+	Description string `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	// read-only, set with TagRepository.SetRecordTags
+	Tags          []*Tag `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fuel) Reset() {
+	*x = Fuel{}
+	mi := &file_server_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fuel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fuel) ProtoMessage() {}
+
+func (x *Fuel) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fuel.ProtoReflect.Descriptor instead.
+func (*Fuel) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Fuel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Fuel) GetCost() *Cost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *Fuel) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Fuel) GetStation() *FillingStation {
+	if x != nil {
+		return x.Station
+	}
+	return nil
+}
+
+func (x *Fuel) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Fuel) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Fuel) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *Fuel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Fuel) GetType() *FuelType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *Fuel) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Fuel) GetConvertedCost() *Cost {
+	if x != nil {
+		return x.ConvertedCost
+	}
+	return nil
+}
+
+func (x *Fuel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Fuel) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FuelCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fuels         []*Fuel                `protobuf:"bytes,1,rep,name=fuels,proto3" json:"fuels,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelCollection) Reset() {
+	*x = FuelCollection{}
+	mi := &file_server_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelCollection) ProtoMessage() {}
+
+func (x *FuelCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelCollection.ProtoReflect.Descriptor instead.
+func (*FuelCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FuelCollection) GetFuels() []*Fuel {
+	if x != nil {
+		return x.Fuels
+	}
+	return nil
+}
+
+func (x *FuelCollection) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type Currency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// currency code, for example RUB
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Default       bool                   `protobuf:"varint,4,opt,name=default,proto3" json:"default,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Currency) Reset() {
+	*x = Currency{}
+	mi := &file_server_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Currency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Currency) ProtoMessage() {}
+
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Currency) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Currency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Currency) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Currency) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+func (x *Currency) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DefaultCurrency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefaultCurrency) Reset() {
+	*x = DefaultCurrency{}
+	mi := &file_server_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefaultCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultCurrency) ProtoMessage() {}
+
+func (x *DefaultCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultCurrency.ProtoReflect.Descriptor instead.
+func (*DefaultCurrency) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DefaultCurrency) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+func (x *DefaultCurrency) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type CurrencyCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currencies    []*Currency            `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyCollection) Reset() {
+	*x = CurrencyCollection{}
+	mi := &file_server_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyCollection) ProtoMessage() {}
+
+func (x *CurrencyCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyCollection.ProtoReflect.Descriptor instead.
+func (*CurrencyCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CurrencyCollection) GetCurrencies() []*Currency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+type PaginationMeta struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Current int32                  `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	Last    int32                  `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
+	// cursor of the next page, empty for the last page
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaginationMeta) Reset() {
+	*x = PaginationMeta{}
+	mi := &file_server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaginationMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationMeta) ProtoMessage() {}
+
+func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationMeta.ProtoReflect.Descriptor instead.
+func (*PaginationMeta) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PaginationMeta) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *PaginationMeta) GetLast() int32 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+func (x *PaginationMeta) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *PaginationMeta) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// units of the statistics and the reports, the records are always
+// in km and litres
+type Units struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Distance      DistanceUnit           `protobuf:"varint,1,opt,name=distance,proto3,enum=xelbot.com.autonotes.server.DistanceUnit" json:"distance,omitempty"`
+	Volume        VolumeUnit             `protobuf:"varint,2,opt,name=volume,proto3,enum=xelbot.com.autonotes.server.VolumeUnit" json:"volume,omitempty"`
+	Consumption   ConsumptionUnit        `protobuf:"varint,3,opt,name=consumption,proto3,enum=xelbot.com.autonotes.server.ConsumptionUnit" json:"consumption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Units) Reset() {
+	*x = Units{}
+	mi := &file_server_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Units) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Units) ProtoMessage() {}
+
+func (x *Units) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Units.ProtoReflect.Descriptor instead.
+func (*Units) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Units) GetDistance() DistanceUnit {
+	if x != nil {
+		return x.Distance
+	}
+	return DistanceUnit_DISTANCE_KM
+}
+
+func (x *Units) GetVolume() VolumeUnit {
+	if x != nil {
+		return x.Volume
+	}
+	return VolumeUnit_VOLUME_LITRES
+}
+
+func (x *Units) GetConsumption() ConsumptionUnit {
+	if x != nil {
+		return x.Consumption
+	}
+	return ConsumptionUnit_CONSUMPTION_PER_100
+}
+
+type UserSettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DefaultCar      *Car                   `protobuf:"bytes,2,opt,name=default_car,json=defaultCar,proto3" json:"default_car,omitempty"`
+	DefaultCurrency *Currency              `protobuf:"bytes,3,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DefaultFuelType *FuelType              `protobuf:"bytes,6,opt,name=default_fuel_type,json=defaultFuelType,proto3" json:"default_fuel_type,omitempty"`
+	// the stored units and timezone are kept when not set on save
+	DistanceUnit *DistanceUnit `protobuf:"varint,7,opt,name=distance_unit,json=distanceUnit,proto3,enum=xelbot.com.autonotes.server.DistanceUnit,oneof" json:"distance_unit,omitempty"`
+	VolumeUnit   *VolumeUnit   `protobuf:"varint,8,opt,name=volume_unit,json=volumeUnit,proto3,enum=xelbot.com.autonotes.server.VolumeUnit,oneof" json:"volume_unit,omitempty"`
+	// IANA name, for example Asia/Vladivostok, empty for the server timezone;
+	// the dates of the records are taken and returned in it
+	Timezone      *string `protobuf:"bytes,9,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_server_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserSettings) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserSettings) GetDefaultCar() *Car {
+	if x != nil {
+		return x.DefaultCar
+	}
+	return nil
+}
+
+func (x *UserSettings) GetDefaultCurrency() *Currency {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return nil
+}
+
+func (x *UserSettings) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UserSettings) GetDefaultFuelType() *FuelType {
+	if x != nil {
+		return x.DefaultFuelType
+	}
+	return nil
+}
+
+func (x *UserSettings) GetDistanceUnit() DistanceUnit {
+	if x != nil && x.DistanceUnit != nil {
+		return *x.DistanceUnit
+	}
+	return DistanceUnit_DISTANCE_KM
+}
+
+func (x *UserSettings) GetVolumeUnit() VolumeUnit {
+	if x != nil && x.VolumeUnit != nil {
+		return *x.VolumeUnit
+	}
+	return VolumeUnit_VOLUME_LITRES
+}
+
+func (x *UserSettings) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+type ExchangeRate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// currency code, for example USD
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	// code of the currency the rate is given in, for example RUB
+	Base string                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Date *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// units of the base currency for one unit of the currency
+	Rate          float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_server_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExchangeRate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type ExchangeRateCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateCollection) Reset() {
+	*x = ExchangeRateCollection{}
+	mi := &file_server_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateCollection) ProtoMessage() {}
+
+func (x *ExchangeRateCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateCollection.ProtoReflect.Descriptor instead.
+func (*ExchangeRateCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExchangeRateCollection) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ExchangeRateImport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// daily rates XML of the Central Bank of Russia or the European Central Bank
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateImport) Reset() {
+	*x = ExchangeRateImport{}
+	mi := &file_server_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateImport) ProtoMessage() {}
+
+func (x *ExchangeRateImport) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateImport.ProtoReflect.Descriptor instead.
+func (*ExchangeRateImport) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExchangeRateImport) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExchangeRateImportResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Imported int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	// rates of currencies missing in the currencies table
+	Skipped       int32 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateImportResult) Reset() {
+	*x = ExchangeRateImportResult{}
+	mi := &file_server_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateImportResult) ProtoMessage() {}
+
+func (x *ExchangeRateImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateImportResult.ProtoReflect.Descriptor instead.
+func (*ExchangeRateImportResult) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExchangeRateImportResult) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ExchangeRateImportResult) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type FuelFilter struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Limit     int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page      int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	CarId     int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	TypeId    int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	StationId int32                  `protobuf:"varint,5,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+	// inclusive date range, either bound is optional
+	DateFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// cost range in minimal units (cents), 0 is no limit
+	MinCost int32 `protobuf:"varint,8,opt,name=min_cost,json=minCost,proto3" json:"min_cost,omitempty"`
+	MaxCost int32 `protobuf:"varint,9,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	// currency code, for example RUB
+	Currency string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	// date (default), cost, value or price (per litre)
+	SortBy        string        `protobuf:"bytes,11,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,12,opt,name=sort_direction,json=sortDirection,proto3,enum=xelbot.com.autonotes.server.SortDirection" json:"sort_direction,omitempty"`
+	// next_cursor of the previous page, replaces page (date sort only)
+	Cursor string `protobuf:"bytes,13,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// do not count the items, PaginationMeta.last is 0 then
+	SkipCount bool `protobuf:"varint,14,opt,name=skip_count,json=skipCount,proto3" json:"skip_count,omitempty"`
+	// substring of the description
+	Search        string `protobuf:"bytes,15,opt,name=search,proto3" json:"search,omitempty"`
+	TagId         int32  `protobuf:"varint,16,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelFilter) Reset() {
+	*x = FuelFilter{}
+	mi := &file_server_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelFilter) ProtoMessage() {}
+
+func (x *FuelFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelFilter.ProtoReflect.Descriptor instead.
+func (*FuelFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FuelFilter) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FuelFilter) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *FuelFilter) GetCarId() int32 {
+	if x != nil {
+		return x.CarId
+	}
+	return 0
+}
+
+func (x *FuelFilter) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *FuelFilter) GetStationId() int32 {
+	if x != nil {
+		return x.StationId
+	}
+	return 0
+}
+
+func (x *FuelFilter) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *FuelFilter) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *FuelFilter) GetMinCost() int32 {
+	if x != nil {
+		return x.MinCost
+	}
+	return 0
+}
+
+func (x *FuelFilter) GetMaxCost() int32 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+func (x *FuelFilter) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FuelFilter) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *FuelFilter) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DESC
+}
+
+func (x *FuelFilter) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *FuelFilter) GetSkipCount() bool {
+	if x != nil {
+		return x.SkipCount
+	}
+	return false
+}
+
+func (x *FuelFilter) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *FuelFilter) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type BatchError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// twirp error code, for example invalid_argument
+	Code          string            `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Meta          map[string]string `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchError) Reset() {
+	*x = BatchError{}
+	mi := &file_server_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchError) ProtoMessage() {}
+
+func (x *BatchError) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchError.ProtoReflect.Descriptor instead.
+func (*BatchError) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchError) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type IdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdRequest) Reset() {
+	*x = IdRequest{}
+	mi := &file_server_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdRequest) ProtoMessage() {}
+
+func (x *IdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdRequest.ProtoReflect.Descriptor instead.
+func (*IdRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FuelBatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// up to 100 items
+	Fuels         []*Fuel   `protobuf:"bytes,1,rep,name=fuels,proto3" json:"fuels,omitempty"`
+	Mode          BatchMode `protobuf:"varint,2,opt,name=mode,proto3,enum=xelbot.com.autonotes.server.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelBatch) Reset() {
+	*x = FuelBatch{}
+	mi := &file_server_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelBatch) ProtoMessage() {}
+
+func (x *FuelBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelBatch.ProtoReflect.Descriptor instead.
+func (*FuelBatch) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FuelBatch) GetFuels() []*Fuel {
+	if x != nil {
+		return x.Fuels
+	}
+	return nil
+}
+
+func (x *FuelBatch) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_ALL_OR_NOTHING
+}
+
+type FuelBatchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fuel          *Fuel                  `protobuf:"bytes,1,opt,name=fuel,proto3" json:"fuel,omitempty"`
+	Error         *BatchError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelBatchItem) Reset() {
+	*x = FuelBatchItem{}
+	mi := &file_server_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelBatchItem) ProtoMessage() {}
+
+func (x *FuelBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelBatchItem.ProtoReflect.Descriptor instead.
+func (*FuelBatchItem) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FuelBatchItem) GetFuel() *Fuel {
+	if x != nil {
+		return x.Fuel
+	}
+	return nil
+}
+
+func (x *FuelBatchItem) GetError() *BatchError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type FuelBatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in the order of FuelBatch.fuels
+	Items         []*FuelBatchItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelBatchResult) Reset() {
+	*x = FuelBatchResult{}
+	mi := &file_server_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelBatchResult) ProtoMessage() {}
+
+func (x *FuelBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelBatchResult.ProtoReflect.Descriptor instead.
+func (*FuelBatchResult) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FuelBatchResult) GetItems() []*FuelBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type FuelPriceFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// inclusive date range, either bound is optional
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	StationId     int32                  `protobuf:"varint,3,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+	TypeId        int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelPriceFilter) Reset() {
+	*x = FuelPriceFilter{}
+	mi := &file_server_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelPriceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelPriceFilter) ProtoMessage() {}
+
+func (x *FuelPriceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelPriceFilter.ProtoReflect.Descriptor instead.
+func (*FuelPriceFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FuelPriceFilter) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *FuelPriceFilter) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *FuelPriceFilter) GetStationId() int32 {
+	if x != nil {
+		return x.StationId
+	}
+	return 0
+}
+
+func (x *FuelPriceFilter) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+type FuelPricePoint struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	FuelId int32                  `protobuf:"varint,1,opt,name=fuel_id,json=fuelId,proto3" json:"fuel_id,omitempty"`
+	Date   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// price per litre or gallon by the units in minimal units (cents)
+	Price int32 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// relative deviation from the median price of the nearby fill-ups at the station
+	Deviation float64 `protobuf:"fixed64,4,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// the price is far off the median, probably a data-entry mistake
+	Suspicious    bool `protobuf:"varint,5,opt,name=suspicious,proto3" json:"suspicious,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelPricePoint) Reset() {
+	*x = FuelPricePoint{}
+	mi := &file_server_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelPricePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelPricePoint) ProtoMessage() {}
+
+func (x *FuelPricePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelPricePoint.ProtoReflect.Descriptor instead.
+func (*FuelPricePoint) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FuelPricePoint) GetFuelId() int32 {
+	if x != nil {
+		return x.FuelId
+	}
+	return 0
+}
+
+func (x *FuelPricePoint) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *FuelPricePoint) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *FuelPricePoint) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *FuelPricePoint) GetSuspicious() bool {
+	if x != nil {
+		return x.Suspicious
+	}
+	return false
+}
+
+type FuelPriceHistory struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Station *FillingStation        `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Type    *FuelType              `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// currency code, for example RUB
+	Currency      string            `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Points        []*FuelPricePoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	MinPrice      int32             `protobuf:"varint,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	AvgPrice      int32             `protobuf:"varint,6,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	MaxPrice      int32             `protobuf:"varint,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelPriceHistory) Reset() {
+	*x = FuelPriceHistory{}
+	mi := &file_server_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelPriceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelPriceHistory) ProtoMessage() {}
+
+func (x *FuelPriceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelPriceHistory.ProtoReflect.Descriptor instead.
+func (*FuelPriceHistory) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FuelPriceHistory) GetStation() *FillingStation {
+	if x != nil {
+		return x.Station
+	}
+	return nil
+}
+
+func (x *FuelPriceHistory) GetType() *FuelType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *FuelPriceHistory) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FuelPriceHistory) GetPoints() []*FuelPricePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *FuelPriceHistory) GetMinPrice() int32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *FuelPriceHistory) GetAvgPrice() int32 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *FuelPriceHistory) GetMaxPrice() int32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+type FuelPriceCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prices        []*FuelPriceHistory    `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	Units         *Units                 `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelPriceCollection) Reset() {
+	*x = FuelPriceCollection{}
+	mi := &file_server_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelPriceCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelPriceCollection) ProtoMessage() {}
+
+func (x *FuelPriceCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelPriceCollection.ProtoReflect.Descriptor instead.
+func (*FuelPriceCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FuelPriceCollection) GetPrices() []*FuelPriceHistory {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *FuelPriceCollection) GetUnits() *Units {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+type OrderType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderType) Reset() {
+	*x = OrderType{}
+	mi := &file_server_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderType) ProtoMessage() {}
+
+func (x *OrderType) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderType.ProtoReflect.Descriptor instead.
+func (*OrderType) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *OrderType) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type OrderTypeCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []*OrderType           `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTypeCollection) Reset() {
+	*x = OrderTypeCollection{}
+	mi := &file_server_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTypeCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTypeCollection) ProtoMessage() {}
+
+func (x *OrderTypeCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTypeCollection.ProtoReflect.Descriptor instead.
+func (*OrderTypeCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OrderTypeCollection) GetTypes() []*OrderType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type Order struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cost        *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Capacity    string                 `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	UsedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
+	Distance    int32                  `protobuf:"varint,7,opt,name=distance,proto3" json:"distance,omitempty"`
+	Car         *Car                   `protobuf:"bytes,8,opt,name=car,proto3" json:"car,omitempty"`
+	Type        *OrderType             `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// row version, send it back on update to reject stale writes (0 skips the check)
+	Version int32 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// cost in the default currency of the user, empty without an exchange rate
+	ConvertedCost *Cost `protobuf:"bytes,12,opt,name=converted_cost,json=convertedCost,proto3" json:"converted_cost,omitempty"`
+	// read-only, set with TagRepository.SetRecordTags
+	Tags          []*Tag `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_server_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Order) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetCost() *Cost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *Order) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Order) GetCapacity() string {
+	if x != nil {
+		return x.Capacity
+	}
+	return ""
+}
+
+func (x *Order) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Order) GetUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UsedAt
+	}
+	return nil
+}
+
+func (x *Order) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Order) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *Order) GetType() *OrderType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Order) GetConvertedCost() *Cost {
+	if x != nil {
+		return x.ConvertedCost
+	}
+	return nil
+}
+
+func (x *Order) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type OrderCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCollection) Reset() {
+	*x = OrderCollection{}
+	mi := &file_server_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCollection) ProtoMessage() {}
+
+func (x *OrderCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCollection.ProtoReflect.Descriptor instead.
+func (*OrderCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *OrderCollection) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *OrderCollection) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ExpenseCategory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 0 for a root category, categories are nested one level deep
+	ParentId int32 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// enum value for the old clients, OTHER for the user categories
+	// outside the built-in ones
+	Type ExpenseType `protobuf:"varint,4,opt,name=type,proto3,enum=xelbot.com.autonotes.server.ExpenseType" json:"type,omitempty"`
+	// built-in categories are shared and read-only
+	System        bool                   `protobuf:"varint,5,opt,name=system,proto3" json:"system,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseCategory) Reset() {
+	*x = ExpenseCategory{}
+	mi := &file_server_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseCategory) ProtoMessage() {}
+
+func (x *ExpenseCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseCategory.ProtoReflect.Descriptor instead.
+func (*ExpenseCategory) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExpenseCategory) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExpenseCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExpenseCategory) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ExpenseCategory) GetType() ExpenseType {
+	if x != nil {
+		return x.Type
+	}
+	return ExpenseType_EMPTY
+}
+
+func (x *ExpenseCategory) GetSystem() bool {
+	if x != nil {
+		return x.System
+	}
+	return false
+}
+
+func (x *ExpenseCategory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ExpenseCategoryCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*ExpenseCategory     `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseCategoryCollection) Reset() {
+	*x = ExpenseCategoryCollection{}
+	mi := &file_server_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseCategoryCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseCategoryCollection) ProtoMessage() {}
+
+func (x *ExpenseCategoryCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseCategoryCollection.ProtoReflect.Descriptor instead.
+func (*ExpenseCategoryCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExpenseCategoryCollection) GetCategories() []*ExpenseCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type Expense struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cost        *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Car         *Car                   `protobuf:"bytes,5,opt,name=car,proto3" json:"car,omitempty"`
+	// deprecated, use category; still accepted and filled in responses
+	Type      ExpenseType            `protobuf:"varint,6,opt,name=type,proto3,enum=xelbot.com.autonotes.server.ExpenseType" json:"type,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// row version, send it back on update to reject stale writes (0 skips the check)
+	Version int32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// cost in the default currency of the user, empty without an exchange rate
+	ConvertedCost *Cost `protobuf:"bytes,9,opt,name=converted_cost,json=convertedCost,proto3" json:"converted_cost,omitempty"`
+	// only id is read on save, it takes precedence over type
+	Category *ExpenseCategory `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	// template the expense was created from (read-only)
+	RecurringId int32 `protobuf:"varint,11,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	// read-only, set with TagRepository.SetRecordTags
+	Tags          []*Tag `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	mi := &file_server_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Expense) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Expense) GetCost() *Cost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *Expense) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Expense) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Expense) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *Expense) GetType() ExpenseType {
+	if x != nil {
+		return x.Type
+	}
+	return ExpenseType_EMPTY
+}
+
+func (x *Expense) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Expense) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Expense) GetConvertedCost() *Cost {
+	if x != nil {
+		return x.ConvertedCost
+	}
+	return nil
+}
+
+func (x *Expense) GetCategory() *ExpenseCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Expense) GetRecurringId() int32 {
+	if x != nil {
+		return x.RecurringId
+	}
+	return 0
+}
+
+func (x *Expense) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RecurringExpense struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cost        *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Car         *Car                   `protobuf:"bytes,4,opt,name=car,proto3" json:"car,omitempty"`
+	// only id is read on save
+	Category  *ExpenseCategory    `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Frequency RecurrenceFrequency `protobuf:"varint,6,opt,name=frequency,proto3,enum=xelbot.com.autonotes.server.RecurrenceFrequency" json:"frequency,omitempty"`
+	// every `interval` months or years, 1 by default
+	Interval  int32                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// inclusive, empty for an endless schedule
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// date of the next expense, empty after the end date (read-only)
+	NextDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_date,json=nextDate,proto3" json:"next_date,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringExpense) Reset() {
+	*x = RecurringExpense{}
+	mi := &file_server_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringExpense) ProtoMessage() {}
+
+func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringExpense.ProtoReflect.Descriptor instead.
+func (*RecurringExpense) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RecurringExpense) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecurringExpense) GetCost() *Cost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetCategory() *ExpenseCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetFrequency() RecurrenceFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return RecurrenceFrequency_RECURRENCE_MONTHLY
+}
+
+func (x *RecurringExpense) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *RecurringExpense) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetNextDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDate
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RecurringExpenseCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RecurringExpense    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringExpenseCollection) Reset() {
+	*x = RecurringExpenseCollection{}
+	mi := &file_server_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringExpenseCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringExpenseCollection) ProtoMessage() {}
+
+func (x *RecurringExpenseCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringExpenseCollection.ProtoReflect.Descriptor instead.
+func (*RecurringExpenseCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RecurringExpenseCollection) GetItems() []*RecurringExpense {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpcomingExpenseFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// days ahead, 30 by default, up to 366
+	Days          int32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpcomingExpenseFilter) Reset() {
+	*x = UpcomingExpenseFilter{}
+	mi := &file_server_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpcomingExpenseFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingExpenseFilter) ProtoMessage() {}
+
+func (x *UpcomingExpenseFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingExpenseFilter.ProtoReflect.Descriptor instead.
+func (*UpcomingExpenseFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpcomingExpenseFilter) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type UpcomingExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     *RecurringExpense      `protobuf:"bytes,1,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpcomingExpense) Reset() {
+	*x = UpcomingExpense{}
+	mi := &file_server_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpcomingExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingExpense) ProtoMessage() {}
+
+func (x *UpcomingExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingExpense.ProtoReflect.Descriptor instead.
+func (*UpcomingExpense) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpcomingExpense) GetRecurring() *RecurringExpense {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+func (x *UpcomingExpense) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type UpcomingExpenseCollection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sorted by date
+	Items         []*UpcomingExpense `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpcomingExpenseCollection) Reset() {
+	*x = UpcomingExpenseCollection{}
+	mi := &file_server_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpcomingExpenseCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingExpenseCollection) ProtoMessage() {}
+
+func (x *UpcomingExpenseCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingExpenseCollection.ProtoReflect.Descriptor instead.
+func (*UpcomingExpenseCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpcomingExpenseCollection) GetItems() []*UpcomingExpense {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ExpenseCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseCollection) Reset() {
+	*x = ExpenseCollection{}
+	mi := &file_server_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseCollection) ProtoMessage() {}
+
+func (x *ExpenseCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseCollection.ProtoReflect.Descriptor instead.
+func (*ExpenseCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExpenseCollection) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *ExpenseCollection) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type OrderFilter struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page   int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	CarId  int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	TypeId int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	// inclusive date range, either bound is optional
+	DateFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// cost range in minimal units (cents), 0 is no limit
+	MinCost int32 `protobuf:"varint,7,opt,name=min_cost,json=minCost,proto3" json:"min_cost,omitempty"`
+	MaxCost int32 `protobuf:"varint,8,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	// currency code, for example RUB
+	Currency string `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	// substring of the description
+	Search string `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`
+	// date (default) or cost
+	SortBy        string        `protobuf:"bytes,11,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,12,opt,name=sort_direction,json=sortDirection,proto3,enum=xelbot.com.autonotes.server.SortDirection" json:"sort_direction,omitempty"`
+	// next_cursor of the previous page, replaces page (date sort only)
+	Cursor string `protobuf:"bytes,13,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// do not count the items, PaginationMeta.last is 0 then
+	SkipCount     bool  `protobuf:"varint,14,opt,name=skip_count,json=skipCount,proto3" json:"skip_count,omitempty"`
+	TagId         int32 `protobuf:"varint,15,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderFilter) Reset() {
+	*x = OrderFilter{}
+	mi := &file_server_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderFilter) ProtoMessage() {}
+
+func (x *OrderFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
+func (*OrderFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *OrderFilter) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetCarId() int32 {
+	if x != nil {
+		return x.CarId
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetMinCost() int32 {
+	if x != nil {
+		return x.MinCost
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetMaxCost() int32 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DESC
+}
+
+func (x *OrderFilter) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetSkipCount() bool {
+	if x != nil {
+		return x.SkipCount
+	}
+	return false
+}
+
+func (x *OrderFilter) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type ExpenseFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page  int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	CarId int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	// deprecated, use category_id
+	Type ExpenseType `protobuf:"varint,4,opt,name=type,proto3,enum=xelbot.com.autonotes.server.ExpenseType" json:"type,omitempty"`
+	// inclusive date range, either bound is optional
+	DateFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// cost range in minimal units (cents), 0 is no limit
+	MinCost int32 `protobuf:"varint,7,opt,name=min_cost,json=minCost,proto3" json:"min_cost,omitempty"`
+	MaxCost int32 `protobuf:"varint,8,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	// currency code, for example RUB
+	Currency string `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	// substring of the description
+	Search string `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`
+	// date (default) or cost
+	SortBy        string        `protobuf:"bytes,11,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,12,opt,name=sort_direction,json=sortDirection,proto3,enum=xelbot.com.autonotes.server.SortDirection" json:"sort_direction,omitempty"`
+	// next_cursor of the previous page, replaces page (date sort only)
+	Cursor string `protobuf:"bytes,13,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// do not count the items, PaginationMeta.last is 0 then
+	SkipCount bool `protobuf:"varint,14,opt,name=skip_count,json=skipCount,proto3" json:"skip_count,omitempty"`
+	// category with its children
+	CategoryId    int32 `protobuf:"varint,15,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TagId         int32 `protobuf:"varint,16,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseFilter) Reset() {
+	*x = ExpenseFilter{}
+	mi := &file_server_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseFilter) ProtoMessage() {}
+
+func (x *ExpenseFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseFilter.ProtoReflect.Descriptor instead.
+func (*ExpenseFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExpenseFilter) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ExpenseFilter) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ExpenseFilter) GetCarId() int32 {
+	if x != nil {
+		return x.CarId
+	}
+	return 0
+}
+
+func (x *ExpenseFilter) GetType() ExpenseType {
+	if x != nil {
+		return x.Type
+	}
+	return ExpenseType_EMPTY
+}
+
+func (x *ExpenseFilter) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ExpenseFilter) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ExpenseFilter) GetMinCost() int32 {
+	if x != nil {
+		return x.MinCost
+	}
+	return 0
+}
+
+func (x *ExpenseFilter) GetMaxCost() int32 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+func (x *ExpenseFilter) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExpenseFilter) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExpenseFilter) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ExpenseFilter) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DESC
+}
+
+func (x *ExpenseFilter) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ExpenseFilter) GetSkipCount() bool {
+	if x != nil {
+		return x.SkipCount
+	}
+	return false
+}
+
+func (x *ExpenseFilter) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ExpenseFilter) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type OrderInventoryFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CarId         int32                  `protobuf:"varint,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	TypeId        int32                  `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderInventoryFilter) Reset() {
+	*x = OrderInventoryFilter{}
+	mi := &file_server_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderInventoryFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderInventoryFilter) ProtoMessage() {}
+
+func (x *OrderInventoryFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderInventoryFilter.ProtoReflect.Descriptor instead.
+func (*OrderInventoryFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *OrderInventoryFilter) GetCarId() int32 {
+	if x != nil {
+		return x.CarId
+	}
+	return 0
+}
+
+func (x *OrderInventoryFilter) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+type OrderInventoryGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for orders without a car
+	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	// empty for orders without a type
+	Type *OrderType `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// purchased but not installed, oldest first
+	Orders        []*Order `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderInventoryGroup) Reset() {
+	*x = OrderInventoryGroup{}
+	mi := &file_server_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderInventoryGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderInventoryGroup) ProtoMessage() {}
+
+func (x *OrderInventoryGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderInventoryGroup.ProtoReflect.Descriptor instead.
+func (*OrderInventoryGroup) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *OrderInventoryGroup) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *OrderInventoryGroup) GetType() *OrderType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *OrderInventoryGroup) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type OrderServiceLife struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Car      *Car                   `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	Type     *OrderType             `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Installs int32                  `protobuf:"varint,3,opt,name=installs,proto3" json:"installs,omitempty"`
+	// km and days between the last two installs, 0 if unknown
+	LastDistance int32 `protobuf:"varint,4,opt,name=last_distance,json=lastDistance,proto3" json:"last_distance,omitempty"`
+	LastDays     int32 `protobuf:"varint,5,opt,name=last_days,json=lastDays,proto3" json:"last_days,omitempty"`
+	// averages between consecutive installs, 0 if unknown
+	AvgDistance   int32 `protobuf:"varint,6,opt,name=avg_distance,json=avgDistance,proto3" json:"avg_distance,omitempty"`
+	AvgDays       int32 `protobuf:"varint,7,opt,name=avg_days,json=avgDays,proto3" json:"avg_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderServiceLife) Reset() {
+	*x = OrderServiceLife{}
+	mi := &file_server_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderServiceLife) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderServiceLife) ProtoMessage() {}
+
+func (x *OrderServiceLife) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderServiceLife.ProtoReflect.Descriptor instead.
+func (*OrderServiceLife) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *OrderServiceLife) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *OrderServiceLife) GetType() *OrderType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *OrderServiceLife) GetInstalls() int32 {
+	if x != nil {
+		return x.Installs
+	}
+	return 0
+}
+
+func (x *OrderServiceLife) GetLastDistance() int32 {
+	if x != nil {
+		return x.LastDistance
+	}
+	return 0
+}
+
+func (x *OrderServiceLife) GetLastDays() int32 {
+	if x != nil {
+		return x.LastDays
+	}
+	return 0
+}
+
+func (x *OrderServiceLife) GetAvgDistance() int32 {
+	if x != nil {
+		return x.AvgDistance
+	}
+	return 0
+}
+
+func (x *OrderServiceLife) GetAvgDays() int32 {
+	if x != nil {
+		return x.AvgDays
+	}
+	return 0
+}
+
+type OrderInventory struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Unused []*OrderInventoryGroup `protobuf:"bytes,1,rep,name=unused,proto3" json:"unused,omitempty"`
+	// per car and order type
+	ServiceLife   []*OrderServiceLife `protobuf:"bytes,2,rep,name=service_life,json=serviceLife,proto3" json:"service_life,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderInventory) Reset() {
+	*x = OrderInventory{}
+	mi := &file_server_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderInventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderInventory) ProtoMessage() {}
+
+func (x *OrderInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderInventory.ProtoReflect.Descriptor instead.
+func (*OrderInventory) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *OrderInventory) GetUnused() []*OrderInventoryGroup {
+	if x != nil {
+		return x.Unused
+	}
+	return nil
+}
+
+func (x *OrderInventory) GetServiceLife() []*OrderServiceLife {
+	if x != nil {
+		return x.ServiceLife
+	}
+	return nil
+}
+
+type OrderUsage struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UsedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
+	// odometer at install, requires the order car
+	Distance int32 `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	// row version, 0 skips the check
+	Version       int32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderUsage) Reset() {
+	*x = OrderUsage{}
+	mi := &file_server_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderUsage) ProtoMessage() {}
+
+func (x *OrderUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderUsage.ProtoReflect.Descriptor instead.
+func (*OrderUsage) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *OrderUsage) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderUsage) GetUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UsedAt
+	}
+	return nil
+}
+
+func (x *OrderUsage) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *OrderUsage) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type OrderBatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// up to 100 items
+	Orders        []*Order  `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Mode          BatchMode `protobuf:"varint,2,opt,name=mode,proto3,enum=xelbot.com.autonotes.server.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBatch) Reset() {
+	*x = OrderBatch{}
+	mi := &file_server_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBatch) ProtoMessage() {}
+
+func (x *OrderBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBatch.ProtoReflect.Descriptor instead.
+func (*OrderBatch) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *OrderBatch) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *OrderBatch) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_ALL_OR_NOTHING
+}
+
+type OrderBatchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Error         *BatchError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBatchItem) Reset() {
+	*x = OrderBatchItem{}
+	mi := &file_server_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBatchItem) ProtoMessage() {}
+
+func (x *OrderBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBatchItem.ProtoReflect.Descriptor instead.
+func (*OrderBatchItem) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OrderBatchItem) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderBatchItem) GetError() *BatchError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type OrderBatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in the order of OrderBatch.orders
+	Items         []*OrderBatchItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBatchResult) Reset() {
+	*x = OrderBatchResult{}
+	mi := &file_server_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBatchResult) ProtoMessage() {}
+
+func (x *OrderBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBatchResult.ProtoReflect.Descriptor instead.
+func (*OrderBatchResult) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OrderBatchResult) GetItems() []*OrderBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ExpenseBatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// up to 100 items
+	Expenses      []*Expense `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	Mode          BatchMode  `protobuf:"varint,2,opt,name=mode,proto3,enum=xelbot.com.autonotes.server.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBatch) Reset() {
+	*x = ExpenseBatch{}
+	mi := &file_server_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBatch) ProtoMessage() {}
+
+func (x *ExpenseBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBatch.ProtoReflect.Descriptor instead.
+func (*ExpenseBatch) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExpenseBatch) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *ExpenseBatch) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_ALL_OR_NOTHING
+}
+
+type ExpenseBatchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	Error         *BatchError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBatchItem) Reset() {
+	*x = ExpenseBatchItem{}
+	mi := &file_server_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBatchItem) ProtoMessage() {}
+
+func (x *ExpenseBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBatchItem.ProtoReflect.Descriptor instead.
+func (*ExpenseBatchItem) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExpenseBatchItem) GetExpense() *Expense {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *ExpenseBatchItem) GetError() *BatchError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ExpenseBatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in the order of ExpenseBatch.expenses
+	Items         []*ExpenseBatchItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBatchResult) Reset() {
+	*x = ExpenseBatchResult{}
+	mi := &file_server_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBatchResult) ProtoMessage() {}
+
+func (x *ExpenseBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBatchResult.ProtoReflect.Descriptor instead.
+func (*ExpenseBatchResult) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExpenseBatchResult) GetItems() []*ExpenseBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MileageFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page  int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	CarId int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	// inclusive date range, either bound is optional
+	DateFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// date (default) or distance
+	SortBy        string        `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,7,opt,name=sort_direction,json=sortDirection,proto3,enum=xelbot.com.autonotes.server.SortDirection" json:"sort_direction,omitempty"`
+	// next_cursor of the previous page, replaces page (date sort only)
+	Cursor string `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// do not count the items, PaginationMeta.last is 0 then
+	SkipCount     bool  `protobuf:"varint,9,opt,name=skip_count,json=skipCount,proto3" json:"skip_count,omitempty"`
+	TagId         int32 `protobuf:"varint,10,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MileageFilter) Reset() {
+	*x = MileageFilter{}
+	mi := &file_server_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MileageFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MileageFilter) ProtoMessage() {}
+
+func (x *MileageFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MileageFilter.ProtoReflect.Descriptor instead.
+func (*MileageFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MileageFilter) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *MileageFilter) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *MileageFilter) GetCarId() int32 {
+	if x != nil {
+		return x.CarId
+	}
+	return 0
+}
+
+func (x *MileageFilter) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *MileageFilter) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *MileageFilter) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *MileageFilter) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DESC
+}
+
+func (x *MileageFilter) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *MileageFilter) GetSkipCount() bool {
+	if x != nil {
+		return x.SkipCount
+	}
+	return false
+}
+
+func (x *MileageFilter) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type Mileage struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Distance  int32                  `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Date      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Car       *Car                   `protobuf:"bytes,4,opt,name=car,proto3" json:"car,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// read-only, set with TagRepository.SetRecordTags
+	Tags          []*Tag `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mileage) Reset() {
+	*x = Mileage{}
+	mi := &file_server_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mileage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mileage) ProtoMessage() {}
+
+func (x *Mileage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mileage.ProtoReflect.Descriptor instead.
+func (*Mileage) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Mileage) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Mileage) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Mileage) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Mileage) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *Mileage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Mileage) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MileageCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mileages      []*Mileage             `protobuf:"bytes,1,rep,name=mileages,proto3" json:"mileages,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MileageCollection) Reset() {
+	*x = MileageCollection{}
+	mi := &file_server_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MileageCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MileageCollection) ProtoMessage() {}
+
+func (x *MileageCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MileageCollection.ProtoReflect.Descriptor instead.
+func (*MileageCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MileageCollection) GetMileages() []*Mileage {
+	if x != nil {
+		return x.Mileages
+	}
+	return nil
+}
+
+func (x *MileageCollection) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type Service struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cost        *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Car         *Car                   `protobuf:"bytes,5,opt,name=car,proto3" json:"car,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Distance    int32                  `protobuf:"varint,7,opt,name=distance,proto3" json:"distance,omitempty"`
+	// cost in the default currency of the user, empty without an exchange rate
+	ConvertedCost *Cost `protobuf:"bytes,8,opt,name=converted_cost,json=convertedCost,proto3" json:"converted_cost,omitempty"`
+	// read-only, set with TagRepository.SetRecordTags
+	Tags          []*Tag `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	mi := &file_server_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Service) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Service) GetCost() *Cost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *Service) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Service) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Service) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *Service) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Service) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Service) GetConvertedCost() *Cost {
+	if x != nil {
+		return x.ConvertedCost
+	}
+	return nil
+}
+
+func (x *Service) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ServiceCollection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceCollection) Reset() {
+	*x = ServiceCollection{}
+	mi := &file_server_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceCollection) ProtoMessage() {}
+
+func (x *ServiceCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceCollection.ProtoReflect.Descriptor instead.
+func (*ServiceCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ServiceCollection) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ServiceCollection) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ServiceFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page  int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	CarId int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	// inclusive date range, either bound is optional
+	DateFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// cost range in minimal units (cents), 0 is no limit
+	MinCost int32 `protobuf:"varint,6,opt,name=min_cost,json=minCost,proto3" json:"min_cost,omitempty"`
+	MaxCost int32 `protobuf:"varint,7,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	// currency code, for example RUB
+	Currency string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	// substring of the description
+	Search string `protobuf:"bytes,9,opt,name=search,proto3" json:"search,omitempty"`
+	// date (default), cost or distance
+	SortBy        string        `protobuf:"bytes,10,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sort_direction,json=sortDirection,proto3,enum=xelbot.com.autonotes.server.SortDirection" json:"sort_direction,omitempty"`
+	// next_cursor of the previous page, replaces page (date sort only)
+	Cursor string `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// do not count the items, PaginationMeta.last is 0 then
+	SkipCount     bool  `protobuf:"varint,13,opt,name=skip_count,json=skipCount,proto3" json:"skip_count,omitempty"`
+	TagId         int32 `protobuf:"varint,14,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceFilter) Reset() {
+	*x = ServiceFilter{}
+	mi := &file_server_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceFilter) ProtoMessage() {}
+
+func (x *ServiceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceFilter.ProtoReflect.Descriptor instead.
+func (*ServiceFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ServiceFilter) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ServiceFilter) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ServiceFilter) GetCarId() int32 {
+	if x != nil {
+		return x.CarId
+	}
+	return 0
+}
+
+func (x *ServiceFilter) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ServiceFilter) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ServiceFilter) GetMinCost() int32 {
+	if x != nil {
+		return x.MinCost
+	}
+	return 0
+}
+
+func (x *ServiceFilter) GetMaxCost() int32 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+func (x *ServiceFilter) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ServiceFilter) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
 }
 
-func (x *CarCollection) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ServiceFilter) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
 }
 
-func (*CarCollection) ProtoMessage() {}
+func (x *ServiceFilter) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DESC
+}
 
-func (x *CarCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[2]
+func (x *ServiceFilter) GetCursor() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Cursor
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CarCollection.ProtoReflect.Descriptor instead.
-func (*CarCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{2}
+func (x *ServiceFilter) GetSkipCount() bool {
+	if x != nil {
+		return x.SkipCount
+	}
+	return false
 }
 
-func (x *CarCollection) GetCars() []*Car {
+func (x *ServiceFilter) GetTagId() int32 {
 	if x != nil {
-		return x.Cars
+		return x.TagId
 	}
-	return nil
+	return 0
 }
 
-type FillingStation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+type Trip struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Car   *Car                   `protobuf:"bytes,3,opt,name=car,proto3" json:"car,omitempty"`
+	Start *Mileage               `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	// empty while the trip is open
+	End           *Mileage               `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FillingStation) Reset() {
-	*x = FillingStation{}
-	mi := &file_server_proto_msgTypes[3]
+func (x *Trip) Reset() {
+	*x = Trip{}
+	mi := &file_server_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FillingStation) String() string {
+func (x *Trip) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FillingStation) ProtoMessage() {}
+func (*Trip) ProtoMessage() {}
 
-func (x *FillingStation) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[3]
+func (x *Trip) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,99 +5165,75 @@ func (x *FillingStation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FillingStation.ProtoReflect.Descriptor instead.
-func (*FillingStation) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Trip.ProtoReflect.Descriptor instead.
+func (*Trip) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *FillingStation) GetId() int32 {
+func (x *Trip) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *FillingStation) GetName() string {
+func (x *Trip) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *FillingStation) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Trip) GetCar() *Car {
 	if x != nil {
-		return x.CreatedAt
+		return x.Car
 	}
 	return nil
 }
 
-type FillingStationCollection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stations      []*FillingStation      `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *FillingStationCollection) Reset() {
-	*x = FillingStationCollection{}
-	mi := &file_server_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *FillingStationCollection) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FillingStationCollection) ProtoMessage() {}
-
-func (x *FillingStationCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[4]
+func (x *Trip) GetStart() *Mileage {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Start
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use FillingStationCollection.ProtoReflect.Descriptor instead.
-func (*FillingStationCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{4}
+func (x *Trip) GetEnd() *Mileage {
+	if x != nil {
+		return x.End
+	}
+	return nil
 }
 
-func (x *FillingStationCollection) GetStations() []*FillingStation {
+func (x *Trip) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Stations
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type FuelType struct {
+type TripCollection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Trips         []*Trip                `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuelType) Reset() {
-	*x = FuelType{}
-	mi := &file_server_proto_msgTypes[5]
+func (x *TripCollection) Reset() {
+	*x = TripCollection{}
+	mi := &file_server_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuelType) String() string {
+func (x *TripCollection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuelType) ProtoMessage() {}
+func (*TripCollection) ProtoMessage() {}
 
-func (x *FuelType) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[5]
+func (x *TripCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,47 +5244,42 @@ func (x *FuelType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuelType.ProtoReflect.Descriptor instead.
-func (*FuelType) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *FuelType) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use TripCollection.ProtoReflect.Descriptor instead.
+func (*TripCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *FuelType) GetName() string {
+func (x *TripCollection) GetTrips() []*Trip {
 	if x != nil {
-		return x.Name
+		return x.Trips
 	}
-	return ""
+	return nil
 }
 
-type FuelTypeCollection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Types         []*FuelType            `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+type TripFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	CarId int32                  `protobuf:"varint,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	// open trips only
+	Open          bool `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuelTypeCollection) Reset() {
-	*x = FuelTypeCollection{}
-	mi := &file_server_proto_msgTypes[6]
+func (x *TripFilter) Reset() {
+	*x = TripFilter{}
+	mi := &file_server_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuelTypeCollection) String() string {
+func (x *TripFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuelTypeCollection) ProtoMessage() {}
+func (*TripFilter) ProtoMessage() {}
 
-func (x *FuelTypeCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[6]
+func (x *TripFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,49 +5290,51 @@ func (x *FuelTypeCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuelTypeCollection.ProtoReflect.Descriptor instead.
-func (*FuelTypeCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TripFilter.ProtoReflect.Descriptor instead.
+func (*TripFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *FuelTypeCollection) GetTypes() []*FuelType {
+func (x *TripFilter) GetCarId() int32 {
 	if x != nil {
-		return x.Types
+		return x.CarId
 	}
-	return nil
+	return 0
 }
 
-type Fuel struct {
+func (x *TripFilter) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+type TripStart struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Cost  *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	// integer value, decimal(8, 2) in MySQL
-	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
-	Station       *FillingStation        `protobuf:"bytes,4,opt,name=station,proto3" json:"station,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
-	Distance      int32                  `protobuf:"varint,6,opt,name=distance,proto3" json:"distance,omitempty"`
-	Car           *Car                   `protobuf:"bytes,7,opt,name=car,proto3" json:"car,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Type          *FuelType              `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
+	CarId int32                  `protobuf:"varint,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// odometer reading at the start
+	Distance      int32                  `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Fuel) Reset() {
-	*x = Fuel{}
-	mi := &file_server_proto_msgTypes[7]
+func (x *TripStart) Reset() {
+	*x = TripStart{}
+	mi := &file_server_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Fuel) String() string {
+func (x *TripStart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Fuel) ProtoMessage() {}
+func (*TripStart) ProtoMessage() {}
 
-func (x *Fuel) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[7]
+func (x *TripStart) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,97 +5345,64 @@ func (x *Fuel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Fuel.ProtoReflect.Descriptor instead.
-func (*Fuel) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Fuel) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Fuel) GetCost() *Cost {
-	if x != nil {
-		return x.Cost
-	}
-	return nil
+// Deprecated: Use TripStart.ProtoReflect.Descriptor instead.
+func (*TripStart) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Fuel) GetValue() int32 {
+func (x *TripStart) GetCarId() int32 {
 	if x != nil {
-		return x.Value
+		return x.CarId
 	}
 	return 0
 }
 
-func (x *Fuel) GetStation() *FillingStation {
-	if x != nil {
-		return x.Station
-	}
-	return nil
-}
-
-func (x *Fuel) GetDate() *timestamppb.Timestamp {
+func (x *TripStart) GetName() string {
 	if x != nil {
-		return x.Date
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Fuel) GetDistance() int32 {
+func (x *TripStart) GetDistance() int32 {
 	if x != nil {
 		return x.Distance
 	}
 	return 0
 }
 
-func (x *Fuel) GetCar() *Car {
-	if x != nil {
-		return x.Car
-	}
-	return nil
-}
-
-func (x *Fuel) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Fuel) GetType() *FuelType {
+func (x *TripStart) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Type
+		return x.Date
 	}
 	return nil
 }
 
-type FuelCollection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fuels         []*Fuel                `protobuf:"bytes,1,rep,name=fuels,proto3" json:"fuels,omitempty"`
-	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+type TripClose struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// odometer reading at the end
+	Distance      int32                  `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuelCollection) Reset() {
-	*x = FuelCollection{}
-	mi := &file_server_proto_msgTypes[8]
+func (x *TripClose) Reset() {
+	*x = TripClose{}
+	mi := &file_server_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuelCollection) String() string {
+func (x *TripClose) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuelCollection) ProtoMessage() {}
+func (*TripClose) ProtoMessage() {}
 
-func (x *FuelCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[8]
+func (x *TripClose) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,52 +5413,80 @@ func (x *FuelCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuelCollection.ProtoReflect.Descriptor instead.
-func (*FuelCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use TripClose.ProtoReflect.Descriptor instead.
+func (*TripClose) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *FuelCollection) GetFuels() []*Fuel {
+func (x *TripClose) GetId() int32 {
 	if x != nil {
-		return x.Fuels
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *FuelCollection) GetMeta() *PaginationMeta {
+func (x *TripClose) GetDistance() int32 {
 	if x != nil {
-		return x.Meta
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *TripClose) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
 	}
 	return nil
 }
 
-type Currency struct {
+type TripReport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// currency code, for example RUB
-	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Default       bool                   `protobuf:"varint,4,opt,name=default,proto3" json:"default,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Trip  *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	// in the distance units, up to the last mileage of the car for an open trip
+	Distance  int32 `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	FuelCount int32 `protobuf:"varint,3,opt,name=fuel_count,json=fuelCount,proto3" json:"fuel_count,omitempty"`
+	// in the volume units, integer value as in Fuel
+	FuelValue int32 `protobuf:"varint,4,opt,name=fuel_value,json=fuelValue,proto3" json:"fuel_value,omitempty"`
+	// in the consumption units multiplied by 100, 0 without a distance
+	Consumption int32 `protobuf:"varint,5,opt,name=consumption,proto3" json:"consumption,omitempty"`
+	// the costs are per currency
+	FuelCosts []*Cost `protobuf:"bytes,6,rep,name=fuel_costs,json=fuelCosts,proto3" json:"fuel_costs,omitempty"`
+	// ROAD expenses
+	TollCosts []*Cost `protobuf:"bytes,7,rep,name=toll_costs,json=tollCosts,proto3" json:"toll_costs,omitempty"`
+	// PARKING expenses
+	ParkingCosts []*Cost `protobuf:"bytes,8,rep,name=parking_costs,json=parkingCosts,proto3" json:"parking_costs,omitempty"`
+	// the rest of the expenses
+	OtherCosts   []*Cost `protobuf:"bytes,9,rep,name=other_costs,json=otherCosts,proto3" json:"other_costs,omitempty"`
+	ServiceCosts []*Cost `protobuf:"bytes,10,rep,name=service_costs,json=serviceCosts,proto3" json:"service_costs,omitempty"`
+	ExpenseCount int32   `protobuf:"varint,11,opt,name=expense_count,json=expenseCount,proto3" json:"expense_count,omitempty"`
+	ServiceCount int32   `protobuf:"varint,12,opt,name=service_count,json=serviceCount,proto3" json:"service_count,omitempty"`
+	Costs        []*Cost `protobuf:"bytes,13,rep,name=costs,proto3" json:"costs,omitempty"`
+	// sum in the default currency of the user, empty without it
+	ConvertedTotal *Cost `protobuf:"bytes,14,opt,name=converted_total,json=convertedTotal,proto3" json:"converted_total,omitempty"`
+	// number of the records left out of converted_total for lack of an exchange rate
+	Unconverted int32 `protobuf:"varint,15,opt,name=unconverted,proto3" json:"unconverted,omitempty"`
+	// converted_total per distance unit in minimal units
+	CostPerKm     int32  `protobuf:"varint,16,opt,name=cost_per_km,json=costPerKm,proto3" json:"cost_per_km,omitempty"`
+	Units         *Units `protobuf:"bytes,17,opt,name=units,proto3" json:"units,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Currency) Reset() {
-	*x = Currency{}
-	mi := &file_server_proto_msgTypes[9]
+func (x *TripReport) Reset() {
+	*x = TripReport{}
+	mi := &file_server_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Currency) String() string {
+func (x *TripReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Currency) ProtoMessage() {}
+func (*TripReport) ProtoMessage() {}
 
-func (x *Currency) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[9]
+func (x *TripReport) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,165 +5497,154 @@ func (x *Currency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
-func (*Currency) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TripReport.ProtoReflect.Descriptor instead.
+func (*TripReport) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Currency) GetId() int32 {
+func (x *TripReport) GetTrip() *Trip {
 	if x != nil {
-		return x.Id
+		return x.Trip
 	}
-	return 0
+	return nil
 }
 
-func (x *Currency) GetName() string {
+func (x *TripReport) GetDistance() int32 {
 	if x != nil {
-		return x.Name
+		return x.Distance
 	}
-	return ""
+	return 0
 }
 
-func (x *Currency) GetCode() string {
+func (x *TripReport) GetFuelCount() int32 {
 	if x != nil {
-		return x.Code
+		return x.FuelCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Currency) GetDefault() bool {
+func (x *TripReport) GetFuelValue() int32 {
 	if x != nil {
-		return x.Default
+		return x.FuelValue
 	}
-	return false
+	return 0
 }
 
-func (x *Currency) GetCreatedAt() *timestamppb.Timestamp {
+func (x *TripReport) GetConsumption() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Consumption
 	}
-	return nil
-}
-
-type DefaultCurrency struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *DefaultCurrency) Reset() {
-	*x = DefaultCurrency{}
-	mi := &file_server_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TripReport) GetFuelCosts() []*Cost {
+	if x != nil {
+		return x.FuelCosts
+	}
+	return nil
 }
 
-func (x *DefaultCurrency) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TripReport) GetTollCosts() []*Cost {
+	if x != nil {
+		return x.TollCosts
+	}
+	return nil
 }
 
-func (*DefaultCurrency) ProtoMessage() {}
-
-func (x *DefaultCurrency) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[10]
+func (x *TripReport) GetParkingCosts() []*Cost {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ParkingCosts
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DefaultCurrency.ProtoReflect.Descriptor instead.
-func (*DefaultCurrency) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{10}
+func (x *TripReport) GetOtherCosts() []*Cost {
+	if x != nil {
+		return x.OtherCosts
+	}
+	return nil
 }
 
-func (x *DefaultCurrency) GetCurrency() *Currency {
+func (x *TripReport) GetServiceCosts() []*Cost {
 	if x != nil {
-		return x.Currency
+		return x.ServiceCosts
 	}
 	return nil
 }
 
-func (x *DefaultCurrency) GetFound() bool {
+func (x *TripReport) GetExpenseCount() int32 {
 	if x != nil {
-		return x.Found
+		return x.ExpenseCount
 	}
-	return false
+	return 0
 }
 
-type CurrencyCollection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currencies    []*Currency            `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TripReport) GetServiceCount() int32 {
+	if x != nil {
+		return x.ServiceCount
+	}
+	return 0
 }
 
-func (x *CurrencyCollection) Reset() {
-	*x = CurrencyCollection{}
-	mi := &file_server_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TripReport) GetCosts() []*Cost {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
 }
 
-func (x *CurrencyCollection) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TripReport) GetConvertedTotal() *Cost {
+	if x != nil {
+		return x.ConvertedTotal
+	}
+	return nil
 }
 
-func (*CurrencyCollection) ProtoMessage() {}
-
-func (x *CurrencyCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[11]
+func (x *TripReport) GetUnconverted() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Unconverted
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CurrencyCollection.ProtoReflect.Descriptor instead.
-func (*CurrencyCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{11}
+func (x *TripReport) GetCostPerKm() int32 {
+	if x != nil {
+		return x.CostPerKm
+	}
+	return 0
 }
 
-func (x *CurrencyCollection) GetCurrencies() []*Currency {
+func (x *TripReport) GetUnits() *Units {
 	if x != nil {
-		return x.Currencies
+		return x.Units
 	}
 	return nil
 }
 
-type PaginationMeta struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Current       int32                  `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
-	Last          int32                  `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
+type OdometerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	CarId int32                  `protobuf:"varint,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	// today by default
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationMeta) Reset() {
-	*x = PaginationMeta{}
-	mi := &file_server_proto_msgTypes[12]
+func (x *OdometerRequest) Reset() {
+	*x = OdometerRequest{}
+	mi := &file_server_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationMeta) String() string {
+func (x *OdometerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationMeta) ProtoMessage() {}
+func (*OdometerRequest) ProtoMessage() {}
 
-func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[12]
+func (x *OdometerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,52 +5655,55 @@ func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationMeta.ProtoReflect.Descriptor instead.
-func (*PaginationMeta) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use OdometerRequest.ProtoReflect.Descriptor instead.
+func (*OdometerRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *PaginationMeta) GetCurrent() int32 {
+func (x *OdometerRequest) GetCarId() int32 {
 	if x != nil {
-		return x.Current
+		return x.CarId
 	}
 	return 0
 }
 
-func (x *PaginationMeta) GetLast() int32 {
+func (x *OdometerRequest) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Last
+		return x.Date
 	}
-	return 0
+	return nil
 }
 
-type UserSettings struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DefaultCar      *Car                   `protobuf:"bytes,2,opt,name=default_car,json=defaultCar,proto3" json:"default_car,omitempty"`
-	DefaultCurrency *Currency              `protobuf:"bytes,3,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DefaultFuelType *FuelType              `protobuf:"bytes,6,opt,name=default_fuel_type,json=defaultFuelType,proto3" json:"default_fuel_type,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type OdometerEstimate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Date  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// in the distance units
+	Distance int32          `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Method   OdometerMethod `protobuf:"varint,3,opt,name=method,proto3,enum=xelbot.com.autonotes.server.OdometerMethod" json:"method,omitempty"`
+	// expected distance per year by the readings of the last year
+	AnnualDistance int32 `protobuf:"varint,4,opt,name=annual_distance,json=annualDistance,proto3" json:"annual_distance,omitempty"`
+	// the latest reading of the car, in km as every record
+	Last          *Mileage `protobuf:"bytes,5,opt,name=last,proto3" json:"last,omitempty"`
+	Units         *Units   `protobuf:"bytes,6,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSettings) Reset() {
-	*x = UserSettings{}
-	mi := &file_server_proto_msgTypes[13]
+func (x *OdometerEstimate) Reset() {
+	*x = OdometerEstimate{}
+	mi := &file_server_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSettings) String() string {
+func (x *OdometerEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSettings) ProtoMessage() {}
+func (*OdometerEstimate) ProtoMessage() {}
 
-func (x *UserSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[13]
+func (x *OdometerEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,79 +5714,80 @@ func (x *UserSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
-func (*UserSettings) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use OdometerEstimate.ProtoReflect.Descriptor instead.
+func (*OdometerEstimate) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UserSettings) GetId() int32 {
+func (x *OdometerEstimate) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Date
 	}
-	return 0
+	return nil
 }
 
-func (x *UserSettings) GetDefaultCar() *Car {
+func (x *OdometerEstimate) GetDistance() int32 {
 	if x != nil {
-		return x.DefaultCar
+		return x.Distance
 	}
-	return nil
+	return 0
 }
 
-func (x *UserSettings) GetDefaultCurrency() *Currency {
+func (x *OdometerEstimate) GetMethod() OdometerMethod {
 	if x != nil {
-		return x.DefaultCurrency
+		return x.Method
 	}
-	return nil
+	return OdometerMethod_ODOMETER_UNKNOWN
 }
 
-func (x *UserSettings) GetCreatedAt() *timestamppb.Timestamp {
+func (x *OdometerEstimate) GetAnnualDistance() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.AnnualDistance
 	}
-	return nil
+	return 0
 }
 
-func (x *UserSettings) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *OdometerEstimate) GetLast() *Mileage {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Last
 	}
 	return nil
 }
 
-func (x *UserSettings) GetDefaultFuelType() *FuelType {
+func (x *OdometerEstimate) GetUnits() *Units {
 	if x != nil {
-		return x.DefaultFuelType
+		return x.Units
 	}
 	return nil
 }
 
-type FuelFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	CarId         int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
-	TypeId        int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
-	StationId     int32                  `protobuf:"varint,5,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+type MileageFix struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MileageId int32                  `protobuf:"varint,1,opt,name=mileage_id,json=mileageId,proto3" json:"mileage_id,omitempty"`
+	Action    MileageFixAction       `protobuf:"varint,2,opt,name=action,proto3,enum=xelbot.com.autonotes.server.MileageFixAction" json:"action,omitempty"`
+	// for MILEAGE_FIX_SET_DISTANCE
+	Distance int32 `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	// for MILEAGE_FIX_MERGE, a reading of the same car and date
+	TargetId      int32 `protobuf:"varint,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuelFilter) Reset() {
-	*x = FuelFilter{}
-	mi := &file_server_proto_msgTypes[14]
+func (x *MileageFix) Reset() {
+	*x = MileageFix{}
+	mi := &file_server_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuelFilter) String() string {
+func (x *MileageFix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuelFilter) ProtoMessage() {}
+func (*MileageFix) ProtoMessage() {}
 
-func (x *FuelFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[14]
+func (x *MileageFix) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,68 +5798,67 @@ func (x *FuelFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuelFilter.ProtoReflect.Descriptor instead.
-func (*FuelFilter) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *FuelFilter) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use MileageFix.ProtoReflect.Descriptor instead.
+func (*MileageFix) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *FuelFilter) GetPage() int32 {
+func (x *MileageFix) GetMileageId() int32 {
 	if x != nil {
-		return x.Page
+		return x.MileageId
 	}
 	return 0
 }
 
-func (x *FuelFilter) GetCarId() int32 {
+func (x *MileageFix) GetAction() MileageFixAction {
 	if x != nil {
-		return x.CarId
+		return x.Action
 	}
-	return 0
+	return MileageFixAction_MILEAGE_FIX_UNKNOWN
 }
 
-func (x *FuelFilter) GetTypeId() int32 {
+func (x *MileageFix) GetDistance() int32 {
 	if x != nil {
-		return x.TypeId
+		return x.Distance
 	}
 	return 0
 }
 
-func (x *FuelFilter) GetStationId() int32 {
+func (x *MileageFix) GetTargetId() int32 {
 	if x != nil {
-		return x.StationId
+		return x.TargetId
 	}
 	return 0
 }
 
-type IdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type MileageAnomaly struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Mileage *Mileage               `protobuf:"bytes,1,opt,name=mileage,proto3" json:"mileage,omitempty"`
+	Kind    MileageAnomalyKind     `protobuf:"varint,2,opt,name=kind,proto3,enum=xelbot.com.autonotes.server.MileageAnomalyKind" json:"kind,omitempty"`
+	// the consistent readings around it
+	Previous *Mileage `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	Next     *Mileage `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`
+	// the most probable first
+	Fixes         []*MileageFix `protobuf:"bytes,5,rep,name=fixes,proto3" json:"fixes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IdRequest) Reset() {
-	*x = IdRequest{}
-	mi := &file_server_proto_msgTypes[15]
+func (x *MileageAnomaly) Reset() {
+	*x = MileageAnomaly{}
+	mi := &file_server_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IdRequest) String() string {
+func (x *MileageAnomaly) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IdRequest) ProtoMessage() {}
+func (*MileageAnomaly) ProtoMessage() {}
 
-func (x *IdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[15]
+func (x *MileageAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,92 +5869,115 @@ func (x *IdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IdRequest.ProtoReflect.Descriptor instead.
-func (*IdRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MileageAnomaly.ProtoReflect.Descriptor instead.
+func (*MileageAnomaly) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *IdRequest) GetId() int32 {
+func (x *MileageAnomaly) GetMileage() *Mileage {
 	if x != nil {
-		return x.Id
+		return x.Mileage
 	}
-	return 0
+	return nil
 }
 
-type OrderType struct {
+func (x *MileageAnomaly) GetKind() MileageAnomalyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MileageAnomalyKind_MILEAGE_ANOMALY_UNKNOWN
+}
+
+func (x *MileageAnomaly) GetPrevious() *Mileage {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *MileageAnomaly) GetNext() *Mileage {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+func (x *MileageAnomaly) GetFixes() []*MileageFix {
+	if x != nil {
+		return x.Fixes
+	}
+	return nil
+}
+
+type MileageAnomalyCollection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Anomalies     []*MileageAnomaly      `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderType) Reset() {
-	*x = OrderType{}
-	mi := &file_server_proto_msgTypes[16]
+func (x *MileageAnomalyCollection) Reset() {
+	*x = MileageAnomalyCollection{}
+	mi := &file_server_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderType) String() string {
+func (x *MileageAnomalyCollection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderType) ProtoMessage() {}
+func (*MileageAnomalyCollection) ProtoMessage() {}
 
-func (x *OrderType) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[16]
+func (x *MileageAnomalyCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OrderType.ProtoReflect.Descriptor instead.
-func (*OrderType) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{16}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *OrderType) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use MileageAnomalyCollection.ProtoReflect.Descriptor instead.
+func (*MileageAnomalyCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *OrderType) GetName() string {
+func (x *MileageAnomalyCollection) GetAnomalies() []*MileageAnomaly {
 	if x != nil {
-		return x.Name
+		return x.Anomalies
 	}
-	return ""
+	return nil
 }
 
-type OrderTypeCollection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Types         []*OrderType           `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+type SyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cursor from the previous page, empty for a full copy
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// changes per page, 100 by default
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderTypeCollection) Reset() {
-	*x = OrderTypeCollection{}
-	mi := &file_server_proto_msgTypes[17]
+func (x *SyncRequest) Reset() {
+	*x = SyncRequest{}
+	mi := &file_server_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderTypeCollection) String() string {
+func (x *SyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderTypeCollection) ProtoMessage() {}
+func (*SyncRequest) ProtoMessage() {}
 
-func (x *OrderTypeCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[17]
+func (x *SyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,49 +5988,60 @@ func (x *OrderTypeCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderTypeCollection.ProtoReflect.Descriptor instead.
-func (*OrderTypeCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
+func (*SyncRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *OrderTypeCollection) GetTypes() []*OrderType {
+func (x *SyncRequest) GetCursor() string {
 	if x != nil {
-		return x.Types
+		return x.Cursor
 	}
-	return nil
+	return ""
 }
 
-type Order struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Cost          *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Capacity      string                 `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
-	UsedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
-	Distance      int32                  `protobuf:"varint,7,opt,name=distance,proto3" json:"distance,omitempty"`
-	Car           *Car                   `protobuf:"bytes,8,opt,name=car,proto3" json:"car,omitempty"`
-	Type          *OrderType             `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *SyncRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SyncChange struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Entity SyncEntity             `protobuf:"varint,1,opt,name=entity,proto3,enum=xelbot.com.autonotes.server.SyncEntity" json:"entity,omitempty"`
+	Id     int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// tombstone, the record is removed on the server
+	Deleted bool `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Types that are valid to be assigned to Record:
+	//
+	//	*SyncChange_Fuel
+	//	*SyncChange_Order
+	//	*SyncChange_Expense
+	//	*SyncChange_Service
+	//	*SyncChange_Mileage
+	//	*SyncChange_Car
+	//	*SyncChange_Settings
+	Record        isSyncChange_Record `protobuf_oneof:"record"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_server_proto_msgTypes[18]
+func (x *SyncChange) Reset() {
+	*x = SyncChange{}
+	mi := &file_server_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *SyncChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*SyncChange) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[18]
+func (x *SyncChange) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1245,104 +6052,173 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SyncChange.ProtoReflect.Descriptor instead.
+func (*SyncChange) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *Order) GetId() int32 {
+func (x *SyncChange) GetEntity() SyncEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return SyncEntity_SYNC_UNKNOWN
+}
+
+func (x *SyncChange) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Order) GetCost() *Cost {
+func (x *SyncChange) GetDeleted() bool {
 	if x != nil {
-		return x.Cost
+		return x.Deleted
 	}
-	return nil
+	return false
 }
 
-func (x *Order) GetDescription() string {
+func (x *SyncChange) GetRecord() isSyncChange_Record {
 	if x != nil {
-		return x.Description
+		return x.Record
 	}
-	return ""
+	return nil
 }
 
-func (x *Order) GetCapacity() string {
+func (x *SyncChange) GetFuel() *Fuel {
 	if x != nil {
-		return x.Capacity
+		if x, ok := x.Record.(*SyncChange_Fuel); ok {
+			return x.Fuel
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *Order) GetDate() *timestamppb.Timestamp {
+func (x *SyncChange) GetOrder() *Order {
 	if x != nil {
-		return x.Date
+		if x, ok := x.Record.(*SyncChange_Order); ok {
+			return x.Order
+		}
 	}
 	return nil
 }
 
-func (x *Order) GetUsedAt() *timestamppb.Timestamp {
+func (x *SyncChange) GetExpense() *Expense {
 	if x != nil {
-		return x.UsedAt
+		if x, ok := x.Record.(*SyncChange_Expense); ok {
+			return x.Expense
+		}
 	}
 	return nil
 }
 
-func (x *Order) GetDistance() int32 {
+func (x *SyncChange) GetService() *Service {
 	if x != nil {
-		return x.Distance
+		if x, ok := x.Record.(*SyncChange_Service); ok {
+			return x.Service
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetCar() *Car {
+func (x *SyncChange) GetMileage() *Mileage {
 	if x != nil {
-		return x.Car
+		if x, ok := x.Record.(*SyncChange_Mileage); ok {
+			return x.Mileage
+		}
 	}
 	return nil
 }
 
-func (x *Order) GetType() *OrderType {
+func (x *SyncChange) GetCar() *Car {
 	if x != nil {
-		return x.Type
+		if x, ok := x.Record.(*SyncChange_Car); ok {
+			return x.Car
+		}
 	}
 	return nil
 }
 
-func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SyncChange) GetSettings() *UserSettings {
 	if x != nil {
-		return x.CreatedAt
+		if x, ok := x.Record.(*SyncChange_Settings); ok {
+			return x.Settings
+		}
 	}
 	return nil
 }
 
-type OrderCollection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+type isSyncChange_Record interface {
+	isSyncChange_Record()
+}
+
+type SyncChange_Fuel struct {
+	Fuel *Fuel `protobuf:"bytes,4,opt,name=fuel,proto3,oneof"`
+}
+
+type SyncChange_Order struct {
+	Order *Order `protobuf:"bytes,5,opt,name=order,proto3,oneof"`
+}
+
+type SyncChange_Expense struct {
+	Expense *Expense `protobuf:"bytes,6,opt,name=expense,proto3,oneof"`
+}
+
+type SyncChange_Service struct {
+	Service *Service `protobuf:"bytes,7,opt,name=service,proto3,oneof"`
+}
+
+type SyncChange_Mileage struct {
+	Mileage *Mileage `protobuf:"bytes,8,opt,name=mileage,proto3,oneof"`
+}
+
+type SyncChange_Car struct {
+	Car *Car `protobuf:"bytes,9,opt,name=car,proto3,oneof"`
+}
+
+type SyncChange_Settings struct {
+	Settings *UserSettings `protobuf:"bytes,10,opt,name=settings,proto3,oneof"`
+}
+
+func (*SyncChange_Fuel) isSyncChange_Record() {}
+
+func (*SyncChange_Order) isSyncChange_Record() {}
+
+func (*SyncChange_Expense) isSyncChange_Record() {}
+
+func (*SyncChange_Service) isSyncChange_Record() {}
+
+func (*SyncChange_Mileage) isSyncChange_Record() {}
+
+func (*SyncChange_Car) isSyncChange_Record() {}
+
+func (*SyncChange_Settings) isSyncChange_Record() {}
+
+type SyncPage struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Changes []*SyncChange          `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// cursor for the next request
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	HasMore       bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderCollection) Reset() {
-	*x = OrderCollection{}
-	mi := &file_server_proto_msgTypes[19]
+func (x *SyncPage) Reset() {
+	*x = SyncPage{}
+	mi := &file_server_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderCollection) String() string {
+func (x *SyncPage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderCollection) ProtoMessage() {}
+func (*SyncPage) ProtoMessage() {}
 
-func (x *OrderCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[19]
+func (x *SyncPage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,53 +6229,63 @@ func (x *OrderCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderCollection.ProtoReflect.Descriptor instead.
-func (*OrderCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SyncPage.ProtoReflect.Descriptor instead.
+func (*SyncPage) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *OrderCollection) GetOrders() []*Order {
+func (x *SyncPage) GetChanges() []*SyncChange {
 	if x != nil {
-		return x.Orders
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *OrderCollection) GetMeta() *PaginationMeta {
+func (x *SyncPage) GetCursor() string {
 	if x != nil {
-		return x.Meta
+		return x.Cursor
 	}
-	return nil
+	return ""
 }
 
-type Expense struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Cost          *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	Car           *Car                   `protobuf:"bytes,5,opt,name=car,proto3" json:"car,omitempty"`
-	Type          ExpenseType            `protobuf:"varint,6,opt,name=type,proto3,enum=xelbot.com.autonotes.server.ExpenseType" json:"type,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *SyncPage) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// fuel, order, expense, service or car
+	Entity   SyncEntity `protobuf:"varint,2,opt,name=entity,proto3,enum=xelbot.com.autonotes.server.SyncEntity" json:"entity,omitempty"`
+	RecordId int32      `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// original file name
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	HasThumbnail  bool                   `protobuf:"varint,7,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Expense) Reset() {
-	*x = Expense{}
-	mi := &file_server_proto_msgTypes[20]
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_server_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Expense) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Expense) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *Expense) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[20]
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,83 +6296,89 @@ func (x *Expense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
-func (*Expense) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *Expense) GetId() int32 {
+func (x *Attachment) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Expense) GetCost() *Cost {
+func (x *Attachment) GetEntity() SyncEntity {
 	if x != nil {
-		return x.Cost
+		return x.Entity
 	}
-	return nil
+	return SyncEntity_SYNC_UNKNOWN
 }
 
-func (x *Expense) GetDescription() string {
+func (x *Attachment) GetRecordId() int32 {
 	if x != nil {
-		return x.Description
+		return x.RecordId
+	}
+	return 0
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Expense) GetDate() *timestamppb.Timestamp {
+func (x *Attachment) GetMimeType() string {
 	if x != nil {
-		return x.Date
+		return x.MimeType
 	}
-	return nil
+	return ""
 }
 
-func (x *Expense) GetCar() *Car {
+func (x *Attachment) GetSize() int32 {
 	if x != nil {
-		return x.Car
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-func (x *Expense) GetType() ExpenseType {
+func (x *Attachment) GetHasThumbnail() bool {
 	if x != nil {
-		return x.Type
+		return x.HasThumbnail
 	}
-	return ExpenseType_EMPTY
+	return false
 }
 
-func (x *Expense) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-type ExpenseCollection struct {
+type AttachmentCollection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expenses      []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
-	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpenseCollection) Reset() {
-	*x = ExpenseCollection{}
-	mi := &file_server_proto_msgTypes[21]
+func (x *AttachmentCollection) Reset() {
+	*x = AttachmentCollection{}
+	mi := &file_server_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpenseCollection) String() string {
+func (x *AttachmentCollection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpenseCollection) ProtoMessage() {}
+func (*AttachmentCollection) ProtoMessage() {}
 
-func (x *ExpenseCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[21]
+func (x *AttachmentCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,50 +6389,44 @@ func (x *ExpenseCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpenseCollection.ProtoReflect.Descriptor instead.
-func (*ExpenseCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *ExpenseCollection) GetExpenses() []*Expense {
-	if x != nil {
-		return x.Expenses
-	}
-	return nil
+// Deprecated: Use AttachmentCollection.ProtoReflect.Descriptor instead.
+func (*AttachmentCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ExpenseCollection) GetMeta() *PaginationMeta {
+func (x *AttachmentCollection) GetAttachments() []*Attachment {
 	if x != nil {
-		return x.Meta
+		return x.Attachments
 	}
 	return nil
 }
 
-type OrderFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	CarId         int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
-	TypeId        int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+type AttachmentUpload struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Entity   SyncEntity             `protobuf:"varint,1,opt,name=entity,proto3,enum=xelbot.com.autonotes.server.SyncEntity" json:"entity,omitempty"`
+	RecordId int32                  `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// up to attachments.max_size, the type is detected from the content
+	Data          []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderFilter) Reset() {
-	*x = OrderFilter{}
-	mi := &file_server_proto_msgTypes[22]
+func (x *AttachmentUpload) Reset() {
+	*x = AttachmentUpload{}
+	mi := &file_server_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderFilter) String() string {
+func (x *AttachmentUpload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderFilter) ProtoMessage() {}
+func (*AttachmentUpload) ProtoMessage() {}
 
-func (x *OrderFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[22]
+func (x *AttachmentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,64 +6437,62 @@ func (x *OrderFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
-func (*OrderFilter) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AttachmentUpload.ProtoReflect.Descriptor instead.
+func (*AttachmentUpload) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *OrderFilter) GetLimit() int32 {
+func (x *AttachmentUpload) GetEntity() SyncEntity {
 	if x != nil {
-		return x.Limit
+		return x.Entity
 	}
-	return 0
+	return SyncEntity_SYNC_UNKNOWN
 }
 
-func (x *OrderFilter) GetPage() int32 {
+func (x *AttachmentUpload) GetRecordId() int32 {
 	if x != nil {
-		return x.Page
+		return x.RecordId
 	}
 	return 0
 }
 
-func (x *OrderFilter) GetCarId() int32 {
+func (x *AttachmentUpload) GetName() string {
 	if x != nil {
-		return x.CarId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderFilter) GetTypeId() int32 {
+func (x *AttachmentUpload) GetData() []byte {
 	if x != nil {
-		return x.TypeId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type ExpenseFilter struct {
+type AttachmentFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	CarId         int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
-	Type          ExpenseType            `protobuf:"varint,4,opt,name=type,proto3,enum=xelbot.com.autonotes.server.ExpenseType" json:"type,omitempty"`
+	Entity        SyncEntity             `protobuf:"varint,1,opt,name=entity,proto3,enum=xelbot.com.autonotes.server.SyncEntity" json:"entity,omitempty"`
+	RecordId      int32                  `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpenseFilter) Reset() {
-	*x = ExpenseFilter{}
-	mi := &file_server_proto_msgTypes[23]
+func (x *AttachmentFilter) Reset() {
+	*x = AttachmentFilter{}
+	mi := &file_server_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpenseFilter) String() string {
+func (x *AttachmentFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpenseFilter) ProtoMessage() {}
+func (*AttachmentFilter) ProtoMessage() {}
 
-func (x *ExpenseFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[23]
+func (x *AttachmentFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,63 +6503,102 @@ func (x *ExpenseFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpenseFilter.ProtoReflect.Descriptor instead.
-func (*ExpenseFilter) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AttachmentFilter.ProtoReflect.Descriptor instead.
+func (*AttachmentFilter) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ExpenseFilter) GetLimit() int32 {
+func (x *AttachmentFilter) GetEntity() SyncEntity {
 	if x != nil {
-		return x.Limit
+		return x.Entity
+	}
+	return SyncEntity_SYNC_UNKNOWN
+}
+
+func (x *AttachmentFilter) GetRecordId() int32 {
+	if x != nil {
+		return x.RecordId
 	}
 	return 0
 }
 
-func (x *ExpenseFilter) GetPage() int32 {
+type AttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// JPEG preview of an image
+	Thumbnail     bool `protobuf:"varint,2,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentRequest) Reset() {
+	*x = AttachmentRequest{}
+	mi := &file_server_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentRequest) ProtoMessage() {}
+
+func (x *AttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[80]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExpenseFilter) GetCarId() int32 {
+// Deprecated: Use AttachmentRequest.ProtoReflect.Descriptor instead.
+func (*AttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AttachmentRequest) GetId() int32 {
 	if x != nil {
-		return x.CarId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ExpenseFilter) GetType() ExpenseType {
+func (x *AttachmentRequest) GetThumbnail() bool {
 	if x != nil {
-		return x.Type
+		return x.Thumbnail
 	}
-	return ExpenseType_EMPTY
+	return false
 }
 
-type MileageFilter struct {
+type AttachmentContent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	CarId         int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MileageFilter) Reset() {
-	*x = MileageFilter{}
-	mi := &file_server_proto_msgTypes[24]
+func (x *AttachmentContent) Reset() {
+	*x = AttachmentContent{}
+	mi := &file_server_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MileageFilter) String() string {
+func (x *AttachmentContent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MileageFilter) ProtoMessage() {}
+func (*AttachmentContent) ProtoMessage() {}
 
-func (x *MileageFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[24]
+func (x *AttachmentContent) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,58 +6609,56 @@ func (x *MileageFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MileageFilter.ProtoReflect.Descriptor instead.
-func (*MileageFilter) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use AttachmentContent.ProtoReflect.Descriptor instead.
+func (*AttachmentContent) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *MileageFilter) GetLimit() int32 {
+func (x *AttachmentContent) GetAttachment() *Attachment {
 	if x != nil {
-		return x.Limit
+		return x.Attachment
 	}
-	return 0
+	return nil
 }
 
-func (x *MileageFilter) GetPage() int32 {
+func (x *AttachmentContent) GetMimeType() string {
 	if x != nil {
-		return x.Page
+		return x.MimeType
 	}
-	return 0
+	return ""
 }
 
-func (x *MileageFilter) GetCarId() int32 {
+func (x *AttachmentContent) GetData() []byte {
 	if x != nil {
-		return x.CarId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type Mileage struct {
+type Tag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Distance      int32                  `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Car           *Car                   `protobuf:"bytes,4,opt,name=car,proto3" json:"car,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Mileage) Reset() {
-	*x = Mileage{}
-	mi := &file_server_proto_msgTypes[25]
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_server_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Mileage) String() string {
+func (x *Tag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Mileage) ProtoMessage() {}
+func (*Tag) ProtoMessage() {}
 
-func (x *Mileage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[25]
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1748,69 +6669,54 @@ func (x *Mileage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Mileage.ProtoReflect.Descriptor instead.
-func (*Mileage) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *Mileage) GetId() int32 {
+func (x *Tag) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Mileage) GetDistance() int32 {
-	if x != nil {
-		return x.Distance
-	}
-	return 0
-}
-
-func (x *Mileage) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *Mileage) GetCar() *Car {
+func (x *Tag) GetName() string {
 	if x != nil {
-		return x.Car
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Mileage) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Tag) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-type MileageCollection struct {
+type TagCollection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mileages      []*Mileage             `protobuf:"bytes,1,rep,name=mileages,proto3" json:"mileages,omitempty"`
-	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MileageCollection) Reset() {
-	*x = MileageCollection{}
-	mi := &file_server_proto_msgTypes[26]
+func (x *TagCollection) Reset() {
+	*x = TagCollection{}
+	mi := &file_server_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MileageCollection) String() string {
+func (x *TagCollection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MileageCollection) ProtoMessage() {}
+func (*TagCollection) ProtoMessage() {}
 
-func (x *MileageCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[26]
+func (x *TagCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,53 +6727,44 @@ func (x *MileageCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MileageCollection.ProtoReflect.Descriptor instead.
-func (*MileageCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *MileageCollection) GetMileages() []*Mileage {
-	if x != nil {
-		return x.Mileages
-	}
-	return nil
+// Deprecated: Use TagCollection.ProtoReflect.Descriptor instead.
+func (*TagCollection) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *MileageCollection) GetMeta() *PaginationMeta {
+func (x *TagCollection) GetTags() []*Tag {
 	if x != nil {
-		return x.Meta
+		return x.Tags
 	}
 	return nil
 }
 
-type Service struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Cost          *Cost                  `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	Car           *Car                   `protobuf:"bytes,5,opt,name=car,proto3" json:"car,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Distance      int32                  `protobuf:"varint,7,opt,name=distance,proto3" json:"distance,omitempty"`
+type RecordTags struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fuel, order, expense, service or mileage
+	Entity   SyncEntity `protobuf:"varint,1,opt,name=entity,proto3,enum=xelbot.com.autonotes.server.SyncEntity" json:"entity,omitempty"`
+	RecordId int32      `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// replaces the tags of the record, empty removes them all
+	TagIds        []int32 `protobuf:"varint,3,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Service) Reset() {
-	*x = Service{}
-	mi := &file_server_proto_msgTypes[27]
+func (x *RecordTags) Reset() {
+	*x = RecordTags{}
+	mi := &file_server_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Service) String() string {
+func (x *RecordTags) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Service) ProtoMessage() {}
+func (*RecordTags) ProtoMessage() {}
 
-func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[27]
+func (x *RecordTags) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1878,83 +6775,57 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Service.ProtoReflect.Descriptor instead.
-func (*Service) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *Service) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Service) GetCost() *Cost {
-	if x != nil {
-		return x.Cost
-	}
-	return nil
-}
-
-func (x *Service) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use RecordTags.ProtoReflect.Descriptor instead.
+func (*RecordTags) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *Service) GetDate() *timestamppb.Timestamp {
+func (x *RecordTags) GetEntity() SyncEntity {
 	if x != nil {
-		return x.Date
+		return x.Entity
 	}
-	return nil
+	return SyncEntity_SYNC_UNKNOWN
 }
 
-func (x *Service) GetCar() *Car {
+func (x *RecordTags) GetRecordId() int32 {
 	if x != nil {
-		return x.Car
+		return x.RecordId
 	}
-	return nil
+	return 0
 }
 
-func (x *Service) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecordTags) GetTagIds() []int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TagIds
 	}
 	return nil
 }
 
-func (x *Service) GetDistance() int32 {
-	if x != nil {
-		return x.Distance
-	}
-	return 0
-}
-
-type ServiceCollection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
-	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+type TagEntityTotal struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Entity SyncEntity             `protobuf:"varint,1,opt,name=entity,proto3,enum=xelbot.com.autonotes.server.SyncEntity" json:"entity,omitempty"`
+	Count  int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// one cost per currency, empty for mileages
+	Costs         []*Cost `protobuf:"bytes,3,rep,name=costs,proto3" json:"costs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceCollection) Reset() {
-	*x = ServiceCollection{}
-	mi := &file_server_proto_msgTypes[28]
+func (x *TagEntityTotal) Reset() {
+	*x = TagEntityTotal{}
+	mi := &file_server_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceCollection) String() string {
+func (x *TagEntityTotal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceCollection) ProtoMessage() {}
+func (*TagEntityTotal) ProtoMessage() {}
 
-func (x *ServiceCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[28]
+func (x *TagEntityTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,49 +6836,61 @@ func (x *ServiceCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceCollection.ProtoReflect.Descriptor instead.
-func (*ServiceCollection) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use TagEntityTotal.ProtoReflect.Descriptor instead.
+func (*TagEntityTotal) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *ServiceCollection) GetServices() []*Service {
+func (x *TagEntityTotal) GetEntity() SyncEntity {
 	if x != nil {
-		return x.Services
+		return x.Entity
 	}
-	return nil
+	return SyncEntity_SYNC_UNKNOWN
 }
 
-func (x *ServiceCollection) GetMeta() *PaginationMeta {
+func (x *TagEntityTotal) GetCount() int32 {
 	if x != nil {
-		return x.Meta
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TagEntityTotal) GetCosts() []*Cost {
+	if x != nil {
+		return x.Costs
 	}
 	return nil
 }
 
-type ServiceFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	CarId         int32                  `protobuf:"varint,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+type TagSummary struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Tag      *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Entities []*TagEntityTotal      `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	// one cost per currency over all the record types
+	Costs []*Cost `protobuf:"bytes,3,rep,name=costs,proto3" json:"costs,omitempty"`
+	// sum in the default currency of the user, empty without it
+	ConvertedTotal *Cost `protobuf:"bytes,4,opt,name=converted_total,json=convertedTotal,proto3" json:"converted_total,omitempty"`
+	// number of the records left out of converted_total for lack of an exchange rate
+	Unconverted   int32 `protobuf:"varint,5,opt,name=unconverted,proto3" json:"unconverted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceFilter) Reset() {
-	*x = ServiceFilter{}
-	mi := &file_server_proto_msgTypes[29]
+func (x *TagSummary) Reset() {
+	*x = TagSummary{}
+	mi := &file_server_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceFilter) String() string {
+func (x *TagSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceFilter) ProtoMessage() {}
+func (*TagSummary) ProtoMessage() {}
 
-func (x *ServiceFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[29]
+func (x *TagSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,28 +6901,42 @@ func (x *ServiceFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceFilter.ProtoReflect.Descriptor instead.
-func (*ServiceFilter) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use TagSummary.ProtoReflect.Descriptor instead.
+func (*TagSummary) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *ServiceFilter) GetLimit() int32 {
+func (x *TagSummary) GetTag() *Tag {
 	if x != nil {
-		return x.Limit
+		return x.Tag
 	}
-	return 0
+	return nil
 }
 
-func (x *ServiceFilter) GetPage() int32 {
+func (x *TagSummary) GetEntities() []*TagEntityTotal {
 	if x != nil {
-		return x.Page
+		return x.Entities
 	}
-	return 0
+	return nil
 }
 
-func (x *ServiceFilter) GetCarId() int32 {
+func (x *TagSummary) GetCosts() []*Cost {
 	if x != nil {
-		return x.CarId
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *TagSummary) GetConvertedTotal() *Cost {
+	if x != nil {
+		return x.ConvertedTotal
+	}
+	return nil
+}
+
+func (x *TagSummary) GetUnconverted() int32 {
+	if x != nil {
+		return x.Unconverted
 	}
 	return 0
 }
@@ -2068,12 +6965,18 @@ const file_server_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"c\n" +
 	"\x18FillingStationCollection\x12G\n" +
-	"\bstations\x18\x01 \x03(\v2+.xelbot.com.autonotes.server.FillingStationR\bstations\".\n" +
+	"\bstations\x18\x01 \x03(\v2+.xelbot.com.autonotes.server.FillingStationR\bstations\"\x8e\x01\n" +
 	"\bFuelType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"Q\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x05R\bparentId\x12A\n" +
+	"\bchildren\x18\x04 \x03(\v2%.xelbot.com.autonotes.server.FuelTypeR\bchildren\"\x8c\x01\n" +
 	"\x12FuelTypeCollection\x12;\n" +
-	"\x05types\x18\x01 \x03(\v2%.xelbot.com.autonotes.server.FuelTypeR\x05types\"\xa0\x03\n" +
+	"\x05types\x18\x01 \x03(\v2%.xelbot.com.autonotes.server.FuelTypeR\x05types\x129\n" +
+	"\x04tree\x18\x02 \x03(\v2%.xelbot.com.autonotes.server.FuelTypeR\x04tree\"I\n" +
+	"\rFuelTypeMerge\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x05R\btargetId\"\xdc\x04\n" +
 	"\x04Fuel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x125\n" +
 	"\x04cost\x18\x02 \x01(\v2!.xelbot.com.autonotes.server.CostR\x04cost\x12\x14\n" +
@@ -2084,7 +6987,12 @@ const file_server_proto_rawDesc = "" +
 	"\x03car\x18\a \x01(\v2 .xelbot.com.autonotes.server.CarR\x03car\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\x04type\x18\t \x01(\v2%.xelbot.com.autonotes.server.FuelTypeR\x04type\"\x8a\x01\n" +
+	"\x04type\x18\t \x01(\v2%.xelbot.com.autonotes.server.FuelTypeR\x04type\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\x12H\n" +
+	"\x0econverted_cost\x18\v \x01(\v2!.xelbot.com.autonotes.server.CostR\rconvertedCost\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x124\n" +
+	"\x04tags\x18\r \x03(\v2 .xelbot.com.autonotes.server.TagR\x04tags\"\x8a\x01\n" +
 	"\x0eFuelCollection\x127\n" +
 	"\x05fuels\x18\x01 \x03(\v2!.xelbot.com.autonotes.server.FuelR\x05fuels\x12?\n" +
 	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"\x97\x01\n" +
@@ -2101,10 +7009,17 @@ const file_server_proto_rawDesc = "" +
 	"\x12CurrencyCollection\x12E\n" +
 	"\n" +
 	"currencies\x18\x01 \x03(\v2%.xelbot.com.autonotes.server.CurrencyR\n" +
-	"currencies\">\n" +
+	"currencies\"z\n" +
 	"\x0ePaginationMeta\x12\x18\n" +
 	"\acurrent\x18\x01 \x01(\x05R\acurrent\x12\x12\n" +
-	"\x04last\x18\x02 \x01(\x05R\x04last\"\xfc\x02\n" +
+	"\x04last\x18\x02 \x01(\x05R\x04last\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"\xdf\x01\n" +
+	"\x05Units\x12E\n" +
+	"\bdistance\x18\x01 \x01(\x0e2).xelbot.com.autonotes.server.DistanceUnitR\bdistance\x12?\n" +
+	"\x06volume\x18\x02 \x01(\x0e2'.xelbot.com.autonotes.server.VolumeUnitR\x06volume\x12N\n" +
+	"\vconsumption\x18\x03 \x01(\x0e2,.xelbot.com.autonotes.server.ConsumptionUnitR\vconsumption\"\xf0\x04\n" +
 	"\fUserSettings\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12A\n" +
 	"\vdefault_car\x18\x02 \x01(\v2 .xelbot.com.autonotes.server.CarR\n" +
@@ -2114,7 +7029,26 @@ const file_server_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12Q\n" +
-	"\x11default_fuel_type\x18\x06 \x01(\v2%.xelbot.com.autonotes.server.FuelTypeR\x0fdefaultFuelType\"\x85\x01\n" +
+	"\x11default_fuel_type\x18\x06 \x01(\v2%.xelbot.com.autonotes.server.FuelTypeR\x0fdefaultFuelType\x12S\n" +
+	"\rdistance_unit\x18\a \x01(\x0e2).xelbot.com.autonotes.server.DistanceUnitH\x00R\fdistanceUnit\x88\x01\x01\x12M\n" +
+	"\vvolume_unit\x18\b \x01(\x0e2'.xelbot.com.autonotes.server.VolumeUnitH\x01R\n" +
+	"volumeUnit\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\t \x01(\tH\x02R\btimezone\x88\x01\x01B\x10\n" +
+	"\x0e_distance_unitB\x0e\n" +
+	"\f_volume_unitB\v\n" +
+	"\t_timezone\"\x82\x01\n" +
+	"\fExchangeRate\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\tR\x04base\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\"Y\n" +
+	"\x16ExchangeRateCollection\x12?\n" +
+	"\x05rates\x18\x01 \x03(\v2).xelbot.com.autonotes.server.ExchangeRateR\x05rates\"(\n" +
+	"\x12ExchangeRateImport\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"P\n" +
+	"\x18ExchangeRateImportResult\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\"\x97\x04\n" +
 	"\n" +
 	"FuelFilter\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
@@ -2122,14 +7056,68 @@ const file_server_proto_rawDesc = "" +
 	"\x06car_id\x18\x03 \x01(\x05R\x05carId\x12\x17\n" +
 	"\atype_id\x18\x04 \x01(\x05R\x06typeId\x12\x1d\n" +
 	"\n" +
-	"station_id\x18\x05 \x01(\x05R\tstationId\"\x1b\n" +
+	"station_id\x18\x05 \x01(\x05R\tstationId\x127\n" +
+	"\tdate_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x19\n" +
+	"\bmin_cost\x18\b \x01(\x05R\aminCost\x12\x19\n" +
+	"\bmax_cost\x18\t \x01(\x05R\amaxCost\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12\x17\n" +
+	"\asort_by\x18\v \x01(\tR\x06sortBy\x12Q\n" +
+	"\x0esort_direction\x18\f \x01(\x0e2*.xelbot.com.autonotes.server.SortDirectionR\rsortDirection\x12\x16\n" +
+	"\x06cursor\x18\r \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"skip_count\x18\x0e \x01(\bR\tskipCount\x12\x16\n" +
+	"\x06search\x18\x0f \x01(\tR\x06search\x12\x15\n" +
+	"\x06tag_id\x18\x10 \x01(\x05R\x05tagId\"\xba\x01\n" +
+	"\n" +
+	"BatchError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12E\n" +
+	"\x04meta\x18\x03 \x03(\v21.xelbot.com.autonotes.server.BatchError.MetaEntryR\x04meta\x1a7\n" +
+	"\tMetaEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1b\n" +
 	"\tIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x80\x01\n" +
+	"\tFuelBatch\x127\n" +
+	"\x05fuels\x18\x01 \x03(\v2!.xelbot.com.autonotes.server.FuelR\x05fuels\x12:\n" +
+	"\x04mode\x18\x02 \x01(\x0e2&.xelbot.com.autonotes.server.BatchModeR\x04mode\"\x85\x01\n" +
+	"\rFuelBatchItem\x125\n" +
+	"\x04fuel\x18\x01 \x01(\v2!.xelbot.com.autonotes.server.FuelR\x04fuel\x12=\n" +
+	"\x05error\x18\x02 \x01(\v2'.xelbot.com.autonotes.server.BatchErrorR\x05error\"S\n" +
+	"\x0fFuelBatchResult\x12@\n" +
+	"\x05items\x18\x01 \x03(\v2*.xelbot.com.autonotes.server.FuelBatchItemR\x05items\"\xb7\x01\n" +
+	"\x0fFuelPriceFilter\x127\n" +
+	"\tdate_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x1d\n" +
+	"\n" +
+	"station_id\x18\x03 \x01(\x05R\tstationId\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\x05R\x06typeId\"\xad\x01\n" +
+	"\x0eFuelPricePoint\x12\x17\n" +
+	"\afuel_id\x18\x01 \x01(\x05R\x06fuelId\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x1c\n" +
+	"\tdeviation\x18\x04 \x01(\x01R\tdeviation\x12\x1e\n" +
+	"\n" +
+	"suspicious\x18\x05 \x01(\bR\n" +
+	"suspicious\"\xcc\x02\n" +
+	"\x10FuelPriceHistory\x12E\n" +
+	"\astation\x18\x01 \x01(\v2+.xelbot.com.autonotes.server.FillingStationR\astation\x129\n" +
+	"\x04type\x18\x02 \x01(\v2%.xelbot.com.autonotes.server.FuelTypeR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12C\n" +
+	"\x06points\x18\x04 \x03(\v2+.xelbot.com.autonotes.server.FuelPricePointR\x06points\x12\x1b\n" +
+	"\tmin_price\x18\x05 \x01(\x05R\bminPrice\x12\x1b\n" +
+	"\tavg_price\x18\x06 \x01(\x05R\bavgPrice\x12\x1b\n" +
+	"\tmax_price\x18\a \x01(\x05R\bmaxPrice\"\x96\x01\n" +
+	"\x13FuelPriceCollection\x12E\n" +
+	"\x06prices\x18\x01 \x03(\v2-.xelbot.com.autonotes.server.FuelPriceHistoryR\x06prices\x128\n" +
+	"\x05units\x18\x02 \x01(\v2\".xelbot.com.autonotes.server.UnitsR\x05units\"/\n" +
 	"\tOrderType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"S\n" +
 	"\x13OrderTypeCollection\x12<\n" +
-	"\x05types\x18\x01 \x03(\v2&.xelbot.com.autonotes.server.OrderTypeR\x05types\"\xb8\x03\n" +
+	"\x05types\x18\x01 \x03(\v2&.xelbot.com.autonotes.server.OrderTypeR\x05types\"\xd2\x04\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x125\n" +
 	"\x04cost\x18\x02 \x01(\v2!.xelbot.com.autonotes.server.CostR\x04cost\x12 \n" +
@@ -2142,10 +7130,25 @@ const file_server_proto_rawDesc = "" +
 	"\x04type\x18\t \x01(\v2&.xelbot.com.autonotes.server.OrderTypeR\x04type\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8e\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\x12H\n" +
+	"\x0econverted_cost\x18\f \x01(\v2!.xelbot.com.autonotes.server.CostR\rconvertedCost\x124\n" +
+	"\x04tags\x18\r \x03(\v2 .xelbot.com.autonotes.server.TagR\x04tags\"\x8e\x01\n" +
 	"\x0fOrderCollection\x12:\n" +
 	"\x06orders\x18\x01 \x03(\v2\".xelbot.com.autonotes.server.OrderR\x06orders\x12?\n" +
-	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"\xcf\x02\n" +
+	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"\xe3\x01\n" +
+	"\x0fExpenseCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x05R\bparentId\x12<\n" +
+	"\x04type\x18\x04 \x01(\x0e2(.xelbot.com.autonotes.server.ExpenseTypeR\x04type\x12\x16\n" +
+	"\x06system\x18\x05 \x01(\bR\x06system\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"i\n" +
+	"\x19ExpenseCategoryCollection\x12L\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2,.xelbot.com.autonotes.server.ExpenseCategoryR\n" +
+	"categories\"\xd6\x04\n" +
 	"\aExpense\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x125\n" +
 	"\x04cost\x18\x02 \x01(\v2!.xelbot.com.autonotes.server.CostR\x04cost\x12 \n" +
@@ -2154,34 +7157,143 @@ const file_server_proto_rawDesc = "" +
 	"\x03car\x18\x05 \x01(\v2 .xelbot.com.autonotes.server.CarR\x03car\x12<\n" +
 	"\x04type\x18\x06 \x01(\x0e2(.xelbot.com.autonotes.server.ExpenseTypeR\x04type\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x96\x01\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\x12H\n" +
+	"\x0econverted_cost\x18\t \x01(\v2!.xelbot.com.autonotes.server.CostR\rconvertedCost\x12H\n" +
+	"\bcategory\x18\n" +
+	" \x01(\v2,.xelbot.com.autonotes.server.ExpenseCategoryR\bcategory\x12!\n" +
+	"\frecurring_id\x18\v \x01(\x05R\vrecurringId\x124\n" +
+	"\x04tags\x18\f \x03(\v2 .xelbot.com.autonotes.server.TagR\x04tags\"\xcb\x04\n" +
+	"\x10RecurringExpense\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x125\n" +
+	"\x04cost\x18\x02 \x01(\v2!.xelbot.com.autonotes.server.CostR\x04cost\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
+	"\x03car\x18\x04 \x01(\v2 .xelbot.com.autonotes.server.CarR\x03car\x12H\n" +
+	"\bcategory\x18\x05 \x01(\v2,.xelbot.com.autonotes.server.ExpenseCategoryR\bcategory\x12N\n" +
+	"\tfrequency\x18\x06 \x01(\x0e20.xelbot.com.autonotes.server.RecurrenceFrequencyR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\a \x01(\x05R\binterval\x129\n" +
+	"\n" +
+	"start_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x127\n" +
+	"\tnext_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bnextDate\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\x1aRecurringExpenseCollection\x12C\n" +
+	"\x05items\x18\x01 \x03(\v2-.xelbot.com.autonotes.server.RecurringExpenseR\x05items\"+\n" +
+	"\x15UpcomingExpenseFilter\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"\x8e\x01\n" +
+	"\x0fUpcomingExpense\x12K\n" +
+	"\trecurring\x18\x01 \x01(\v2-.xelbot.com.autonotes.server.RecurringExpenseR\trecurring\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"_\n" +
+	"\x19UpcomingExpenseCollection\x12B\n" +
+	"\x05items\x18\x01 \x03(\v2,.xelbot.com.autonotes.server.UpcomingExpenseR\x05items\"\x96\x01\n" +
 	"\x11ExpenseCollection\x12@\n" +
 	"\bexpenses\x18\x01 \x03(\v2$.xelbot.com.autonotes.server.ExpenseR\bexpenses\x12?\n" +
-	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"g\n" +
+	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"\xf9\x03\n" +
 	"\vOrderFilter\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x15\n" +
 	"\x06car_id\x18\x03 \x01(\x05R\x05carId\x12\x17\n" +
-	"\atype_id\x18\x04 \x01(\x05R\x06typeId\"\x8e\x01\n" +
+	"\atype_id\x18\x04 \x01(\x05R\x06typeId\x127\n" +
+	"\tdate_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x19\n" +
+	"\bmin_cost\x18\a \x01(\x05R\aminCost\x12\x19\n" +
+	"\bmax_cost\x18\b \x01(\x05R\amaxCost\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06search\x18\n" +
+	" \x01(\tR\x06search\x12\x17\n" +
+	"\asort_by\x18\v \x01(\tR\x06sortBy\x12Q\n" +
+	"\x0esort_direction\x18\f \x01(\x0e2*.xelbot.com.autonotes.server.SortDirectionR\rsortDirection\x12\x16\n" +
+	"\x06cursor\x18\r \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"skip_count\x18\x0e \x01(\bR\tskipCount\x12\x15\n" +
+	"\x06tag_id\x18\x0f \x01(\x05R\x05tagId\"\xc1\x04\n" +
 	"\rExpenseFilter\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x15\n" +
 	"\x06car_id\x18\x03 \x01(\x05R\x05carId\x12<\n" +
-	"\x04type\x18\x04 \x01(\x0e2(.xelbot.com.autonotes.server.ExpenseTypeR\x04type\"P\n" +
+	"\x04type\x18\x04 \x01(\x0e2(.xelbot.com.autonotes.server.ExpenseTypeR\x04type\x127\n" +
+	"\tdate_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x19\n" +
+	"\bmin_cost\x18\a \x01(\x05R\aminCost\x12\x19\n" +
+	"\bmax_cost\x18\b \x01(\x05R\amaxCost\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06search\x18\n" +
+	" \x01(\tR\x06search\x12\x17\n" +
+	"\asort_by\x18\v \x01(\tR\x06sortBy\x12Q\n" +
+	"\x0esort_direction\x18\f \x01(\x0e2*.xelbot.com.autonotes.server.SortDirectionR\rsortDirection\x12\x16\n" +
+	"\x06cursor\x18\r \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"skip_count\x18\x0e \x01(\bR\tskipCount\x12\x1f\n" +
+	"\vcategory_id\x18\x0f \x01(\x05R\n" +
+	"categoryId\x12\x15\n" +
+	"\x06tag_id\x18\x10 \x01(\x05R\x05tagId\"F\n" +
+	"\x14OrderInventoryFilter\x12\x15\n" +
+	"\x06car_id\x18\x01 \x01(\x05R\x05carId\x12\x17\n" +
+	"\atype_id\x18\x02 \x01(\x05R\x06typeId\"\xc1\x01\n" +
+	"\x13OrderInventoryGroup\x122\n" +
+	"\x03car\x18\x01 \x01(\v2 .xelbot.com.autonotes.server.CarR\x03car\x12:\n" +
+	"\x04type\x18\x02 \x01(\v2&.xelbot.com.autonotes.server.OrderTypeR\x04type\x12:\n" +
+	"\x06orders\x18\x03 \x03(\v2\".xelbot.com.autonotes.server.OrderR\x06orders\"\x9e\x02\n" +
+	"\x10OrderServiceLife\x122\n" +
+	"\x03car\x18\x01 \x01(\v2 .xelbot.com.autonotes.server.CarR\x03car\x12:\n" +
+	"\x04type\x18\x02 \x01(\v2&.xelbot.com.autonotes.server.OrderTypeR\x04type\x12\x1a\n" +
+	"\binstalls\x18\x03 \x01(\x05R\binstalls\x12#\n" +
+	"\rlast_distance\x18\x04 \x01(\x05R\flastDistance\x12\x1b\n" +
+	"\tlast_days\x18\x05 \x01(\x05R\blastDays\x12!\n" +
+	"\favg_distance\x18\x06 \x01(\x05R\vavgDistance\x12\x19\n" +
+	"\bavg_days\x18\a \x01(\x05R\aavgDays\"\xac\x01\n" +
+	"\x0eOrderInventory\x12H\n" +
+	"\x06unused\x18\x01 \x03(\v20.xelbot.com.autonotes.server.OrderInventoryGroupR\x06unused\x12P\n" +
+	"\fservice_life\x18\x02 \x03(\v2-.xelbot.com.autonotes.server.OrderServiceLifeR\vserviceLife\"\x87\x01\n" +
+	"\n" +
+	"OrderUsage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x123\n" +
+	"\aused_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06usedAt\x12\x1a\n" +
+	"\bdistance\x18\x03 \x01(\x05R\bdistance\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\"\x84\x01\n" +
+	"\n" +
+	"OrderBatch\x12:\n" +
+	"\x06orders\x18\x01 \x03(\v2\".xelbot.com.autonotes.server.OrderR\x06orders\x12:\n" +
+	"\x04mode\x18\x02 \x01(\x0e2&.xelbot.com.autonotes.server.BatchModeR\x04mode\"\x89\x01\n" +
+	"\x0eOrderBatchItem\x128\n" +
+	"\x05order\x18\x01 \x01(\v2\".xelbot.com.autonotes.server.OrderR\x05order\x12=\n" +
+	"\x05error\x18\x02 \x01(\v2'.xelbot.com.autonotes.server.BatchErrorR\x05error\"U\n" +
+	"\x10OrderBatchResult\x12A\n" +
+	"\x05items\x18\x01 \x03(\v2+.xelbot.com.autonotes.server.OrderBatchItemR\x05items\"\x8c\x01\n" +
+	"\fExpenseBatch\x12@\n" +
+	"\bexpenses\x18\x01 \x03(\v2$.xelbot.com.autonotes.server.ExpenseR\bexpenses\x12:\n" +
+	"\x04mode\x18\x02 \x01(\x0e2&.xelbot.com.autonotes.server.BatchModeR\x04mode\"\x91\x01\n" +
+	"\x10ExpenseBatchItem\x12>\n" +
+	"\aexpense\x18\x01 \x01(\v2$.xelbot.com.autonotes.server.ExpenseR\aexpense\x12=\n" +
+	"\x05error\x18\x02 \x01(\v2'.xelbot.com.autonotes.server.BatchErrorR\x05error\"Y\n" +
+	"\x12ExpenseBatchResult\x12C\n" +
+	"\x05items\x18\x01 \x03(\v2-.xelbot.com.autonotes.server.ExpenseBatchItemR\x05items\"\xf8\x02\n" +
 	"\rMileageFilter\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x15\n" +
-	"\x06car_id\x18\x03 \x01(\x05R\x05carId\"\xd4\x01\n" +
+	"\x06car_id\x18\x03 \x01(\x05R\x05carId\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x17\n" +
+	"\asort_by\x18\x06 \x01(\tR\x06sortBy\x12Q\n" +
+	"\x0esort_direction\x18\a \x01(\x0e2*.xelbot.com.autonotes.server.SortDirectionR\rsortDirection\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"skip_count\x18\t \x01(\bR\tskipCount\x12\x15\n" +
+	"\x06tag_id\x18\n" +
+	" \x01(\x05R\x05tagId\"\x8a\x02\n" +
 	"\aMileage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
 	"\bdistance\x18\x02 \x01(\x05R\bdistance\x12.\n" +
 	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x122\n" +
 	"\x03car\x18\x04 \x01(\v2 .xelbot.com.autonotes.server.CarR\x03car\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x96\x01\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x124\n" +
+	"\x04tags\x18\x06 \x03(\v2 .xelbot.com.autonotes.server.TagR\x04tags\"\x96\x01\n" +
 	"\x11MileageCollection\x12@\n" +
 	"\bmileages\x18\x01 \x03(\v2$.xelbot.com.autonotes.server.MileageR\bmileages\x12?\n" +
-	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"\xad\x02\n" +
+	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"\xad\x03\n" +
 	"\aService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x125\n" +
 	"\x04cost\x18\x02 \x01(\v2!.xelbot.com.autonotes.server.CostR\x04cost\x12 \n" +
@@ -2190,14 +7302,193 @@ const file_server_proto_rawDesc = "" +
 	"\x03car\x18\x05 \x01(\v2 .xelbot.com.autonotes.server.CarR\x03car\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
-	"\bdistance\x18\a \x01(\x05R\bdistance\"\x96\x01\n" +
+	"\bdistance\x18\a \x01(\x05R\bdistance\x12H\n" +
+	"\x0econverted_cost\x18\b \x01(\v2!.xelbot.com.autonotes.server.CostR\rconvertedCost\x124\n" +
+	"\x04tags\x18\t \x03(\v2 .xelbot.com.autonotes.server.TagR\x04tags\"\x96\x01\n" +
 	"\x11ServiceCollection\x12@\n" +
 	"\bservices\x18\x01 \x03(\v2$.xelbot.com.autonotes.server.ServiceR\bservices\x12?\n" +
-	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"P\n" +
+	"\x04meta\x18\x02 \x01(\v2+.xelbot.com.autonotes.server.PaginationMetaR\x04meta\"\xe2\x03\n" +
 	"\rServiceFilter\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x15\n" +
-	"\x06car_id\x18\x03 \x01(\x05R\x05carId*v\n" +
+	"\x06car_id\x18\x03 \x01(\x05R\x05carId\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x19\n" +
+	"\bmin_cost\x18\x06 \x01(\x05R\aminCost\x12\x19\n" +
+	"\bmax_cost\x18\a \x01(\x05R\amaxCost\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06search\x18\t \x01(\tR\x06search\x12\x17\n" +
+	"\asort_by\x18\n" +
+	" \x01(\tR\x06sortBy\x12Q\n" +
+	"\x0esort_direction\x18\v \x01(\x0e2*.xelbot.com.autonotes.server.SortDirectionR\rsortDirection\x12\x16\n" +
+	"\x06cursor\x18\f \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"skip_count\x18\r \x01(\bR\tskipCount\x12\x15\n" +
+	"\x06tag_id\x18\x0e \x01(\x05R\x05tagId\"\x8d\x02\n" +
+	"\x04Trip\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
+	"\x03car\x18\x03 \x01(\v2 .xelbot.com.autonotes.server.CarR\x03car\x12:\n" +
+	"\x05start\x18\x04 \x01(\v2$.xelbot.com.autonotes.server.MileageR\x05start\x126\n" +
+	"\x03end\x18\x05 \x01(\v2$.xelbot.com.autonotes.server.MileageR\x03end\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"I\n" +
+	"\x0eTripCollection\x127\n" +
+	"\x05trips\x18\x01 \x03(\v2!.xelbot.com.autonotes.server.TripR\x05trips\"7\n" +
+	"\n" +
+	"TripFilter\x12\x15\n" +
+	"\x06car_id\x18\x01 \x01(\x05R\x05carId\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\bR\x04open\"\x82\x01\n" +
+	"\tTripStart\x12\x15\n" +
+	"\x06car_id\x18\x01 \x01(\x05R\x05carId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bdistance\x18\x03 \x01(\x05R\bdistance\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"g\n" +
+	"\tTripClose\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x05R\bdistance\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xe2\x06\n" +
+	"\n" +
+	"TripReport\x125\n" +
+	"\x04trip\x18\x01 \x01(\v2!.xelbot.com.autonotes.server.TripR\x04trip\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x05R\bdistance\x12\x1d\n" +
+	"\n" +
+	"fuel_count\x18\x03 \x01(\x05R\tfuelCount\x12\x1d\n" +
+	"\n" +
+	"fuel_value\x18\x04 \x01(\x05R\tfuelValue\x12 \n" +
+	"\vconsumption\x18\x05 \x01(\x05R\vconsumption\x12@\n" +
+	"\n" +
+	"fuel_costs\x18\x06 \x03(\v2!.xelbot.com.autonotes.server.CostR\tfuelCosts\x12@\n" +
+	"\n" +
+	"toll_costs\x18\a \x03(\v2!.xelbot.com.autonotes.server.CostR\ttollCosts\x12F\n" +
+	"\rparking_costs\x18\b \x03(\v2!.xelbot.com.autonotes.server.CostR\fparkingCosts\x12B\n" +
+	"\vother_costs\x18\t \x03(\v2!.xelbot.com.autonotes.server.CostR\n" +
+	"otherCosts\x12F\n" +
+	"\rservice_costs\x18\n" +
+	" \x03(\v2!.xelbot.com.autonotes.server.CostR\fserviceCosts\x12#\n" +
+	"\rexpense_count\x18\v \x01(\x05R\fexpenseCount\x12#\n" +
+	"\rservice_count\x18\f \x01(\x05R\fserviceCount\x127\n" +
+	"\x05costs\x18\r \x03(\v2!.xelbot.com.autonotes.server.CostR\x05costs\x12J\n" +
+	"\x0fconverted_total\x18\x0e \x01(\v2!.xelbot.com.autonotes.server.CostR\x0econvertedTotal\x12 \n" +
+	"\vunconverted\x18\x0f \x01(\x05R\vunconverted\x12\x1e\n" +
+	"\vcost_per_km\x18\x10 \x01(\x05R\tcostPerKm\x128\n" +
+	"\x05units\x18\x11 \x01(\v2\".xelbot.com.autonotes.server.UnitsR\x05units\"X\n" +
+	"\x0fOdometerRequest\x12\x15\n" +
+	"\x06car_id\x18\x01 \x01(\x05R\x05carId\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xc0\x02\n" +
+	"\x10OdometerEstimate\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x05R\bdistance\x12C\n" +
+	"\x06method\x18\x03 \x01(\x0e2+.xelbot.com.autonotes.server.OdometerMethodR\x06method\x12'\n" +
+	"\x0fannual_distance\x18\x04 \x01(\x05R\x0eannualDistance\x128\n" +
+	"\x04last\x18\x05 \x01(\v2$.xelbot.com.autonotes.server.MileageR\x04last\x128\n" +
+	"\x05units\x18\x06 \x01(\v2\".xelbot.com.autonotes.server.UnitsR\x05units\"\xab\x01\n" +
+	"\n" +
+	"MileageFix\x12\x1d\n" +
+	"\n" +
+	"mileage_id\x18\x01 \x01(\x05R\tmileageId\x12E\n" +
+	"\x06action\x18\x02 \x01(\x0e2-.xelbot.com.autonotes.server.MileageFixActionR\x06action\x12\x1a\n" +
+	"\bdistance\x18\x03 \x01(\x05R\bdistance\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\x05R\btargetId\"\xd0\x02\n" +
+	"\x0eMileageAnomaly\x12>\n" +
+	"\amileage\x18\x01 \x01(\v2$.xelbot.com.autonotes.server.MileageR\amileage\x12C\n" +
+	"\x04kind\x18\x02 \x01(\x0e2/.xelbot.com.autonotes.server.MileageAnomalyKindR\x04kind\x12@\n" +
+	"\bprevious\x18\x03 \x01(\v2$.xelbot.com.autonotes.server.MileageR\bprevious\x128\n" +
+	"\x04next\x18\x04 \x01(\v2$.xelbot.com.autonotes.server.MileageR\x04next\x12=\n" +
+	"\x05fixes\x18\x05 \x03(\v2'.xelbot.com.autonotes.server.MileageFixR\x05fixes\"e\n" +
+	"\x18MileageAnomalyCollection\x12I\n" +
+	"\tanomalies\x18\x01 \x03(\v2+.xelbot.com.autonotes.server.MileageAnomalyR\tanomalies\";\n" +
+	"\vSyncRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xbb\x04\n" +
+	"\n" +
+	"SyncChange\x12?\n" +
+	"\x06entity\x18\x01 \x01(\x0e2'.xelbot.com.autonotes.server.SyncEntityR\x06entity\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\bR\adeleted\x127\n" +
+	"\x04fuel\x18\x04 \x01(\v2!.xelbot.com.autonotes.server.FuelH\x00R\x04fuel\x12:\n" +
+	"\x05order\x18\x05 \x01(\v2\".xelbot.com.autonotes.server.OrderH\x00R\x05order\x12@\n" +
+	"\aexpense\x18\x06 \x01(\v2$.xelbot.com.autonotes.server.ExpenseH\x00R\aexpense\x12@\n" +
+	"\aservice\x18\a \x01(\v2$.xelbot.com.autonotes.server.ServiceH\x00R\aservice\x12@\n" +
+	"\amileage\x18\b \x01(\v2$.xelbot.com.autonotes.server.MileageH\x00R\amileage\x124\n" +
+	"\x03car\x18\t \x01(\v2 .xelbot.com.autonotes.server.CarH\x00R\x03car\x12G\n" +
+	"\bsettings\x18\n" +
+	" \x01(\v2).xelbot.com.autonotes.server.UserSettingsH\x00R\bsettingsB\b\n" +
+	"\x06record\"\x80\x01\n" +
+	"\bSyncPage\x12A\n" +
+	"\achanges\x18\x01 \x03(\v2'.xelbot.com.autonotes.server.SyncChangeR\achanges\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x9f\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12?\n" +
+	"\x06entity\x18\x02 \x01(\x0e2'.xelbot.com.autonotes.server.SyncEntityR\x06entity\x12\x1b\n" +
+	"\trecord_id\x18\x03 \x01(\x05R\brecordId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmime_type\x18\x05 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x05R\x04size\x12#\n" +
+	"\rhas_thumbnail\x18\a \x01(\bR\fhasThumbnail\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\x14AttachmentCollection\x12I\n" +
+	"\vattachments\x18\x01 \x03(\v2'.xelbot.com.autonotes.server.AttachmentR\vattachments\"\x98\x01\n" +
+	"\x10AttachmentUpload\x12?\n" +
+	"\x06entity\x18\x01 \x01(\x0e2'.xelbot.com.autonotes.server.SyncEntityR\x06entity\x12\x1b\n" +
+	"\trecord_id\x18\x02 \x01(\x05R\brecordId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"p\n" +
+	"\x10AttachmentFilter\x12?\n" +
+	"\x06entity\x18\x01 \x01(\x0e2'.xelbot.com.autonotes.server.SyncEntityR\x06entity\x12\x1b\n" +
+	"\trecord_id\x18\x02 \x01(\x05R\brecordId\"A\n" +
+	"\x11AttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1c\n" +
+	"\tthumbnail\x18\x02 \x01(\bR\tthumbnail\"\x8d\x01\n" +
+	"\x11AttachmentContent\x12G\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2'.xelbot.com.autonotes.server.AttachmentR\n" +
+	"attachment\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"d\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"E\n" +
+	"\rTagCollection\x124\n" +
+	"\x04tags\x18\x01 \x03(\v2 .xelbot.com.autonotes.server.TagR\x04tags\"\x83\x01\n" +
+	"\n" +
+	"RecordTags\x12?\n" +
+	"\x06entity\x18\x01 \x01(\x0e2'.xelbot.com.autonotes.server.SyncEntityR\x06entity\x12\x1b\n" +
+	"\trecord_id\x18\x02 \x01(\x05R\brecordId\x12\x17\n" +
+	"\atag_ids\x18\x03 \x03(\x05R\x06tagIds\"\xa0\x01\n" +
+	"\x0eTagEntityTotal\x12?\n" +
+	"\x06entity\x18\x01 \x01(\x0e2'.xelbot.com.autonotes.server.SyncEntityR\x06entity\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x127\n" +
+	"\x05costs\x18\x03 \x03(\v2!.xelbot.com.autonotes.server.CostR\x05costs\"\xb0\x02\n" +
+	"\n" +
+	"TagSummary\x122\n" +
+	"\x03tag\x18\x01 \x01(\v2 .xelbot.com.autonotes.server.TagR\x03tag\x12G\n" +
+	"\bentities\x18\x02 \x03(\v2+.xelbot.com.autonotes.server.TagEntityTotalR\bentities\x127\n" +
+	"\x05costs\x18\x03 \x03(\v2!.xelbot.com.autonotes.server.CostR\x05costs\x12J\n" +
+	"\x0fconverted_total\x18\x04 \x01(\v2!.xelbot.com.autonotes.server.CostR\x0econvertedTotal\x12 \n" +
+	"\vunconverted\x18\x05 \x01(\x05R\vunconverted*3\n" +
+	"\fDistanceUnit\x12\x0f\n" +
+	"\vDISTANCE_KM\x10\x00\x12\x12\n" +
+	"\x0eDISTANCE_MILES\x10\x01*S\n" +
+	"\n" +
+	"VolumeUnit\x12\x11\n" +
+	"\rVOLUME_LITRES\x10\x00\x12\x15\n" +
+	"\x11VOLUME_US_GALLONS\x10\x01\x12\x1b\n" +
+	"\x17VOLUME_IMPERIAL_GALLONS\x10\x02*?\n" +
+	"\x0fConsumptionUnit\x12\x17\n" +
+	"\x13CONSUMPTION_PER_100\x10\x00\x12\x13\n" +
+	"\x0fCONSUMPTION_MPG\x10\x01*,\n" +
+	"\rSortDirection\x12\r\n" +
+	"\tSORT_DESC\x10\x00\x12\f\n" +
+	"\bSORT_ASC\x10\x01*<\n" +
+	"\tBatchMode\x12\x18\n" +
+	"\x14BATCH_ALL_OR_NOTHING\x10\x00\x12\x15\n" +
+	"\x11BATCH_BEST_EFFORT\x10\x01*v\n" +
 	"\vExpenseType\x12\t\n" +
 	"\x05EMPTY\x10\x00\x12\n" +
 	"\n" +
@@ -2208,36 +7499,109 @@ const file_server_proto_rawDesc = "" +
 	"\x04ROAD\x10\x05\x12\v\n" +
 	"\aWASHING\x10\x06\x12\v\n" +
 	"\aPARKING\x10\a\x12\t\n" +
-	"\x05OTHER\x10c*\x1f\n" +
+	"\x05OTHER\x10c*D\n" +
+	"\x13RecurrenceFrequency\x12\x16\n" +
+	"\x12RECURRENCE_MONTHLY\x10\x00\x12\x15\n" +
+	"\x11RECURRENCE_YEARLY\x10\x01*p\n" +
+	"\x0eOdometerMethod\x12\x14\n" +
+	"\x10ODOMETER_UNKNOWN\x10\x00\x12\x12\n" +
+	"\x0eODOMETER_EXACT\x10\x01\x12\x19\n" +
+	"\x15ODOMETER_INTERPOLATED\x10\x02\x12\x19\n" +
+	"\x15ODOMETER_EXTRAPOLATED\x10\x03*\x8a\x01\n" +
+	"\x12MileageAnomalyKind\x12\x1b\n" +
+	"\x17MILEAGE_ANOMALY_UNKNOWN\x10\x00\x12\x19\n" +
+	"\x15MILEAGE_NON_MONOTONIC\x10\x01\x12 \n" +
+	"\x1cMILEAGE_IMPLAUSIBLE_DISTANCE\x10\x02\x12\x1a\n" +
+	"\x16MILEAGE_DUPLICATE_DATE\x10\x03*x\n" +
+	"\x10MileageFixAction\x12\x17\n" +
+	"\x13MILEAGE_FIX_UNKNOWN\x10\x00\x12\x1c\n" +
+	"\x18MILEAGE_FIX_SET_DISTANCE\x10\x01\x12\x15\n" +
+	"\x11MILEAGE_FIX_MERGE\x10\x02\x12\x16\n" +
+	"\x12MILEAGE_FIX_DELETE\x10\x03*)\n" +
 	"\tErrorCode\x12\b\n" +
 	"\x04E001\x10\x00\x12\b\n" +
-	"\x04E002\x10\x012\xd5\x03\n" +
+	"\x04E002\x10\x01\x12\b\n" +
+	"\x04E003\x10\x02*\x99\x01\n" +
+	"\n" +
+	"SyncEntity\x12\x10\n" +
+	"\fSYNC_UNKNOWN\x10\x00\x12\r\n" +
+	"\tSYNC_FUEL\x10\x01\x12\x0e\n" +
+	"\n" +
+	"SYNC_ORDER\x10\x02\x12\x10\n" +
+	"\fSYNC_EXPENSE\x10\x03\x12\x10\n" +
+	"\fSYNC_SERVICE\x10\x04\x12\x10\n" +
+	"\fSYNC_MILEAGE\x10\x05\x12\f\n" +
+	"\bSYNC_CAR\x10\x06\x12\x16\n" +
+	"\x12SYNC_USER_SETTINGS\x10\a2\xd3\x05\n" +
 	"\x0eUserRepository\x12M\n" +
 	"\aGetCars\x12\x16.google.protobuf.Empty\x1a*.xelbot.com.autonotes.server.CarCollection\x12X\n" +
 	"\rGetCurrencies\x12\x16.google.protobuf.Empty\x1a/.xelbot.com.autonotes.server.CurrencyCollection\x12Z\n" +
 	"\x12GetDefaultCurrency\x12\x16.google.protobuf.Empty\x1a,.xelbot.com.autonotes.server.DefaultCurrency\x12T\n" +
 	"\x0fGetUserSettings\x12\x16.google.protobuf.Empty\x1a).xelbot.com.autonotes.server.UserSettings\x12h\n" +
-	"\x10SaveUserSettings\x12).xelbot.com.autonotes.server.UserSettings\x1a).xelbot.com.autonotes.server.UserSettings2\xd9\x03\n" +
+	"\x10SaveUserSettings\x12).xelbot.com.autonotes.server.UserSettings\x1a).xelbot.com.autonotes.server.UserSettings\x12}\n" +
+	"\x11SaveExchangeRates\x123.xelbot.com.autonotes.server.ExchangeRateCollection\x1a3.xelbot.com.autonotes.server.ExchangeRateCollection\x12}\n" +
+	"\x13ImportExchangeRates\x12/.xelbot.com.autonotes.server.ExchangeRateImport\x1a5.xelbot.com.autonotes.server.ExchangeRateImportResult2\xb5\b\n" +
 	"\x0eFuelRepository\x12`\n" +
 	"\bGetFuels\x12'.xelbot.com.autonotes.server.FuelFilter\x1a+.xelbot.com.autonotes.server.FuelCollection\x12U\n" +
 	"\bFindFuel\x12&.xelbot.com.autonotes.server.IdRequest\x1a!.xelbot.com.autonotes.server.Fuel\x12c\n" +
 	"\x12GetFillingStations\x12\x16.google.protobuf.Empty\x1a5.xelbot.com.autonotes.server.FillingStationCollection\x12W\n" +
 	"\fGetFuelTypes\x12\x16.google.protobuf.Empty\x1a/.xelbot.com.autonotes.server.FuelTypeCollection\x12P\n" +
-	"\bSaveFuel\x12!.xelbot.com.autonotes.server.Fuel\x1a!.xelbot.com.autonotes.server.Fuel2\xa2\x05\n" +
+	"\bSaveFuel\x12!.xelbot.com.autonotes.server.Fuel\x1a!.xelbot.com.autonotes.server.Fuel\x12f\n" +
+	"\x0eBatchSaveFuels\x12&.xelbot.com.autonotes.server.FuelBatch\x1a,.xelbot.com.autonotes.server.FuelBatchResult\x12o\n" +
+	"\rGetFuelPrices\x12,.xelbot.com.autonotes.server.FuelPriceFilter\x1a0.xelbot.com.autonotes.server.FuelPriceCollection\x12^\n" +
+	"\x0eCreateFuelType\x12%.xelbot.com.autonotes.server.FuelType\x1a%.xelbot.com.autonotes.server.FuelType\x12^\n" +
+	"\x0eRenameFuelType\x12%.xelbot.com.autonotes.server.FuelType\x1a%.xelbot.com.autonotes.server.FuelType\x12\\\n" +
+	"\fMoveFuelType\x12%.xelbot.com.autonotes.server.FuelType\x1a%.xelbot.com.autonotes.server.FuelType\x12c\n" +
+	"\x0eMergeFuelTypes\x12*.xelbot.com.autonotes.server.FuelTypeMerge\x1a%.xelbot.com.autonotes.server.FuelType2\xe9\r\n" +
 	"\x0fOrderRepository\x12c\n" +
 	"\tGetOrders\x12(.xelbot.com.autonotes.server.OrderFilter\x1a,.xelbot.com.autonotes.server.OrderCollection\x12W\n" +
 	"\tFindOrder\x12&.xelbot.com.autonotes.server.IdRequest\x1a\".xelbot.com.autonotes.server.Order\x12Y\n" +
 	"\rGetOrderTypes\x12\x16.google.protobuf.Empty\x1a0.xelbot.com.autonotes.server.OrderTypeCollection\x12S\n" +
 	"\tSaveOrder\x12\".xelbot.com.autonotes.server.Order\x1a\".xelbot.com.autonotes.server.Order\x12i\n" +
+	"\x0fBatchSaveOrders\x12'.xelbot.com.autonotes.server.OrderBatch\x1a-.xelbot.com.autonotes.server.OrderBatchResult\x12s\n" +
+	"\x11GetOrderInventory\x121.xelbot.com.autonotes.server.OrderInventoryFilter\x1a+.xelbot.com.autonotes.server.OrderInventory\x12\\\n" +
+	"\rMarkOrderUsed\x12'.xelbot.com.autonotes.server.OrderUsage\x1a\".xelbot.com.autonotes.server.Order\x12i\n" +
 	"\vGetExpenses\x12*.xelbot.com.autonotes.server.ExpenseFilter\x1a..xelbot.com.autonotes.server.ExpenseCollection\x12[\n" +
 	"\vFindExpense\x12&.xelbot.com.autonotes.server.IdRequest\x1a$.xelbot.com.autonotes.server.Expense\x12Y\n" +
-	"\vSaveExpense\x12$.xelbot.com.autonotes.server.Expense\x1a$.xelbot.com.autonotes.server.Expense2\xf8\x03\n" +
+	"\vSaveExpense\x12$.xelbot.com.autonotes.server.Expense\x1a$.xelbot.com.autonotes.server.Expense\x12o\n" +
+	"\x11BatchSaveExpenses\x12).xelbot.com.autonotes.server.ExpenseBatch\x1a/.xelbot.com.autonotes.server.ExpenseBatchResult\x12f\n" +
+	"\x14GetExpenseCategories\x12\x16.google.protobuf.Empty\x1a6.xelbot.com.autonotes.server.ExpenseCategoryCollection\x12q\n" +
+	"\x13SaveExpenseCategory\x12,.xelbot.com.autonotes.server.ExpenseCategory\x1a,.xelbot.com.autonotes.server.ExpenseCategory\x12g\n" +
+	"\x14GetRecurringExpenses\x12\x16.google.protobuf.Empty\x1a7.xelbot.com.autonotes.server.RecurringExpenseCollection\x12t\n" +
+	"\x14SaveRecurringExpense\x12-.xelbot.com.autonotes.server.RecurringExpense\x1a-.xelbot.com.autonotes.server.RecurringExpense\x12X\n" +
+	"\x16DeleteRecurringExpense\x12&.xelbot.com.autonotes.server.IdRequest\x1a\x16.google.protobuf.Empty\x12\x81\x01\n" +
+	"\x13GetUpcomingExpenses\x122.xelbot.com.autonotes.server.UpcomingExpenseFilter\x1a6.xelbot.com.autonotes.server.UpcomingExpenseCollection2\x8f\n" +
+	"\n" +
 	"\rCarRepository\x12i\n" +
 	"\vGetServices\x12*.xelbot.com.autonotes.server.ServiceFilter\x1a..xelbot.com.autonotes.server.ServiceCollection\x12[\n" +
 	"\vFindService\x12&.xelbot.com.autonotes.server.IdRequest\x1a$.xelbot.com.autonotes.server.Service\x12Y\n" +
 	"\vSaveService\x12$.xelbot.com.autonotes.server.Service\x1a$.xelbot.com.autonotes.server.Service\x12i\n" +
 	"\vGetMileages\x12*.xelbot.com.autonotes.server.MileageFilter\x1a..xelbot.com.autonotes.server.MileageCollection\x12Y\n" +
-	"\vSaveMileage\x12$.xelbot.com.autonotes.server.Mileage\x1a$.xelbot.com.autonotes.server.MileageBTZ'xelbot.com/auto-notes/server/rpc/server\xca\x02\x10AutoNotes\\Server\xe2\x02\x15AutoNotes\\Server\\Metab\x06proto3"
+	"\vSaveMileage\x12$.xelbot.com.autonotes.server.Mileage\x1a$.xelbot.com.autonotes.server.Mileage\x12`\n" +
+	"\bGetTrips\x12'.xelbot.com.autonotes.server.TripFilter\x1a+.xelbot.com.autonotes.server.TripCollection\x12V\n" +
+	"\tStartTrip\x12&.xelbot.com.autonotes.server.TripStart\x1a!.xelbot.com.autonotes.server.Trip\x12V\n" +
+	"\tCloseTrip\x12&.xelbot.com.autonotes.server.TripClose\x1a!.xelbot.com.autonotes.server.Trip\x12L\n" +
+	"\n" +
+	"DeleteTrip\x12&.xelbot.com.autonotes.server.IdRequest\x1a\x16.google.protobuf.Empty\x12`\n" +
+	"\rGetTripReport\x12&.xelbot.com.autonotes.server.IdRequest\x1a'.xelbot.com.autonotes.server.TripReport\x12o\n" +
+	"\x10EstimateOdometer\x12,.xelbot.com.autonotes.server.OdometerRequest\x1a-.xelbot.com.autonotes.server.OdometerEstimate\x12t\n" +
+	"\x13GetMileageAnomalies\x12&.xelbot.com.autonotes.server.IdRequest\x1a5.xelbot.com.autonotes.server.MileageAnomalyCollection\x12l\n" +
+	"\n" +
+	"FixMileage\x12'.xelbot.com.autonotes.server.MileageFix\x1a5.xelbot.com.autonotes.server.MileageAnomalyCollection2o\n" +
+	"\x0eSyncRepository\x12]\n" +
+	"\n" +
+	"GetChanges\x12(.xelbot.com.autonotes.server.SyncRequest\x1a%.xelbot.com.autonotes.server.SyncPage2\xc0\x03\n" +
+	"\x14AttachmentRepository\x12r\n" +
+	"\x0eGetAttachments\x12-.xelbot.com.autonotes.server.AttachmentFilter\x1a1.xelbot.com.autonotes.server.AttachmentCollection\x12j\n" +
+	"\x10UploadAttachment\x12-.xelbot.com.autonotes.server.AttachmentUpload\x1a'.xelbot.com.autonotes.server.Attachment\x12t\n" +
+	"\x12DownloadAttachment\x12..xelbot.com.autonotes.server.AttachmentRequest\x1a..xelbot.com.autonotes.server.AttachmentContent\x12R\n" +
+	"\x10DeleteAttachment\x12&.xelbot.com.autonotes.server.IdRequest\x1a\x16.google.protobuf.Empty2\xc2\x03\n" +
+	"\rTagRepository\x12M\n" +
+	"\aGetTags\x12\x16.google.protobuf.Empty\x1a*.xelbot.com.autonotes.server.TagCollection\x12M\n" +
+	"\aSaveTag\x12 .xelbot.com.autonotes.server.Tag\x1a .xelbot.com.autonotes.server.Tag\x12K\n" +
+	"\tDeleteTag\x12&.xelbot.com.autonotes.server.IdRequest\x1a\x16.google.protobuf.Empty\x12d\n" +
+	"\rSetRecordTags\x12'.xelbot.com.autonotes.server.RecordTags\x1a*.xelbot.com.autonotes.server.TagCollection\x12`\n" +
+	"\rGetTagSummary\x12&.xelbot.com.autonotes.server.IdRequest\x1a'.xelbot.com.autonotes.server.TagSummaryBTZ'xelbot.com/auto-notes/server/rpc/server\xca\x02\x10AutoNotes\\Server\xe2\x02\x15AutoNotes\\Server\\Metab\x06proto3"
 
 var (
 	file_server_proto_rawDescOnce sync.Once
@@ -2251,143 +7615,416 @@ func file_server_proto_rawDescGZIP() []byte {
 	return file_server_proto_rawDescData
 }
 
-var file_server_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_server_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_server_proto_goTypes = []any{
-	(ExpenseType)(0),                 // 0: xelbot.com.autonotes.server.ExpenseType
-	(ErrorCode)(0),                   // 1: xelbot.com.autonotes.server.ErrorCode
-	(*Cost)(nil),                     // 2: xelbot.com.autonotes.server.Cost
-	(*Car)(nil),                      // 3: xelbot.com.autonotes.server.Car
-	(*CarCollection)(nil),            // 4: xelbot.com.autonotes.server.CarCollection
-	(*FillingStation)(nil),           // 5: xelbot.com.autonotes.server.FillingStation
-	(*FillingStationCollection)(nil), // 6: xelbot.com.autonotes.server.FillingStationCollection
-	(*FuelType)(nil),                 // 7: xelbot.com.autonotes.server.FuelType
-	(*FuelTypeCollection)(nil),       // 8: xelbot.com.autonotes.server.FuelTypeCollection
-	(*Fuel)(nil),                     // 9: xelbot.com.autonotes.server.Fuel
-	(*FuelCollection)(nil),           // 10: xelbot.com.autonotes.server.FuelCollection
-	(*Currency)(nil),                 // 11: xelbot.com.autonotes.server.Currency
-	(*DefaultCurrency)(nil),          // 12: xelbot.com.autonotes.server.DefaultCurrency
-	(*CurrencyCollection)(nil),       // 13: xelbot.com.autonotes.server.CurrencyCollection
-	(*PaginationMeta)(nil),           // 14: xelbot.com.autonotes.server.PaginationMeta
-	(*UserSettings)(nil),             // 15: xelbot.com.autonotes.server.UserSettings
-	(*FuelFilter)(nil),               // 16: xelbot.com.autonotes.server.FuelFilter
-	(*IdRequest)(nil),                // 17: xelbot.com.autonotes.server.IdRequest
-	(*OrderType)(nil),                // 18: xelbot.com.autonotes.server.OrderType
-	(*OrderTypeCollection)(nil),      // 19: xelbot.com.autonotes.server.OrderTypeCollection
-	(*Order)(nil),                    // 20: xelbot.com.autonotes.server.Order
-	(*OrderCollection)(nil),          // 21: xelbot.com.autonotes.server.OrderCollection
-	(*Expense)(nil),                  // 22: xelbot.com.autonotes.server.Expense
-	(*ExpenseCollection)(nil),        // 23: xelbot.com.autonotes.server.ExpenseCollection
-	(*OrderFilter)(nil),              // 24: xelbot.com.autonotes.server.OrderFilter
-	(*ExpenseFilter)(nil),            // 25: xelbot.com.autonotes.server.ExpenseFilter
-	(*MileageFilter)(nil),            // 26: xelbot.com.autonotes.server.MileageFilter
-	(*Mileage)(nil),                  // 27: xelbot.com.autonotes.server.Mileage
-	(*MileageCollection)(nil),        // 28: xelbot.com.autonotes.server.MileageCollection
-	(*Service)(nil),                  // 29: xelbot.com.autonotes.server.Service
-	(*ServiceCollection)(nil),        // 30: xelbot.com.autonotes.server.ServiceCollection
-	(*ServiceFilter)(nil),            // 31: xelbot.com.autonotes.server.ServiceFilter
-	(*timestamppb.Timestamp)(nil),    // 32: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 33: google.protobuf.Empty
+	(DistanceUnit)(0),                  // 0: xelbot.com.autonotes.server.DistanceUnit
+	(VolumeUnit)(0),                    // 1: xelbot.com.autonotes.server.VolumeUnit
+	(ConsumptionUnit)(0),               // 2: xelbot.com.autonotes.server.ConsumptionUnit
+	(SortDirection)(0),                 // 3: xelbot.com.autonotes.server.SortDirection
+	(BatchMode)(0),                     // 4: xelbot.com.autonotes.server.BatchMode
+	(ExpenseType)(0),                   // 5: xelbot.com.autonotes.server.ExpenseType
+	(RecurrenceFrequency)(0),           // 6: xelbot.com.autonotes.server.RecurrenceFrequency
+	(OdometerMethod)(0),                // 7: xelbot.com.autonotes.server.OdometerMethod
+	(MileageAnomalyKind)(0),            // 8: xelbot.com.autonotes.server.MileageAnomalyKind
+	(MileageFixAction)(0),              // 9: xelbot.com.autonotes.server.MileageFixAction
+	(ErrorCode)(0),                     // 10: xelbot.com.autonotes.server.ErrorCode
+	(SyncEntity)(0),                    // 11: xelbot.com.autonotes.server.SyncEntity
+	(*Cost)(nil),                       // 12: xelbot.com.autonotes.server.Cost
+	(*Car)(nil),                        // 13: xelbot.com.autonotes.server.Car
+	(*CarCollection)(nil),              // 14: xelbot.com.autonotes.server.CarCollection
+	(*FillingStation)(nil),             // 15: xelbot.com.autonotes.server.FillingStation
+	(*FillingStationCollection)(nil),   // 16: xelbot.com.autonotes.server.FillingStationCollection
+	(*FuelType)(nil),                   // 17: xelbot.com.autonotes.server.FuelType
+	(*FuelTypeCollection)(nil),         // 18: xelbot.com.autonotes.server.FuelTypeCollection
+	(*FuelTypeMerge)(nil),              // 19: xelbot.com.autonotes.server.FuelTypeMerge
+	(*Fuel)(nil),                       // 20: xelbot.com.autonotes.server.Fuel
+	(*FuelCollection)(nil),             // 21: xelbot.com.autonotes.server.FuelCollection
+	(*Currency)(nil),                   // 22: xelbot.com.autonotes.server.Currency
+	(*DefaultCurrency)(nil),            // 23: xelbot.com.autonotes.server.DefaultCurrency
+	(*CurrencyCollection)(nil),         // 24: xelbot.com.autonotes.server.CurrencyCollection
+	(*PaginationMeta)(nil),             // 25: xelbot.com.autonotes.server.PaginationMeta
+	(*Units)(nil),                      // 26: xelbot.com.autonotes.server.Units
+	(*UserSettings)(nil),               // 27: xelbot.com.autonotes.server.UserSettings
+	(*ExchangeRate)(nil),               // 28: xelbot.com.autonotes.server.ExchangeRate
+	(*ExchangeRateCollection)(nil),     // 29: xelbot.com.autonotes.server.ExchangeRateCollection
+	(*ExchangeRateImport)(nil),         // 30: xelbot.com.autonotes.server.ExchangeRateImport
+	(*ExchangeRateImportResult)(nil),   // 31: xelbot.com.autonotes.server.ExchangeRateImportResult
+	(*FuelFilter)(nil),                 // 32: xelbot.com.autonotes.server.FuelFilter
+	(*BatchError)(nil),                 // 33: xelbot.com.autonotes.server.BatchError
+	(*IdRequest)(nil),                  // 34: xelbot.com.autonotes.server.IdRequest
+	(*FuelBatch)(nil),                  // 35: xelbot.com.autonotes.server.FuelBatch
+	(*FuelBatchItem)(nil),              // 36: xelbot.com.autonotes.server.FuelBatchItem
+	(*FuelBatchResult)(nil),            // 37: xelbot.com.autonotes.server.FuelBatchResult
+	(*FuelPriceFilter)(nil),            // 38: xelbot.com.autonotes.server.FuelPriceFilter
+	(*FuelPricePoint)(nil),             // 39: xelbot.com.autonotes.server.FuelPricePoint
+	(*FuelPriceHistory)(nil),           // 40: xelbot.com.autonotes.server.FuelPriceHistory
+	(*FuelPriceCollection)(nil),        // 41: xelbot.com.autonotes.server.FuelPriceCollection
+	(*OrderType)(nil),                  // 42: xelbot.com.autonotes.server.OrderType
+	(*OrderTypeCollection)(nil),        // 43: xelbot.com.autonotes.server.OrderTypeCollection
+	(*Order)(nil),                      // 44: xelbot.com.autonotes.server.Order
+	(*OrderCollection)(nil),            // 45: xelbot.com.autonotes.server.OrderCollection
+	(*ExpenseCategory)(nil),            // 46: xelbot.com.autonotes.server.ExpenseCategory
+	(*ExpenseCategoryCollection)(nil),  // 47: xelbot.com.autonotes.server.ExpenseCategoryCollection
+	(*Expense)(nil),                    // 48: xelbot.com.autonotes.server.Expense
+	(*RecurringExpense)(nil),           // 49: xelbot.com.autonotes.server.RecurringExpense
+	(*RecurringExpenseCollection)(nil), // 50: xelbot.com.autonotes.server.RecurringExpenseCollection
+	(*UpcomingExpenseFilter)(nil),      // 51: xelbot.com.autonotes.server.UpcomingExpenseFilter
+	(*UpcomingExpense)(nil),            // 52: xelbot.com.autonotes.server.UpcomingExpense
+	(*UpcomingExpenseCollection)(nil),  // 53: xelbot.com.autonotes.server.UpcomingExpenseCollection
+	(*ExpenseCollection)(nil),          // 54: xelbot.com.autonotes.server.ExpenseCollection
+	(*OrderFilter)(nil),                // 55: xelbot.com.autonotes.server.OrderFilter
+	(*ExpenseFilter)(nil),              // 56: xelbot.com.autonotes.server.ExpenseFilter
+	(*OrderInventoryFilter)(nil),       // 57: xelbot.com.autonotes.server.OrderInventoryFilter
+	(*OrderInventoryGroup)(nil),        // 58: xelbot.com.autonotes.server.OrderInventoryGroup
+	(*OrderServiceLife)(nil),           // 59: xelbot.com.autonotes.server.OrderServiceLife
+	(*OrderInventory)(nil),             // 60: xelbot.com.autonotes.server.OrderInventory
+	(*OrderUsage)(nil),                 // 61: xelbot.com.autonotes.server.OrderUsage
+	(*OrderBatch)(nil),                 // 62: xelbot.com.autonotes.server.OrderBatch
+	(*OrderBatchItem)(nil),             // 63: xelbot.com.autonotes.server.OrderBatchItem
+	(*OrderBatchResult)(nil),           // 64: xelbot.com.autonotes.server.OrderBatchResult
+	(*ExpenseBatch)(nil),               // 65: xelbot.com.autonotes.server.ExpenseBatch
+	(*ExpenseBatchItem)(nil),           // 66: xelbot.com.autonotes.server.ExpenseBatchItem
+	(*ExpenseBatchResult)(nil),         // 67: xelbot.com.autonotes.server.ExpenseBatchResult
+	(*MileageFilter)(nil),              // 68: xelbot.com.autonotes.server.MileageFilter
+	(*Mileage)(nil),                    // 69: xelbot.com.autonotes.server.Mileage
+	(*MileageCollection)(nil),          // 70: xelbot.com.autonotes.server.MileageCollection
+	(*Service)(nil),                    // 71: xelbot.com.autonotes.server.Service
+	(*ServiceCollection)(nil),          // 72: xelbot.com.autonotes.server.ServiceCollection
+	(*ServiceFilter)(nil),              // 73: xelbot.com.autonotes.server.ServiceFilter
+	(*Trip)(nil),                       // 74: xelbot.com.autonotes.server.Trip
+	(*TripCollection)(nil),             // 75: xelbot.com.autonotes.server.TripCollection
+	(*TripFilter)(nil),                 // 76: xelbot.com.autonotes.server.TripFilter
+	(*TripStart)(nil),                  // 77: xelbot.com.autonotes.server.TripStart
+	(*TripClose)(nil),                  // 78: xelbot.com.autonotes.server.TripClose
+	(*TripReport)(nil),                 // 79: xelbot.com.autonotes.server.TripReport
+	(*OdometerRequest)(nil),            // 80: xelbot.com.autonotes.server.OdometerRequest
+	(*OdometerEstimate)(nil),           // 81: xelbot.com.autonotes.server.OdometerEstimate
+	(*MileageFix)(nil),                 // 82: xelbot.com.autonotes.server.MileageFix
+	(*MileageAnomaly)(nil),             // 83: xelbot.com.autonotes.server.MileageAnomaly
+	(*MileageAnomalyCollection)(nil),   // 84: xelbot.com.autonotes.server.MileageAnomalyCollection
+	(*SyncRequest)(nil),                // 85: xelbot.com.autonotes.server.SyncRequest
+	(*SyncChange)(nil),                 // 86: xelbot.com.autonotes.server.SyncChange
+	(*SyncPage)(nil),                   // 87: xelbot.com.autonotes.server.SyncPage
+	(*Attachment)(nil),                 // 88: xelbot.com.autonotes.server.Attachment
+	(*AttachmentCollection)(nil),       // 89: xelbot.com.autonotes.server.AttachmentCollection
+	(*AttachmentUpload)(nil),           // 90: xelbot.com.autonotes.server.AttachmentUpload
+	(*AttachmentFilter)(nil),           // 91: xelbot.com.autonotes.server.AttachmentFilter
+	(*AttachmentRequest)(nil),          // 92: xelbot.com.autonotes.server.AttachmentRequest
+	(*AttachmentContent)(nil),          // 93: xelbot.com.autonotes.server.AttachmentContent
+	(*Tag)(nil),                        // 94: xelbot.com.autonotes.server.Tag
+	(*TagCollection)(nil),              // 95: xelbot.com.autonotes.server.TagCollection
+	(*RecordTags)(nil),                 // 96: xelbot.com.autonotes.server.RecordTags
+	(*TagEntityTotal)(nil),             // 97: xelbot.com.autonotes.server.TagEntityTotal
+	(*TagSummary)(nil),                 // 98: xelbot.com.autonotes.server.TagSummary
+	nil,                                // 99: xelbot.com.autonotes.server.BatchError.MetaEntry
+	(*timestamppb.Timestamp)(nil),      // 100: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 101: google.protobuf.Empty
 }
 var file_server_proto_depIdxs = []int32{
-	32, // 0: xelbot.com.autonotes.server.Car.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 1: xelbot.com.autonotes.server.CarCollection.cars:type_name -> xelbot.com.autonotes.server.Car
-	32, // 2: xelbot.com.autonotes.server.FillingStation.created_at:type_name -> google.protobuf.Timestamp
-	5,  // 3: xelbot.com.autonotes.server.FillingStationCollection.stations:type_name -> xelbot.com.autonotes.server.FillingStation
-	7,  // 4: xelbot.com.autonotes.server.FuelTypeCollection.types:type_name -> xelbot.com.autonotes.server.FuelType
-	2,  // 5: xelbot.com.autonotes.server.Fuel.cost:type_name -> xelbot.com.autonotes.server.Cost
-	5,  // 6: xelbot.com.autonotes.server.Fuel.station:type_name -> xelbot.com.autonotes.server.FillingStation
-	32, // 7: xelbot.com.autonotes.server.Fuel.date:type_name -> google.protobuf.Timestamp
-	3,  // 8: xelbot.com.autonotes.server.Fuel.car:type_name -> xelbot.com.autonotes.server.Car
-	32, // 9: xelbot.com.autonotes.server.Fuel.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 10: xelbot.com.autonotes.server.Fuel.type:type_name -> xelbot.com.autonotes.server.FuelType
-	9,  // 11: xelbot.com.autonotes.server.FuelCollection.fuels:type_name -> xelbot.com.autonotes.server.Fuel
-	14, // 12: xelbot.com.autonotes.server.FuelCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
-	32, // 13: xelbot.com.autonotes.server.Currency.created_at:type_name -> google.protobuf.Timestamp
-	11, // 14: xelbot.com.autonotes.server.DefaultCurrency.currency:type_name -> xelbot.com.autonotes.server.Currency
-	11, // 15: xelbot.com.autonotes.server.CurrencyCollection.currencies:type_name -> xelbot.com.autonotes.server.Currency
-	3,  // 16: xelbot.com.autonotes.server.UserSettings.default_car:type_name -> xelbot.com.autonotes.server.Car
-	11, // 17: xelbot.com.autonotes.server.UserSettings.default_currency:type_name -> xelbot.com.autonotes.server.Currency
-	32, // 18: xelbot.com.autonotes.server.UserSettings.created_at:type_name -> google.protobuf.Timestamp
-	32, // 19: xelbot.com.autonotes.server.UserSettings.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 20: xelbot.com.autonotes.server.UserSettings.default_fuel_type:type_name -> xelbot.com.autonotes.server.FuelType
-	18, // 21: xelbot.com.autonotes.server.OrderTypeCollection.types:type_name -> xelbot.com.autonotes.server.OrderType
-	2,  // 22: xelbot.com.autonotes.server.Order.cost:type_name -> xelbot.com.autonotes.server.Cost
-	32, // 23: xelbot.com.autonotes.server.Order.date:type_name -> google.protobuf.Timestamp
-	32, // 24: xelbot.com.autonotes.server.Order.used_at:type_name -> google.protobuf.Timestamp
-	3,  // 25: xelbot.com.autonotes.server.Order.car:type_name -> xelbot.com.autonotes.server.Car
-	18, // 26: xelbot.com.autonotes.server.Order.type:type_name -> xelbot.com.autonotes.server.OrderType
-	32, // 27: xelbot.com.autonotes.server.Order.created_at:type_name -> google.protobuf.Timestamp
-	20, // 28: xelbot.com.autonotes.server.OrderCollection.orders:type_name -> xelbot.com.autonotes.server.Order
-	14, // 29: xelbot.com.autonotes.server.OrderCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
-	2,  // 30: xelbot.com.autonotes.server.Expense.cost:type_name -> xelbot.com.autonotes.server.Cost
-	32, // 31: xelbot.com.autonotes.server.Expense.date:type_name -> google.protobuf.Timestamp
-	3,  // 32: xelbot.com.autonotes.server.Expense.car:type_name -> xelbot.com.autonotes.server.Car
-	0,  // 33: xelbot.com.autonotes.server.Expense.type:type_name -> xelbot.com.autonotes.server.ExpenseType
-	32, // 34: xelbot.com.autonotes.server.Expense.created_at:type_name -> google.protobuf.Timestamp
-	22, // 35: xelbot.com.autonotes.server.ExpenseCollection.expenses:type_name -> xelbot.com.autonotes.server.Expense
-	14, // 36: xelbot.com.autonotes.server.ExpenseCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
-	0,  // 37: xelbot.com.autonotes.server.ExpenseFilter.type:type_name -> xelbot.com.autonotes.server.ExpenseType
-	32, // 38: xelbot.com.autonotes.server.Mileage.date:type_name -> google.protobuf.Timestamp
-	3,  // 39: xelbot.com.autonotes.server.Mileage.car:type_name -> xelbot.com.autonotes.server.Car
-	32, // 40: xelbot.com.autonotes.server.Mileage.created_at:type_name -> google.protobuf.Timestamp
-	27, // 41: xelbot.com.autonotes.server.MileageCollection.mileages:type_name -> xelbot.com.autonotes.server.Mileage
-	14, // 42: xelbot.com.autonotes.server.MileageCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
-	2,  // 43: xelbot.com.autonotes.server.Service.cost:type_name -> xelbot.com.autonotes.server.Cost
-	32, // 44: xelbot.com.autonotes.server.Service.date:type_name -> google.protobuf.Timestamp
-	3,  // 45: xelbot.com.autonotes.server.Service.car:type_name -> xelbot.com.autonotes.server.Car
-	32, // 46: xelbot.com.autonotes.server.Service.created_at:type_name -> google.protobuf.Timestamp
-	29, // 47: xelbot.com.autonotes.server.ServiceCollection.services:type_name -> xelbot.com.autonotes.server.Service
-	14, // 48: xelbot.com.autonotes.server.ServiceCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
-	33, // 49: xelbot.com.autonotes.server.UserRepository.GetCars:input_type -> google.protobuf.Empty
-	33, // 50: xelbot.com.autonotes.server.UserRepository.GetCurrencies:input_type -> google.protobuf.Empty
-	33, // 51: xelbot.com.autonotes.server.UserRepository.GetDefaultCurrency:input_type -> google.protobuf.Empty
-	33, // 52: xelbot.com.autonotes.server.UserRepository.GetUserSettings:input_type -> google.protobuf.Empty
-	15, // 53: xelbot.com.autonotes.server.UserRepository.SaveUserSettings:input_type -> xelbot.com.autonotes.server.UserSettings
-	16, // 54: xelbot.com.autonotes.server.FuelRepository.GetFuels:input_type -> xelbot.com.autonotes.server.FuelFilter
-	17, // 55: xelbot.com.autonotes.server.FuelRepository.FindFuel:input_type -> xelbot.com.autonotes.server.IdRequest
-	33, // 56: xelbot.com.autonotes.server.FuelRepository.GetFillingStations:input_type -> google.protobuf.Empty
-	33, // 57: xelbot.com.autonotes.server.FuelRepository.GetFuelTypes:input_type -> google.protobuf.Empty
-	9,  // 58: xelbot.com.autonotes.server.FuelRepository.SaveFuel:input_type -> xelbot.com.autonotes.server.Fuel
-	24, // 59: xelbot.com.autonotes.server.OrderRepository.GetOrders:input_type -> xelbot.com.autonotes.server.OrderFilter
-	17, // 60: xelbot.com.autonotes.server.OrderRepository.FindOrder:input_type -> xelbot.com.autonotes.server.IdRequest
-	33, // 61: xelbot.com.autonotes.server.OrderRepository.GetOrderTypes:input_type -> google.protobuf.Empty
-	20, // 62: xelbot.com.autonotes.server.OrderRepository.SaveOrder:input_type -> xelbot.com.autonotes.server.Order
-	25, // 63: xelbot.com.autonotes.server.OrderRepository.GetExpenses:input_type -> xelbot.com.autonotes.server.ExpenseFilter
-	17, // 64: xelbot.com.autonotes.server.OrderRepository.FindExpense:input_type -> xelbot.com.autonotes.server.IdRequest
-	22, // 65: xelbot.com.autonotes.server.OrderRepository.SaveExpense:input_type -> xelbot.com.autonotes.server.Expense
-	31, // 66: xelbot.com.autonotes.server.CarRepository.GetServices:input_type -> xelbot.com.autonotes.server.ServiceFilter
-	17, // 67: xelbot.com.autonotes.server.CarRepository.FindService:input_type -> xelbot.com.autonotes.server.IdRequest
-	29, // 68: xelbot.com.autonotes.server.CarRepository.SaveService:input_type -> xelbot.com.autonotes.server.Service
-	26, // 69: xelbot.com.autonotes.server.CarRepository.GetMileages:input_type -> xelbot.com.autonotes.server.MileageFilter
-	27, // 70: xelbot.com.autonotes.server.CarRepository.SaveMileage:input_type -> xelbot.com.autonotes.server.Mileage
-	4,  // 71: xelbot.com.autonotes.server.UserRepository.GetCars:output_type -> xelbot.com.autonotes.server.CarCollection
-	13, // 72: xelbot.com.autonotes.server.UserRepository.GetCurrencies:output_type -> xelbot.com.autonotes.server.CurrencyCollection
-	12, // 73: xelbot.com.autonotes.server.UserRepository.GetDefaultCurrency:output_type -> xelbot.com.autonotes.server.DefaultCurrency
-	15, // 74: xelbot.com.autonotes.server.UserRepository.GetUserSettings:output_type -> xelbot.com.autonotes.server.UserSettings
-	15, // 75: xelbot.com.autonotes.server.UserRepository.SaveUserSettings:output_type -> xelbot.com.autonotes.server.UserSettings
-	10, // 76: xelbot.com.autonotes.server.FuelRepository.GetFuels:output_type -> xelbot.com.autonotes.server.FuelCollection
-	9,  // 77: xelbot.com.autonotes.server.FuelRepository.FindFuel:output_type -> xelbot.com.autonotes.server.Fuel
-	6,  // 78: xelbot.com.autonotes.server.FuelRepository.GetFillingStations:output_type -> xelbot.com.autonotes.server.FillingStationCollection
-	8,  // 79: xelbot.com.autonotes.server.FuelRepository.GetFuelTypes:output_type -> xelbot.com.autonotes.server.FuelTypeCollection
-	9,  // 80: xelbot.com.autonotes.server.FuelRepository.SaveFuel:output_type -> xelbot.com.autonotes.server.Fuel
-	21, // 81: xelbot.com.autonotes.server.OrderRepository.GetOrders:output_type -> xelbot.com.autonotes.server.OrderCollection
-	20, // 82: xelbot.com.autonotes.server.OrderRepository.FindOrder:output_type -> xelbot.com.autonotes.server.Order
-	19, // 83: xelbot.com.autonotes.server.OrderRepository.GetOrderTypes:output_type -> xelbot.com.autonotes.server.OrderTypeCollection
-	20, // 84: xelbot.com.autonotes.server.OrderRepository.SaveOrder:output_type -> xelbot.com.autonotes.server.Order
-	23, // 85: xelbot.com.autonotes.server.OrderRepository.GetExpenses:output_type -> xelbot.com.autonotes.server.ExpenseCollection
-	22, // 86: xelbot.com.autonotes.server.OrderRepository.FindExpense:output_type -> xelbot.com.autonotes.server.Expense
-	22, // 87: xelbot.com.autonotes.server.OrderRepository.SaveExpense:output_type -> xelbot.com.autonotes.server.Expense
-	30, // 88: xelbot.com.autonotes.server.CarRepository.GetServices:output_type -> xelbot.com.autonotes.server.ServiceCollection
-	29, // 89: xelbot.com.autonotes.server.CarRepository.FindService:output_type -> xelbot.com.autonotes.server.Service
-	29, // 90: xelbot.com.autonotes.server.CarRepository.SaveService:output_type -> xelbot.com.autonotes.server.Service
-	28, // 91: xelbot.com.autonotes.server.CarRepository.GetMileages:output_type -> xelbot.com.autonotes.server.MileageCollection
-	27, // 92: xelbot.com.autonotes.server.CarRepository.SaveMileage:output_type -> xelbot.com.autonotes.server.Mileage
-	71, // [71:93] is the sub-list for method output_type
-	49, // [49:71] is the sub-list for method input_type
-	49, // [49:49] is the sub-list for extension type_name
-	49, // [49:49] is the sub-list for extension extendee
-	0,  // [0:49] is the sub-list for field type_name
+	100, // 0: xelbot.com.autonotes.server.Car.created_at:type_name -> google.protobuf.Timestamp
+	13,  // 1: xelbot.com.autonotes.server.CarCollection.cars:type_name -> xelbot.com.autonotes.server.Car
+	100, // 2: xelbot.com.autonotes.server.FillingStation.created_at:type_name -> google.protobuf.Timestamp
+	15,  // 3: xelbot.com.autonotes.server.FillingStationCollection.stations:type_name -> xelbot.com.autonotes.server.FillingStation
+	17,  // 4: xelbot.com.autonotes.server.FuelType.children:type_name -> xelbot.com.autonotes.server.FuelType
+	17,  // 5: xelbot.com.autonotes.server.FuelTypeCollection.types:type_name -> xelbot.com.autonotes.server.FuelType
+	17,  // 6: xelbot.com.autonotes.server.FuelTypeCollection.tree:type_name -> xelbot.com.autonotes.server.FuelType
+	12,  // 7: xelbot.com.autonotes.server.Fuel.cost:type_name -> xelbot.com.autonotes.server.Cost
+	15,  // 8: xelbot.com.autonotes.server.Fuel.station:type_name -> xelbot.com.autonotes.server.FillingStation
+	100, // 9: xelbot.com.autonotes.server.Fuel.date:type_name -> google.protobuf.Timestamp
+	13,  // 10: xelbot.com.autonotes.server.Fuel.car:type_name -> xelbot.com.autonotes.server.Car
+	100, // 11: xelbot.com.autonotes.server.Fuel.created_at:type_name -> google.protobuf.Timestamp
+	17,  // 12: xelbot.com.autonotes.server.Fuel.type:type_name -> xelbot.com.autonotes.server.FuelType
+	12,  // 13: xelbot.com.autonotes.server.Fuel.converted_cost:type_name -> xelbot.com.autonotes.server.Cost
+	94,  // 14: xelbot.com.autonotes.server.Fuel.tags:type_name -> xelbot.com.autonotes.server.Tag
+	20,  // 15: xelbot.com.autonotes.server.FuelCollection.fuels:type_name -> xelbot.com.autonotes.server.Fuel
+	25,  // 16: xelbot.com.autonotes.server.FuelCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
+	100, // 17: xelbot.com.autonotes.server.Currency.created_at:type_name -> google.protobuf.Timestamp
+	22,  // 18: xelbot.com.autonotes.server.DefaultCurrency.currency:type_name -> xelbot.com.autonotes.server.Currency
+	22,  // 19: xelbot.com.autonotes.server.CurrencyCollection.currencies:type_name -> xelbot.com.autonotes.server.Currency
+	0,   // 20: xelbot.com.autonotes.server.Units.distance:type_name -> xelbot.com.autonotes.server.DistanceUnit
+	1,   // 21: xelbot.com.autonotes.server.Units.volume:type_name -> xelbot.com.autonotes.server.VolumeUnit
+	2,   // 22: xelbot.com.autonotes.server.Units.consumption:type_name -> xelbot.com.autonotes.server.ConsumptionUnit
+	13,  // 23: xelbot.com.autonotes.server.UserSettings.default_car:type_name -> xelbot.com.autonotes.server.Car
+	22,  // 24: xelbot.com.autonotes.server.UserSettings.default_currency:type_name -> xelbot.com.autonotes.server.Currency
+	100, // 25: xelbot.com.autonotes.server.UserSettings.created_at:type_name -> google.protobuf.Timestamp
+	100, // 26: xelbot.com.autonotes.server.UserSettings.updated_at:type_name -> google.protobuf.Timestamp
+	17,  // 27: xelbot.com.autonotes.server.UserSettings.default_fuel_type:type_name -> xelbot.com.autonotes.server.FuelType
+	0,   // 28: xelbot.com.autonotes.server.UserSettings.distance_unit:type_name -> xelbot.com.autonotes.server.DistanceUnit
+	1,   // 29: xelbot.com.autonotes.server.UserSettings.volume_unit:type_name -> xelbot.com.autonotes.server.VolumeUnit
+	100, // 30: xelbot.com.autonotes.server.ExchangeRate.date:type_name -> google.protobuf.Timestamp
+	28,  // 31: xelbot.com.autonotes.server.ExchangeRateCollection.rates:type_name -> xelbot.com.autonotes.server.ExchangeRate
+	100, // 32: xelbot.com.autonotes.server.FuelFilter.date_from:type_name -> google.protobuf.Timestamp
+	100, // 33: xelbot.com.autonotes.server.FuelFilter.date_to:type_name -> google.protobuf.Timestamp
+	3,   // 34: xelbot.com.autonotes.server.FuelFilter.sort_direction:type_name -> xelbot.com.autonotes.server.SortDirection
+	99,  // 35: xelbot.com.autonotes.server.BatchError.meta:type_name -> xelbot.com.autonotes.server.BatchError.MetaEntry
+	20,  // 36: xelbot.com.autonotes.server.FuelBatch.fuels:type_name -> xelbot.com.autonotes.server.Fuel
+	4,   // 37: xelbot.com.autonotes.server.FuelBatch.mode:type_name -> xelbot.com.autonotes.server.BatchMode
+	20,  // 38: xelbot.com.autonotes.server.FuelBatchItem.fuel:type_name -> xelbot.com.autonotes.server.Fuel
+	33,  // 39: xelbot.com.autonotes.server.FuelBatchItem.error:type_name -> xelbot.com.autonotes.server.BatchError
+	36,  // 40: xelbot.com.autonotes.server.FuelBatchResult.items:type_name -> xelbot.com.autonotes.server.FuelBatchItem
+	100, // 41: xelbot.com.autonotes.server.FuelPriceFilter.date_from:type_name -> google.protobuf.Timestamp
+	100, // 42: xelbot.com.autonotes.server.FuelPriceFilter.date_to:type_name -> google.protobuf.Timestamp
+	100, // 43: xelbot.com.autonotes.server.FuelPricePoint.date:type_name -> google.protobuf.Timestamp
+	15,  // 44: xelbot.com.autonotes.server.FuelPriceHistory.station:type_name -> xelbot.com.autonotes.server.FillingStation
+	17,  // 45: xelbot.com.autonotes.server.FuelPriceHistory.type:type_name -> xelbot.com.autonotes.server.FuelType
+	39,  // 46: xelbot.com.autonotes.server.FuelPriceHistory.points:type_name -> xelbot.com.autonotes.server.FuelPricePoint
+	40,  // 47: xelbot.com.autonotes.server.FuelPriceCollection.prices:type_name -> xelbot.com.autonotes.server.FuelPriceHistory
+	26,  // 48: xelbot.com.autonotes.server.FuelPriceCollection.units:type_name -> xelbot.com.autonotes.server.Units
+	42,  // 49: xelbot.com.autonotes.server.OrderTypeCollection.types:type_name -> xelbot.com.autonotes.server.OrderType
+	12,  // 50: xelbot.com.autonotes.server.Order.cost:type_name -> xelbot.com.autonotes.server.Cost
+	100, // 51: xelbot.com.autonotes.server.Order.date:type_name -> google.protobuf.Timestamp
+	100, // 52: xelbot.com.autonotes.server.Order.used_at:type_name -> google.protobuf.Timestamp
+	13,  // 53: xelbot.com.autonotes.server.Order.car:type_name -> xelbot.com.autonotes.server.Car
+	42,  // 54: xelbot.com.autonotes.server.Order.type:type_name -> xelbot.com.autonotes.server.OrderType
+	100, // 55: xelbot.com.autonotes.server.Order.created_at:type_name -> google.protobuf.Timestamp
+	12,  // 56: xelbot.com.autonotes.server.Order.converted_cost:type_name -> xelbot.com.autonotes.server.Cost
+	94,  // 57: xelbot.com.autonotes.server.Order.tags:type_name -> xelbot.com.autonotes.server.Tag
+	44,  // 58: xelbot.com.autonotes.server.OrderCollection.orders:type_name -> xelbot.com.autonotes.server.Order
+	25,  // 59: xelbot.com.autonotes.server.OrderCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
+	5,   // 60: xelbot.com.autonotes.server.ExpenseCategory.type:type_name -> xelbot.com.autonotes.server.ExpenseType
+	100, // 61: xelbot.com.autonotes.server.ExpenseCategory.created_at:type_name -> google.protobuf.Timestamp
+	46,  // 62: xelbot.com.autonotes.server.ExpenseCategoryCollection.categories:type_name -> xelbot.com.autonotes.server.ExpenseCategory
+	12,  // 63: xelbot.com.autonotes.server.Expense.cost:type_name -> xelbot.com.autonotes.server.Cost
+	100, // 64: xelbot.com.autonotes.server.Expense.date:type_name -> google.protobuf.Timestamp
+	13,  // 65: xelbot.com.autonotes.server.Expense.car:type_name -> xelbot.com.autonotes.server.Car
+	5,   // 66: xelbot.com.autonotes.server.Expense.type:type_name -> xelbot.com.autonotes.server.ExpenseType
+	100, // 67: xelbot.com.autonotes.server.Expense.created_at:type_name -> google.protobuf.Timestamp
+	12,  // 68: xelbot.com.autonotes.server.Expense.converted_cost:type_name -> xelbot.com.autonotes.server.Cost
+	46,  // 69: xelbot.com.autonotes.server.Expense.category:type_name -> xelbot.com.autonotes.server.ExpenseCategory
+	94,  // 70: xelbot.com.autonotes.server.Expense.tags:type_name -> xelbot.com.autonotes.server.Tag
+	12,  // 71: xelbot.com.autonotes.server.RecurringExpense.cost:type_name -> xelbot.com.autonotes.server.Cost
+	13,  // 72: xelbot.com.autonotes.server.RecurringExpense.car:type_name -> xelbot.com.autonotes.server.Car
+	46,  // 73: xelbot.com.autonotes.server.RecurringExpense.category:type_name -> xelbot.com.autonotes.server.ExpenseCategory
+	6,   // 74: xelbot.com.autonotes.server.RecurringExpense.frequency:type_name -> xelbot.com.autonotes.server.RecurrenceFrequency
+	100, // 75: xelbot.com.autonotes.server.RecurringExpense.start_date:type_name -> google.protobuf.Timestamp
+	100, // 76: xelbot.com.autonotes.server.RecurringExpense.end_date:type_name -> google.protobuf.Timestamp
+	100, // 77: xelbot.com.autonotes.server.RecurringExpense.next_date:type_name -> google.protobuf.Timestamp
+	100, // 78: xelbot.com.autonotes.server.RecurringExpense.created_at:type_name -> google.protobuf.Timestamp
+	49,  // 79: xelbot.com.autonotes.server.RecurringExpenseCollection.items:type_name -> xelbot.com.autonotes.server.RecurringExpense
+	49,  // 80: xelbot.com.autonotes.server.UpcomingExpense.recurring:type_name -> xelbot.com.autonotes.server.RecurringExpense
+	100, // 81: xelbot.com.autonotes.server.UpcomingExpense.date:type_name -> google.protobuf.Timestamp
+	52,  // 82: xelbot.com.autonotes.server.UpcomingExpenseCollection.items:type_name -> xelbot.com.autonotes.server.UpcomingExpense
+	48,  // 83: xelbot.com.autonotes.server.ExpenseCollection.expenses:type_name -> xelbot.com.autonotes.server.Expense
+	25,  // 84: xelbot.com.autonotes.server.ExpenseCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
+	100, // 85: xelbot.com.autonotes.server.OrderFilter.date_from:type_name -> google.protobuf.Timestamp
+	100, // 86: xelbot.com.autonotes.server.OrderFilter.date_to:type_name -> google.protobuf.Timestamp
+	3,   // 87: xelbot.com.autonotes.server.OrderFilter.sort_direction:type_name -> xelbot.com.autonotes.server.SortDirection
+	5,   // 88: xelbot.com.autonotes.server.ExpenseFilter.type:type_name -> xelbot.com.autonotes.server.ExpenseType
+	100, // 89: xelbot.com.autonotes.server.ExpenseFilter.date_from:type_name -> google.protobuf.Timestamp
+	100, // 90: xelbot.com.autonotes.server.ExpenseFilter.date_to:type_name -> google.protobuf.Timestamp
+	3,   // 91: xelbot.com.autonotes.server.ExpenseFilter.sort_direction:type_name -> xelbot.com.autonotes.server.SortDirection
+	13,  // 92: xelbot.com.autonotes.server.OrderInventoryGroup.car:type_name -> xelbot.com.autonotes.server.Car
+	42,  // 93: xelbot.com.autonotes.server.OrderInventoryGroup.type:type_name -> xelbot.com.autonotes.server.OrderType
+	44,  // 94: xelbot.com.autonotes.server.OrderInventoryGroup.orders:type_name -> xelbot.com.autonotes.server.Order
+	13,  // 95: xelbot.com.autonotes.server.OrderServiceLife.car:type_name -> xelbot.com.autonotes.server.Car
+	42,  // 96: xelbot.com.autonotes.server.OrderServiceLife.type:type_name -> xelbot.com.autonotes.server.OrderType
+	58,  // 97: xelbot.com.autonotes.server.OrderInventory.unused:type_name -> xelbot.com.autonotes.server.OrderInventoryGroup
+	59,  // 98: xelbot.com.autonotes.server.OrderInventory.service_life:type_name -> xelbot.com.autonotes.server.OrderServiceLife
+	100, // 99: xelbot.com.autonotes.server.OrderUsage.used_at:type_name -> google.protobuf.Timestamp
+	44,  // 100: xelbot.com.autonotes.server.OrderBatch.orders:type_name -> xelbot.com.autonotes.server.Order
+	4,   // 101: xelbot.com.autonotes.server.OrderBatch.mode:type_name -> xelbot.com.autonotes.server.BatchMode
+	44,  // 102: xelbot.com.autonotes.server.OrderBatchItem.order:type_name -> xelbot.com.autonotes.server.Order
+	33,  // 103: xelbot.com.autonotes.server.OrderBatchItem.error:type_name -> xelbot.com.autonotes.server.BatchError
+	63,  // 104: xelbot.com.autonotes.server.OrderBatchResult.items:type_name -> xelbot.com.autonotes.server.OrderBatchItem
+	48,  // 105: xelbot.com.autonotes.server.ExpenseBatch.expenses:type_name -> xelbot.com.autonotes.server.Expense
+	4,   // 106: xelbot.com.autonotes.server.ExpenseBatch.mode:type_name -> xelbot.com.autonotes.server.BatchMode
+	48,  // 107: xelbot.com.autonotes.server.ExpenseBatchItem.expense:type_name -> xelbot.com.autonotes.server.Expense
+	33,  // 108: xelbot.com.autonotes.server.ExpenseBatchItem.error:type_name -> xelbot.com.autonotes.server.BatchError
+	66,  // 109: xelbot.com.autonotes.server.ExpenseBatchResult.items:type_name -> xelbot.com.autonotes.server.ExpenseBatchItem
+	100, // 110: xelbot.com.autonotes.server.MileageFilter.date_from:type_name -> google.protobuf.Timestamp
+	100, // 111: xelbot.com.autonotes.server.MileageFilter.date_to:type_name -> google.protobuf.Timestamp
+	3,   // 112: xelbot.com.autonotes.server.MileageFilter.sort_direction:type_name -> xelbot.com.autonotes.server.SortDirection
+	100, // 113: xelbot.com.autonotes.server.Mileage.date:type_name -> google.protobuf.Timestamp
+	13,  // 114: xelbot.com.autonotes.server.Mileage.car:type_name -> xelbot.com.autonotes.server.Car
+	100, // 115: xelbot.com.autonotes.server.Mileage.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 116: xelbot.com.autonotes.server.Mileage.tags:type_name -> xelbot.com.autonotes.server.Tag
+	69,  // 117: xelbot.com.autonotes.server.MileageCollection.mileages:type_name -> xelbot.com.autonotes.server.Mileage
+	25,  // 118: xelbot.com.autonotes.server.MileageCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
+	12,  // 119: xelbot.com.autonotes.server.Service.cost:type_name -> xelbot.com.autonotes.server.Cost
+	100, // 120: xelbot.com.autonotes.server.Service.date:type_name -> google.protobuf.Timestamp
+	13,  // 121: xelbot.com.autonotes.server.Service.car:type_name -> xelbot.com.autonotes.server.Car
+	100, // 122: xelbot.com.autonotes.server.Service.created_at:type_name -> google.protobuf.Timestamp
+	12,  // 123: xelbot.com.autonotes.server.Service.converted_cost:type_name -> xelbot.com.autonotes.server.Cost
+	94,  // 124: xelbot.com.autonotes.server.Service.tags:type_name -> xelbot.com.autonotes.server.Tag
+	71,  // 125: xelbot.com.autonotes.server.ServiceCollection.services:type_name -> xelbot.com.autonotes.server.Service
+	25,  // 126: xelbot.com.autonotes.server.ServiceCollection.meta:type_name -> xelbot.com.autonotes.server.PaginationMeta
+	100, // 127: xelbot.com.autonotes.server.ServiceFilter.date_from:type_name -> google.protobuf.Timestamp
+	100, // 128: xelbot.com.autonotes.server.ServiceFilter.date_to:type_name -> google.protobuf.Timestamp
+	3,   // 129: xelbot.com.autonotes.server.ServiceFilter.sort_direction:type_name -> xelbot.com.autonotes.server.SortDirection
+	13,  // 130: xelbot.com.autonotes.server.Trip.car:type_name -> xelbot.com.autonotes.server.Car
+	69,  // 131: xelbot.com.autonotes.server.Trip.start:type_name -> xelbot.com.autonotes.server.Mileage
+	69,  // 132: xelbot.com.autonotes.server.Trip.end:type_name -> xelbot.com.autonotes.server.Mileage
+	100, // 133: xelbot.com.autonotes.server.Trip.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 134: xelbot.com.autonotes.server.TripCollection.trips:type_name -> xelbot.com.autonotes.server.Trip
+	100, // 135: xelbot.com.autonotes.server.TripStart.date:type_name -> google.protobuf.Timestamp
+	100, // 136: xelbot.com.autonotes.server.TripClose.date:type_name -> google.protobuf.Timestamp
+	74,  // 137: xelbot.com.autonotes.server.TripReport.trip:type_name -> xelbot.com.autonotes.server.Trip
+	12,  // 138: xelbot.com.autonotes.server.TripReport.fuel_costs:type_name -> xelbot.com.autonotes.server.Cost
+	12,  // 139: xelbot.com.autonotes.server.TripReport.toll_costs:type_name -> xelbot.com.autonotes.server.Cost
+	12,  // 140: xelbot.com.autonotes.server.TripReport.parking_costs:type_name -> xelbot.com.autonotes.server.Cost
+	12,  // 141: xelbot.com.autonotes.server.TripReport.other_costs:type_name -> xelbot.com.autonotes.server.Cost
+	12,  // 142: xelbot.com.autonotes.server.TripReport.service_costs:type_name -> xelbot.com.autonotes.server.Cost
+	12,  // 143: xelbot.com.autonotes.server.TripReport.costs:type_name -> xelbot.com.autonotes.server.Cost
+	12,  // 144: xelbot.com.autonotes.server.TripReport.converted_total:type_name -> xelbot.com.autonotes.server.Cost
+	26,  // 145: xelbot.com.autonotes.server.TripReport.units:type_name -> xelbot.com.autonotes.server.Units
+	100, // 146: xelbot.com.autonotes.server.OdometerRequest.date:type_name -> google.protobuf.Timestamp
+	100, // 147: xelbot.com.autonotes.server.OdometerEstimate.date:type_name -> google.protobuf.Timestamp
+	7,   // 148: xelbot.com.autonotes.server.OdometerEstimate.method:type_name -> xelbot.com.autonotes.server.OdometerMethod
+	69,  // 149: xelbot.com.autonotes.server.OdometerEstimate.last:type_name -> xelbot.com.autonotes.server.Mileage
+	26,  // 150: xelbot.com.autonotes.server.OdometerEstimate.units:type_name -> xelbot.com.autonotes.server.Units
+	9,   // 151: xelbot.com.autonotes.server.MileageFix.action:type_name -> xelbot.com.autonotes.server.MileageFixAction
+	69,  // 152: xelbot.com.autonotes.server.MileageAnomaly.mileage:type_name -> xelbot.com.autonotes.server.Mileage
+	8,   // 153: xelbot.com.autonotes.server.MileageAnomaly.kind:type_name -> xelbot.com.autonotes.server.MileageAnomalyKind
+	69,  // 154: xelbot.com.autonotes.server.MileageAnomaly.previous:type_name -> xelbot.com.autonotes.server.Mileage
+	69,  // 155: xelbot.com.autonotes.server.MileageAnomaly.next:type_name -> xelbot.com.autonotes.server.Mileage
+	82,  // 156: xelbot.com.autonotes.server.MileageAnomaly.fixes:type_name -> xelbot.com.autonotes.server.MileageFix
+	83,  // 157: xelbot.com.autonotes.server.MileageAnomalyCollection.anomalies:type_name -> xelbot.com.autonotes.server.MileageAnomaly
+	11,  // 158: xelbot.com.autonotes.server.SyncChange.entity:type_name -> xelbot.com.autonotes.server.SyncEntity
+	20,  // 159: xelbot.com.autonotes.server.SyncChange.fuel:type_name -> xelbot.com.autonotes.server.Fuel
+	44,  // 160: xelbot.com.autonotes.server.SyncChange.order:type_name -> xelbot.com.autonotes.server.Order
+	48,  // 161: xelbot.com.autonotes.server.SyncChange.expense:type_name -> xelbot.com.autonotes.server.Expense
+	71,  // 162: xelbot.com.autonotes.server.SyncChange.service:type_name -> xelbot.com.autonotes.server.Service
+	69,  // 163: xelbot.com.autonotes.server.SyncChange.mileage:type_name -> xelbot.com.autonotes.server.Mileage
+	13,  // 164: xelbot.com.autonotes.server.SyncChange.car:type_name -> xelbot.com.autonotes.server.Car
+	27,  // 165: xelbot.com.autonotes.server.SyncChange.settings:type_name -> xelbot.com.autonotes.server.UserSettings
+	86,  // 166: xelbot.com.autonotes.server.SyncPage.changes:type_name -> xelbot.com.autonotes.server.SyncChange
+	11,  // 167: xelbot.com.autonotes.server.Attachment.entity:type_name -> xelbot.com.autonotes.server.SyncEntity
+	100, // 168: xelbot.com.autonotes.server.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 169: xelbot.com.autonotes.server.AttachmentCollection.attachments:type_name -> xelbot.com.autonotes.server.Attachment
+	11,  // 170: xelbot.com.autonotes.server.AttachmentUpload.entity:type_name -> xelbot.com.autonotes.server.SyncEntity
+	11,  // 171: xelbot.com.autonotes.server.AttachmentFilter.entity:type_name -> xelbot.com.autonotes.server.SyncEntity
+	88,  // 172: xelbot.com.autonotes.server.AttachmentContent.attachment:type_name -> xelbot.com.autonotes.server.Attachment
+	100, // 173: xelbot.com.autonotes.server.Tag.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 174: xelbot.com.autonotes.server.TagCollection.tags:type_name -> xelbot.com.autonotes.server.Tag
+	11,  // 175: xelbot.com.autonotes.server.RecordTags.entity:type_name -> xelbot.com.autonotes.server.SyncEntity
+	11,  // 176: xelbot.com.autonotes.server.TagEntityTotal.entity:type_name -> xelbot.com.autonotes.server.SyncEntity
+	12,  // 177: xelbot.com.autonotes.server.TagEntityTotal.costs:type_name -> xelbot.com.autonotes.server.Cost
+	94,  // 178: xelbot.com.autonotes.server.TagSummary.tag:type_name -> xelbot.com.autonotes.server.Tag
+	97,  // 179: xelbot.com.autonotes.server.TagSummary.entities:type_name -> xelbot.com.autonotes.server.TagEntityTotal
+	12,  // 180: xelbot.com.autonotes.server.TagSummary.costs:type_name -> xelbot.com.autonotes.server.Cost
+	12,  // 181: xelbot.com.autonotes.server.TagSummary.converted_total:type_name -> xelbot.com.autonotes.server.Cost
+	101, // 182: xelbot.com.autonotes.server.UserRepository.GetCars:input_type -> google.protobuf.Empty
+	101, // 183: xelbot.com.autonotes.server.UserRepository.GetCurrencies:input_type -> google.protobuf.Empty
+	101, // 184: xelbot.com.autonotes.server.UserRepository.GetDefaultCurrency:input_type -> google.protobuf.Empty
+	101, // 185: xelbot.com.autonotes.server.UserRepository.GetUserSettings:input_type -> google.protobuf.Empty
+	27,  // 186: xelbot.com.autonotes.server.UserRepository.SaveUserSettings:input_type -> xelbot.com.autonotes.server.UserSettings
+	29,  // 187: xelbot.com.autonotes.server.UserRepository.SaveExchangeRates:input_type -> xelbot.com.autonotes.server.ExchangeRateCollection
+	30,  // 188: xelbot.com.autonotes.server.UserRepository.ImportExchangeRates:input_type -> xelbot.com.autonotes.server.ExchangeRateImport
+	32,  // 189: xelbot.com.autonotes.server.FuelRepository.GetFuels:input_type -> xelbot.com.autonotes.server.FuelFilter
+	34,  // 190: xelbot.com.autonotes.server.FuelRepository.FindFuel:input_type -> xelbot.com.autonotes.server.IdRequest
+	101, // 191: xelbot.com.autonotes.server.FuelRepository.GetFillingStations:input_type -> google.protobuf.Empty
+	101, // 192: xelbot.com.autonotes.server.FuelRepository.GetFuelTypes:input_type -> google.protobuf.Empty
+	20,  // 193: xelbot.com.autonotes.server.FuelRepository.SaveFuel:input_type -> xelbot.com.autonotes.server.Fuel
+	35,  // 194: xelbot.com.autonotes.server.FuelRepository.BatchSaveFuels:input_type -> xelbot.com.autonotes.server.FuelBatch
+	38,  // 195: xelbot.com.autonotes.server.FuelRepository.GetFuelPrices:input_type -> xelbot.com.autonotes.server.FuelPriceFilter
+	17,  // 196: xelbot.com.autonotes.server.FuelRepository.CreateFuelType:input_type -> xelbot.com.autonotes.server.FuelType
+	17,  // 197: xelbot.com.autonotes.server.FuelRepository.RenameFuelType:input_type -> xelbot.com.autonotes.server.FuelType
+	17,  // 198: xelbot.com.autonotes.server.FuelRepository.MoveFuelType:input_type -> xelbot.com.autonotes.server.FuelType
+	19,  // 199: xelbot.com.autonotes.server.FuelRepository.MergeFuelTypes:input_type -> xelbot.com.autonotes.server.FuelTypeMerge
+	55,  // 200: xelbot.com.autonotes.server.OrderRepository.GetOrders:input_type -> xelbot.com.autonotes.server.OrderFilter
+	34,  // 201: xelbot.com.autonotes.server.OrderRepository.FindOrder:input_type -> xelbot.com.autonotes.server.IdRequest
+	101, // 202: xelbot.com.autonotes.server.OrderRepository.GetOrderTypes:input_type -> google.protobuf.Empty
+	44,  // 203: xelbot.com.autonotes.server.OrderRepository.SaveOrder:input_type -> xelbot.com.autonotes.server.Order
+	62,  // 204: xelbot.com.autonotes.server.OrderRepository.BatchSaveOrders:input_type -> xelbot.com.autonotes.server.OrderBatch
+	57,  // 205: xelbot.com.autonotes.server.OrderRepository.GetOrderInventory:input_type -> xelbot.com.autonotes.server.OrderInventoryFilter
+	61,  // 206: xelbot.com.autonotes.server.OrderRepository.MarkOrderUsed:input_type -> xelbot.com.autonotes.server.OrderUsage
+	56,  // 207: xelbot.com.autonotes.server.OrderRepository.GetExpenses:input_type -> xelbot.com.autonotes.server.ExpenseFilter
+	34,  // 208: xelbot.com.autonotes.server.OrderRepository.FindExpense:input_type -> xelbot.com.autonotes.server.IdRequest
+	48,  // 209: xelbot.com.autonotes.server.OrderRepository.SaveExpense:input_type -> xelbot.com.autonotes.server.Expense
+	65,  // 210: xelbot.com.autonotes.server.OrderRepository.BatchSaveExpenses:input_type -> xelbot.com.autonotes.server.ExpenseBatch
+	101, // 211: xelbot.com.autonotes.server.OrderRepository.GetExpenseCategories:input_type -> google.protobuf.Empty
+	46,  // 212: xelbot.com.autonotes.server.OrderRepository.SaveExpenseCategory:input_type -> xelbot.com.autonotes.server.ExpenseCategory
+	101, // 213: xelbot.com.autonotes.server.OrderRepository.GetRecurringExpenses:input_type -> google.protobuf.Empty
+	49,  // 214: xelbot.com.autonotes.server.OrderRepository.SaveRecurringExpense:input_type -> xelbot.com.autonotes.server.RecurringExpense
+	34,  // 215: xelbot.com.autonotes.server.OrderRepository.DeleteRecurringExpense:input_type -> xelbot.com.autonotes.server.IdRequest
+	51,  // 216: xelbot.com.autonotes.server.OrderRepository.GetUpcomingExpenses:input_type -> xelbot.com.autonotes.server.UpcomingExpenseFilter
+	73,  // 217: xelbot.com.autonotes.server.CarRepository.GetServices:input_type -> xelbot.com.autonotes.server.ServiceFilter
+	34,  // 218: xelbot.com.autonotes.server.CarRepository.FindService:input_type -> xelbot.com.autonotes.server.IdRequest
+	71,  // 219: xelbot.com.autonotes.server.CarRepository.SaveService:input_type -> xelbot.com.autonotes.server.Service
+	68,  // 220: xelbot.com.autonotes.server.CarRepository.GetMileages:input_type -> xelbot.com.autonotes.server.MileageFilter
+	69,  // 221: xelbot.com.autonotes.server.CarRepository.SaveMileage:input_type -> xelbot.com.autonotes.server.Mileage
+	76,  // 222: xelbot.com.autonotes.server.CarRepository.GetTrips:input_type -> xelbot.com.autonotes.server.TripFilter
+	77,  // 223: xelbot.com.autonotes.server.CarRepository.StartTrip:input_type -> xelbot.com.autonotes.server.TripStart
+	78,  // 224: xelbot.com.autonotes.server.CarRepository.CloseTrip:input_type -> xelbot.com.autonotes.server.TripClose
+	34,  // 225: xelbot.com.autonotes.server.CarRepository.DeleteTrip:input_type -> xelbot.com.autonotes.server.IdRequest
+	34,  // 226: xelbot.com.autonotes.server.CarRepository.GetTripReport:input_type -> xelbot.com.autonotes.server.IdRequest
+	80,  // 227: xelbot.com.autonotes.server.CarRepository.EstimateOdometer:input_type -> xelbot.com.autonotes.server.OdometerRequest
+	34,  // 228: xelbot.com.autonotes.server.CarRepository.GetMileageAnomalies:input_type -> xelbot.com.autonotes.server.IdRequest
+	82,  // 229: xelbot.com.autonotes.server.CarRepository.FixMileage:input_type -> xelbot.com.autonotes.server.MileageFix
+	85,  // 230: xelbot.com.autonotes.server.SyncRepository.GetChanges:input_type -> xelbot.com.autonotes.server.SyncRequest
+	91,  // 231: xelbot.com.autonotes.server.AttachmentRepository.GetAttachments:input_type -> xelbot.com.autonotes.server.AttachmentFilter
+	90,  // 232: xelbot.com.autonotes.server.AttachmentRepository.UploadAttachment:input_type -> xelbot.com.autonotes.server.AttachmentUpload
+	92,  // 233: xelbot.com.autonotes.server.AttachmentRepository.DownloadAttachment:input_type -> xelbot.com.autonotes.server.AttachmentRequest
+	34,  // 234: xelbot.com.autonotes.server.AttachmentRepository.DeleteAttachment:input_type -> xelbot.com.autonotes.server.IdRequest
+	101, // 235: xelbot.com.autonotes.server.TagRepository.GetTags:input_type -> google.protobuf.Empty
+	94,  // 236: xelbot.com.autonotes.server.TagRepository.SaveTag:input_type -> xelbot.com.autonotes.server.Tag
+	34,  // 237: xelbot.com.autonotes.server.TagRepository.DeleteTag:input_type -> xelbot.com.autonotes.server.IdRequest
+	96,  // 238: xelbot.com.autonotes.server.TagRepository.SetRecordTags:input_type -> xelbot.com.autonotes.server.RecordTags
+	34,  // 239: xelbot.com.autonotes.server.TagRepository.GetTagSummary:input_type -> xelbot.com.autonotes.server.IdRequest
+	14,  // 240: xelbot.com.autonotes.server.UserRepository.GetCars:output_type -> xelbot.com.autonotes.server.CarCollection
+	24,  // 241: xelbot.com.autonotes.server.UserRepository.GetCurrencies:output_type -> xelbot.com.autonotes.server.CurrencyCollection
+	23,  // 242: xelbot.com.autonotes.server.UserRepository.GetDefaultCurrency:output_type -> xelbot.com.autonotes.server.DefaultCurrency
+	27,  // 243: xelbot.com.autonotes.server.UserRepository.GetUserSettings:output_type -> xelbot.com.autonotes.server.UserSettings
+	27,  // 244: xelbot.com.autonotes.server.UserRepository.SaveUserSettings:output_type -> xelbot.com.autonotes.server.UserSettings
+	29,  // 245: xelbot.com.autonotes.server.UserRepository.SaveExchangeRates:output_type -> xelbot.com.autonotes.server.ExchangeRateCollection
+	31,  // 246: xelbot.com.autonotes.server.UserRepository.ImportExchangeRates:output_type -> xelbot.com.autonotes.server.ExchangeRateImportResult
+	21,  // 247: xelbot.com.autonotes.server.FuelRepository.GetFuels:output_type -> xelbot.com.autonotes.server.FuelCollection
+	20,  // 248: xelbot.com.autonotes.server.FuelRepository.FindFuel:output_type -> xelbot.com.autonotes.server.Fuel
+	16,  // 249: xelbot.com.autonotes.server.FuelRepository.GetFillingStations:output_type -> xelbot.com.autonotes.server.FillingStationCollection
+	18,  // 250: xelbot.com.autonotes.server.FuelRepository.GetFuelTypes:output_type -> xelbot.com.autonotes.server.FuelTypeCollection
+	20,  // 251: xelbot.com.autonotes.server.FuelRepository.SaveFuel:output_type -> xelbot.com.autonotes.server.Fuel
+	37,  // 252: xelbot.com.autonotes.server.FuelRepository.BatchSaveFuels:output_type -> xelbot.com.autonotes.server.FuelBatchResult
+	41,  // 253: xelbot.com.autonotes.server.FuelRepository.GetFuelPrices:output_type -> xelbot.com.autonotes.server.FuelPriceCollection
+	17,  // 254: xelbot.com.autonotes.server.FuelRepository.CreateFuelType:output_type -> xelbot.com.autonotes.server.FuelType
+	17,  // 255: xelbot.com.autonotes.server.FuelRepository.RenameFuelType:output_type -> xelbot.com.autonotes.server.FuelType
+	17,  // 256: xelbot.com.autonotes.server.FuelRepository.MoveFuelType:output_type -> xelbot.com.autonotes.server.FuelType
+	17,  // 257: xelbot.com.autonotes.server.FuelRepository.MergeFuelTypes:output_type -> xelbot.com.autonotes.server.FuelType
+	45,  // 258: xelbot.com.autonotes.server.OrderRepository.GetOrders:output_type -> xelbot.com.autonotes.server.OrderCollection
+	44,  // 259: xelbot.com.autonotes.server.OrderRepository.FindOrder:output_type -> xelbot.com.autonotes.server.Order
+	43,  // 260: xelbot.com.autonotes.server.OrderRepository.GetOrderTypes:output_type -> xelbot.com.autonotes.server.OrderTypeCollection
+	44,  // 261: xelbot.com.autonotes.server.OrderRepository.SaveOrder:output_type -> xelbot.com.autonotes.server.Order
+	64,  // 262: xelbot.com.autonotes.server.OrderRepository.BatchSaveOrders:output_type -> xelbot.com.autonotes.server.OrderBatchResult
+	60,  // 263: xelbot.com.autonotes.server.OrderRepository.GetOrderInventory:output_type -> xelbot.com.autonotes.server.OrderInventory
+	44,  // 264: xelbot.com.autonotes.server.OrderRepository.MarkOrderUsed:output_type -> xelbot.com.autonotes.server.Order
+	54,  // 265: xelbot.com.autonotes.server.OrderRepository.GetExpenses:output_type -> xelbot.com.autonotes.server.ExpenseCollection
+	48,  // 266: xelbot.com.autonotes.server.OrderRepository.FindExpense:output_type -> xelbot.com.autonotes.server.Expense
+	48,  // 267: xelbot.com.autonotes.server.OrderRepository.SaveExpense:output_type -> xelbot.com.autonotes.server.Expense
+	67,  // 268: xelbot.com.autonotes.server.OrderRepository.BatchSaveExpenses:output_type -> xelbot.com.autonotes.server.ExpenseBatchResult
+	47,  // 269: xelbot.com.autonotes.server.OrderRepository.GetExpenseCategories:output_type -> xelbot.com.autonotes.server.ExpenseCategoryCollection
+	46,  // 270: xelbot.com.autonotes.server.OrderRepository.SaveExpenseCategory:output_type -> xelbot.com.autonotes.server.ExpenseCategory
+	50,  // 271: xelbot.com.autonotes.server.OrderRepository.GetRecurringExpenses:output_type -> xelbot.com.autonotes.server.RecurringExpenseCollection
+	49,  // 272: xelbot.com.autonotes.server.OrderRepository.SaveRecurringExpense:output_type -> xelbot.com.autonotes.server.RecurringExpense
+	101, // 273: xelbot.com.autonotes.server.OrderRepository.DeleteRecurringExpense:output_type -> google.protobuf.Empty
+	53,  // 274: xelbot.com.autonotes.server.OrderRepository.GetUpcomingExpenses:output_type -> xelbot.com.autonotes.server.UpcomingExpenseCollection
+	72,  // 275: xelbot.com.autonotes.server.CarRepository.GetServices:output_type -> xelbot.com.autonotes.server.ServiceCollection
+	71,  // 276: xelbot.com.autonotes.server.CarRepository.FindService:output_type -> xelbot.com.autonotes.server.Service
+	71,  // 277: xelbot.com.autonotes.server.CarRepository.SaveService:output_type -> xelbot.com.autonotes.server.Service
+	70,  // 278: xelbot.com.autonotes.server.CarRepository.GetMileages:output_type -> xelbot.com.autonotes.server.MileageCollection
+	69,  // 279: xelbot.com.autonotes.server.CarRepository.SaveMileage:output_type -> xelbot.com.autonotes.server.Mileage
+	75,  // 280: xelbot.com.autonotes.server.CarRepository.GetTrips:output_type -> xelbot.com.autonotes.server.TripCollection
+	74,  // 281: xelbot.com.autonotes.server.CarRepository.StartTrip:output_type -> xelbot.com.autonotes.server.Trip
+	74,  // 282: xelbot.com.autonotes.server.CarRepository.CloseTrip:output_type -> xelbot.com.autonotes.server.Trip
+	101, // 283: xelbot.com.autonotes.server.CarRepository.DeleteTrip:output_type -> google.protobuf.Empty
+	79,  // 284: xelbot.com.autonotes.server.CarRepository.GetTripReport:output_type -> xelbot.com.autonotes.server.TripReport
+	81,  // 285: xelbot.com.autonotes.server.CarRepository.EstimateOdometer:output_type -> xelbot.com.autonotes.server.OdometerEstimate
+	84,  // 286: xelbot.com.autonotes.server.CarRepository.GetMileageAnomalies:output_type -> xelbot.com.autonotes.server.MileageAnomalyCollection
+	84,  // 287: xelbot.com.autonotes.server.CarRepository.FixMileage:output_type -> xelbot.com.autonotes.server.MileageAnomalyCollection
+	87,  // 288: xelbot.com.autonotes.server.SyncRepository.GetChanges:output_type -> xelbot.com.autonotes.server.SyncPage
+	89,  // 289: xelbot.com.autonotes.server.AttachmentRepository.GetAttachments:output_type -> xelbot.com.autonotes.server.AttachmentCollection
+	88,  // 290: xelbot.com.autonotes.server.AttachmentRepository.UploadAttachment:output_type -> xelbot.com.autonotes.server.Attachment
+	93,  // 291: xelbot.com.autonotes.server.AttachmentRepository.DownloadAttachment:output_type -> xelbot.com.autonotes.server.AttachmentContent
+	101, // 292: xelbot.com.autonotes.server.AttachmentRepository.DeleteAttachment:output_type -> google.protobuf.Empty
+	95,  // 293: xelbot.com.autonotes.server.TagRepository.GetTags:output_type -> xelbot.com.autonotes.server.TagCollection
+	94,  // 294: xelbot.com.autonotes.server.TagRepository.SaveTag:output_type -> xelbot.com.autonotes.server.Tag
+	101, // 295: xelbot.com.autonotes.server.TagRepository.DeleteTag:output_type -> google.protobuf.Empty
+	95,  // 296: xelbot.com.autonotes.server.TagRepository.SetRecordTags:output_type -> xelbot.com.autonotes.server.TagCollection
+	98,  // 297: xelbot.com.autonotes.server.TagRepository.GetTagSummary:output_type -> xelbot.com.autonotes.server.TagSummary
+	240, // [240:298] is the sub-list for method output_type
+	182, // [182:240] is the sub-list for method input_type
+	182, // [182:182] is the sub-list for extension type_name
+	182, // [182:182] is the sub-list for extension extendee
+	0,   // [0:182] is the sub-list for field type_name
 }
 
 func init() { file_server_proto_init() }
@@ -2395,15 +8032,25 @@ func file_server_proto_init() {
 	if File_server_proto != nil {
 		return
 	}
+	file_server_proto_msgTypes[15].OneofWrappers = []any{}
+	file_server_proto_msgTypes[74].OneofWrappers = []any{
+		(*SyncChange_Fuel)(nil),
+		(*SyncChange_Order)(nil),
+		(*SyncChange_Expense)(nil),
+		(*SyncChange_Service)(nil),
+		(*SyncChange_Mileage)(nil),
+		(*SyncChange_Car)(nil),
+		(*SyncChange_Settings)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_server_proto_rawDesc), len(file_server_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   30,
+			NumEnums:      12,
+			NumMessages:   88,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   7,
 		},
 		GoTypes:           file_server_proto_goTypes,
 		DependencyIndexes: file_server_proto_depIdxs,
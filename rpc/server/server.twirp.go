@@ -43,6 +43,11 @@ type UserRepository interface {
 	GetUserSettings(context.Context, *google_protobuf.Empty) (*UserSettings, error)
 
 	SaveUserSettings(context.Context, *UserSettings) (*UserSettings, error)
+
+	// the rates are shared by every user, allowed for admins only
+	SaveExchangeRates(context.Context, *ExchangeRateCollection) (*ExchangeRateCollection, error)
+
+	ImportExchangeRates(context.Context, *ExchangeRateImport) (*ExchangeRateImportResult, error)
 }
 
 // ==============================
@@ -51,7 +56,7 @@ type UserRepository interface {
 
 type userRepositoryProtobufClient struct {
 	client      HTTPClient
-	urls        [5]string
+	urls        [7]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -79,12 +84,14 @@ func NewUserRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "UserRepository")
-	urls := [5]string{
+	urls := [7]string{
 		serviceURL + "GetCars",
 		serviceURL + "GetCurrencies",
 		serviceURL + "GetDefaultCurrency",
 		serviceURL + "GetUserSettings",
 		serviceURL + "SaveUserSettings",
+		serviceURL + "SaveExchangeRates",
+		serviceURL + "ImportExchangeRates",
 	}
 
 	return &userRepositoryProtobufClient{
@@ -325,13 +332,105 @@ func (c *userRepositoryProtobufClient) callSaveUserSettings(ctx context.Context,
 	return out, nil
 }
 
+func (c *userRepositoryProtobufClient) SaveExchangeRates(ctx context.Context, in *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "UserRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExchangeRates")
+	caller := c.callSaveExchangeRates
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExchangeRateCollection)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExchangeRateCollection) when calling interceptor")
+					}
+					return c.callSaveExchangeRates(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExchangeRateCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExchangeRateCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userRepositoryProtobufClient) callSaveExchangeRates(ctx context.Context, in *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+	out := new(ExchangeRateCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userRepositoryProtobufClient) ImportExchangeRates(ctx context.Context, in *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "UserRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "ImportExchangeRates")
+	caller := c.callImportExchangeRates
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExchangeRateImport)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExchangeRateImport) when calling interceptor")
+					}
+					return c.callImportExchangeRates(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExchangeRateImportResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExchangeRateImportResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userRepositoryProtobufClient) callImportExchangeRates(ctx context.Context, in *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+	out := new(ExchangeRateImportResult)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ==========================
 // UserRepository JSON Client
 // ==========================
 
 type userRepositoryJSONClient struct {
 	client      HTTPClient
-	urls        [5]string
+	urls        [7]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -359,12 +458,14 @@ func NewUserRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twir
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "UserRepository")
-	urls := [5]string{
+	urls := [7]string{
 		serviceURL + "GetCars",
 		serviceURL + "GetCurrencies",
 		serviceURL + "GetDefaultCurrency",
 		serviceURL + "GetUserSettings",
 		serviceURL + "SaveUserSettings",
+		serviceURL + "SaveExchangeRates",
+		serviceURL + "ImportExchangeRates",
 	}
 
 	return &userRepositoryJSONClient{
@@ -605,6 +706,98 @@ func (c *userRepositoryJSONClient) callSaveUserSettings(ctx context.Context, in
 	return out, nil
 }
 
+func (c *userRepositoryJSONClient) SaveExchangeRates(ctx context.Context, in *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "UserRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExchangeRates")
+	caller := c.callSaveExchangeRates
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExchangeRateCollection)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExchangeRateCollection) when calling interceptor")
+					}
+					return c.callSaveExchangeRates(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExchangeRateCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExchangeRateCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userRepositoryJSONClient) callSaveExchangeRates(ctx context.Context, in *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+	out := new(ExchangeRateCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userRepositoryJSONClient) ImportExchangeRates(ctx context.Context, in *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "UserRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "ImportExchangeRates")
+	caller := c.callImportExchangeRates
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExchangeRateImport)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExchangeRateImport) when calling interceptor")
+					}
+					return c.callImportExchangeRates(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExchangeRateImportResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExchangeRateImportResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userRepositoryJSONClient) callImportExchangeRates(ctx context.Context, in *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+	out := new(ExchangeRateImportResult)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // =============================
 // UserRepository Server Handler
 // =============================
@@ -717,6 +910,12 @@ func (s *userRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Req
 	case "SaveUserSettings":
 		s.serveSaveUserSettings(ctx, resp, req)
 		return
+	case "SaveExchangeRates":
+		s.serveSaveExchangeRates(ctx, resp, req)
+		return
+	case "ImportExchangeRates":
+		s.serveImportExchangeRates(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -1624,331 +1823,424 @@ func (s *userRepositoryServer) serveSaveUserSettingsProtobuf(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userRepositoryServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 0
-}
-
-func (s *userRepositoryServer) ProtocGenTwirpVersion() string {
-	return "v8.1.3"
+func (s *userRepositoryServer) serveSaveExchangeRates(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveExchangeRatesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveExchangeRatesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
 }
 
-// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
-// that is everything in a Twirp route except for the <Method>. This can be used for routing,
-// for example to identify the requests that are targeted to this service in a mux.
-func (s *userRepositoryServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "UserRepository")
-}
+func (s *userRepositoryServer) serveSaveExchangeRatesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExchangeRates")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-// ========================
-// FuelRepository Interface
-// ========================
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ExchangeRateCollection)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
 
-type FuelRepository interface {
-	GetFuels(context.Context, *FuelFilter) (*FuelCollection, error)
+	handler := s.UserRepository.SaveExchangeRates
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExchangeRateCollection)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExchangeRateCollection) when calling interceptor")
+					}
+					return s.UserRepository.SaveExchangeRates(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExchangeRateCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExchangeRateCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-	FindFuel(context.Context, *IdRequest) (*Fuel, error)
+	// Call service method
+	var respContent *ExchangeRateCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	GetFillingStations(context.Context, *google_protobuf.Empty) (*FillingStationCollection, error)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExchangeRateCollection and nil error while calling SaveExchangeRates. nil responses are not supported"))
+		return
+	}
 
-	GetFuelTypes(context.Context, *google_protobuf.Empty) (*FuelTypeCollection, error)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	SaveFuel(context.Context, *Fuel) (*Fuel, error)
-}
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
 
-// ==============================
-// FuelRepository Protobuf Client
-// ==============================
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
 
-type fuelRepositoryProtobufClient struct {
-	client      HTTPClient
-	urls        [5]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// NewFuelRepositoryProtobufClient creates a Protobuf client that implements the FuelRepository interface.
-// It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewFuelRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) FuelRepository {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
-	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
-
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (s *userRepositoryServer) serveSaveExchangeRatesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExchangeRates")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "FuelRepository")
-	urls := [5]string{
-		serviceURL + "GetFuels",
-		serviceURL + "FindFuel",
-		serviceURL + "GetFillingStations",
-		serviceURL + "GetFuelTypes",
-		serviceURL + "SaveFuel",
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
 	}
-
-	return &fuelRepositoryProtobufClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+	reqContent := new(ExchangeRateCollection)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
 	}
-}
 
-func (c *fuelRepositoryProtobufClient) GetFuels(ctx context.Context, in *FuelFilter) (*FuelCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetFuels")
-	caller := c.callGetFuels
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *FuelFilter) (*FuelCollection, error) {
-			resp, err := c.interceptor(
+	handler := s.UserRepository.SaveExchangeRates
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FuelFilter)
+					typedReq, ok := req.(*ExchangeRateCollection)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FuelFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ExchangeRateCollection) when calling interceptor")
 					}
-					return c.callGetFuels(ctx, typedReq)
+					return s.UserRepository.SaveExchangeRates(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FuelCollection)
+				typedResp, ok := resp.(*ExchangeRateCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FuelCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ExchangeRateCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *fuelRepositoryProtobufClient) callGetFuels(ctx context.Context, in *FuelFilter) (*FuelCollection, error) {
-	out := new(FuelCollection)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	// Call service method
+	var respContent *ExchangeRateCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExchangeRateCollection and nil error while calling SaveExchangeRates. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *fuelRepositoryProtobufClient) FindFuel(ctx context.Context, in *IdRequest) (*Fuel, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "FindFuel")
-	caller := c.callFindFuel
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *IdRequest) (*Fuel, error) {
-			resp, err := c.interceptor(
+func (s *userRepositoryServer) serveImportExchangeRates(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveImportExchangeRatesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveImportExchangeRatesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userRepositoryServer) serveImportExchangeRatesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ImportExchangeRates")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ExchangeRateImport)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserRepository.ImportExchangeRates
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*ExchangeRateImport)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ExchangeRateImport) when calling interceptor")
 					}
-					return c.callFindFuel(ctx, typedReq)
+					return s.UserRepository.ImportExchangeRates(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Fuel)
+				typedResp, ok := resp.(*ExchangeRateImportResult)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ExchangeRateImportResult) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *fuelRepositoryProtobufClient) callFindFuel(ctx context.Context, in *IdRequest) (*Fuel, error) {
-	out := new(Fuel)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	// Call service method
+	var respContent *ExchangeRateImportResult
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExchangeRateImportResult and nil error while calling ImportExchangeRates. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *fuelRepositoryProtobufClient) GetFillingStations(ctx context.Context, in *google_protobuf.Empty) (*FillingStationCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetFillingStations")
-	caller := c.callGetFillingStations
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*FillingStationCollection, error) {
-			resp, err := c.interceptor(
+func (s *userRepositoryServer) serveImportExchangeRatesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ImportExchangeRates")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ExchangeRateImport)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserRepository.ImportExchangeRates
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*ExchangeRateImport)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ExchangeRateImport) when calling interceptor")
 					}
-					return c.callGetFillingStations(ctx, typedReq)
+					return s.UserRepository.ImportExchangeRates(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FillingStationCollection)
+				typedResp, ok := resp.(*ExchangeRateImportResult)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FillingStationCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ExchangeRateImportResult) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *fuelRepositoryProtobufClient) callGetFillingStations(ctx context.Context, in *google_protobuf.Empty) (*FillingStationCollection, error) {
-	out := new(FillingStationCollection)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	// Call service method
+	var respContent *ExchangeRateImportResult
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExchangeRateImportResult and nil error while calling ImportExchangeRates. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-func (c *fuelRepositoryProtobufClient) GetFuelTypes(ctx context.Context, in *google_protobuf.Empty) (*FuelTypeCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetFuelTypes")
-	caller := c.callGetFuelTypes
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*FuelTypeCollection, error) {
-			resp, err := c.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
-					}
-					return c.callGetFuelTypes(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*FuelTypeCollection)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FuelTypeCollection) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
 	}
-	return caller(ctx, in)
-}
 
-func (c *fuelRepositoryProtobufClient) callGetFuelTypes(ctx context.Context, in *google_protobuf.Empty) (*FuelTypeCollection, error) {
-	out := new(FuelTypeCollection)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
-	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
+}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+func (s *userRepositoryServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
 
-	return out, nil
+func (s *userRepositoryServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
 }
 
-func (c *fuelRepositoryProtobufClient) SaveFuel(ctx context.Context, in *Fuel) (*Fuel, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveFuel")
-	caller := c.callSaveFuel
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Fuel) (*Fuel, error) {
-			resp, err := c.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Fuel)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Fuel) when calling interceptor")
-					}
-					return c.callSaveFuel(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*Fuel)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
-	}
-	return caller(ctx, in)
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *userRepositoryServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "UserRepository")
 }
 
-func (c *fuelRepositoryProtobufClient) callSaveFuel(ctx context.Context, in *Fuel) (*Fuel, error) {
-	out := new(Fuel)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
-	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
-	}
+// ========================
+// FuelRepository Interface
+// ========================
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+type FuelRepository interface {
+	GetFuels(context.Context, *FuelFilter) (*FuelCollection, error)
 
-	return out, nil
+	FindFuel(context.Context, *IdRequest) (*Fuel, error)
+
+	GetFillingStations(context.Context, *google_protobuf.Empty) (*FillingStationCollection, error)
+
+	GetFuelTypes(context.Context, *google_protobuf.Empty) (*FuelTypeCollection, error)
+
+	SaveFuel(context.Context, *Fuel) (*Fuel, error)
+
+	BatchSaveFuels(context.Context, *FuelBatch) (*FuelBatchResult, error)
+
+	GetFuelPrices(context.Context, *FuelPriceFilter) (*FuelPriceCollection, error)
+
+	// management of fuel types, allowed for admins only
+	CreateFuelType(context.Context, *FuelType) (*FuelType, error)
+
+	RenameFuelType(context.Context, *FuelType) (*FuelType, error)
+
+	MoveFuelType(context.Context, *FuelType) (*FuelType, error)
+
+	MergeFuelTypes(context.Context, *FuelTypeMerge) (*FuelType, error)
 }
 
-// ==========================
-// FuelRepository JSON Client
-// ==========================
+// ==============================
+// FuelRepository Protobuf Client
+// ==============================
 
-type fuelRepositoryJSONClient struct {
+type fuelRepositoryProtobufClient struct {
 	client      HTTPClient
-	urls        [5]string
+	urls        [11]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewFuelRepositoryJSONClient creates a JSON client that implements the FuelRepository interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewFuelRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) FuelRepository {
+// NewFuelRepositoryProtobufClient creates a Protobuf client that implements the FuelRepository interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewFuelRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) FuelRepository {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -1969,15 +2261,21 @@ func NewFuelRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twir
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "FuelRepository")
-	urls := [5]string{
+	urls := [11]string{
 		serviceURL + "GetFuels",
 		serviceURL + "FindFuel",
 		serviceURL + "GetFillingStations",
 		serviceURL + "GetFuelTypes",
 		serviceURL + "SaveFuel",
+		serviceURL + "BatchSaveFuels",
+		serviceURL + "GetFuelPrices",
+		serviceURL + "CreateFuelType",
+		serviceURL + "RenameFuelType",
+		serviceURL + "MoveFuelType",
+		serviceURL + "MergeFuelTypes",
 	}
 
-	return &fuelRepositoryJSONClient{
+	return &fuelRepositoryProtobufClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -1985,7 +2283,7 @@ func NewFuelRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twir
 	}
 }
 
-func (c *fuelRepositoryJSONClient) GetFuels(ctx context.Context, in *FuelFilter) (*FuelCollection, error) {
+func (c *fuelRepositoryProtobufClient) GetFuels(ctx context.Context, in *FuelFilter) (*FuelCollection, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
 	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
 	ctx = ctxsetters.WithMethodName(ctx, "GetFuels")
@@ -2014,9 +2312,9 @@ func (c *fuelRepositoryJSONClient) GetFuels(ctx context.Context, in *FuelFilter)
 	return caller(ctx, in)
 }
 
-func (c *fuelRepositoryJSONClient) callGetFuels(ctx context.Context, in *FuelFilter) (*FuelCollection, error) {
+func (c *fuelRepositoryProtobufClient) callGetFuels(ctx context.Context, in *FuelFilter) (*FuelCollection, error) {
 	out := new(FuelCollection)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2031,7 +2329,7 @@ func (c *fuelRepositoryJSONClient) callGetFuels(ctx context.Context, in *FuelFil
 	return out, nil
 }
 
-func (c *fuelRepositoryJSONClient) FindFuel(ctx context.Context, in *IdRequest) (*Fuel, error) {
+func (c *fuelRepositoryProtobufClient) FindFuel(ctx context.Context, in *IdRequest) (*Fuel, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
 	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
 	ctx = ctxsetters.WithMethodName(ctx, "FindFuel")
@@ -2060,9 +2358,9 @@ func (c *fuelRepositoryJSONClient) FindFuel(ctx context.Context, in *IdRequest)
 	return caller(ctx, in)
 }
 
-func (c *fuelRepositoryJSONClient) callFindFuel(ctx context.Context, in *IdRequest) (*Fuel, error) {
+func (c *fuelRepositoryProtobufClient) callFindFuel(ctx context.Context, in *IdRequest) (*Fuel, error) {
 	out := new(Fuel)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2077,7 +2375,7 @@ func (c *fuelRepositoryJSONClient) callFindFuel(ctx context.Context, in *IdReque
 	return out, nil
 }
 
-func (c *fuelRepositoryJSONClient) GetFillingStations(ctx context.Context, in *google_protobuf.Empty) (*FillingStationCollection, error) {
+func (c *fuelRepositoryProtobufClient) GetFillingStations(ctx context.Context, in *google_protobuf.Empty) (*FillingStationCollection, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
 	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
 	ctx = ctxsetters.WithMethodName(ctx, "GetFillingStations")
@@ -2106,9 +2404,9 @@ func (c *fuelRepositoryJSONClient) GetFillingStations(ctx context.Context, in *g
 	return caller(ctx, in)
 }
 
-func (c *fuelRepositoryJSONClient) callGetFillingStations(ctx context.Context, in *google_protobuf.Empty) (*FillingStationCollection, error) {
+func (c *fuelRepositoryProtobufClient) callGetFillingStations(ctx context.Context, in *google_protobuf.Empty) (*FillingStationCollection, error) {
 	out := new(FillingStationCollection)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2123,7 +2421,7 @@ func (c *fuelRepositoryJSONClient) callGetFillingStations(ctx context.Context, i
 	return out, nil
 }
 
-func (c *fuelRepositoryJSONClient) GetFuelTypes(ctx context.Context, in *google_protobuf.Empty) (*FuelTypeCollection, error) {
+func (c *fuelRepositoryProtobufClient) GetFuelTypes(ctx context.Context, in *google_protobuf.Empty) (*FuelTypeCollection, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
 	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
 	ctx = ctxsetters.WithMethodName(ctx, "GetFuelTypes")
@@ -2152,9 +2450,9 @@ func (c *fuelRepositoryJSONClient) GetFuelTypes(ctx context.Context, in *google_
 	return caller(ctx, in)
 }
 
-func (c *fuelRepositoryJSONClient) callGetFuelTypes(ctx context.Context, in *google_protobuf.Empty) (*FuelTypeCollection, error) {
+func (c *fuelRepositoryProtobufClient) callGetFuelTypes(ctx context.Context, in *google_protobuf.Empty) (*FuelTypeCollection, error) {
 	out := new(FuelTypeCollection)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2169,7 +2467,7 @@ func (c *fuelRepositoryJSONClient) callGetFuelTypes(ctx context.Context, in *goo
 	return out, nil
 }
 
-func (c *fuelRepositoryJSONClient) SaveFuel(ctx context.Context, in *Fuel) (*Fuel, error) {
+func (c *fuelRepositoryProtobufClient) SaveFuel(ctx context.Context, in *Fuel) (*Fuel, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
 	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
 	ctx = ctxsetters.WithMethodName(ctx, "SaveFuel")
@@ -2198,9 +2496,9 @@ func (c *fuelRepositoryJSONClient) SaveFuel(ctx context.Context, in *Fuel) (*Fue
 	return caller(ctx, in)
 }
 
-func (c *fuelRepositoryJSONClient) callSaveFuel(ctx context.Context, in *Fuel) (*Fuel, error) {
+func (c *fuelRepositoryProtobufClient) callSaveFuel(ctx context.Context, in *Fuel) (*Fuel, error) {
 	out := new(Fuel)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -2215,436 +2513,398 @@ func (c *fuelRepositoryJSONClient) callSaveFuel(ctx context.Context, in *Fuel) (
 	return out, nil
 }
 
-// =============================
-// FuelRepository Server Handler
-// =============================
-
-type fuelRepositoryServer struct {
-	FuelRepository
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewFuelRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewFuelRepositoryServer(svc FuelRepository, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *fuelRepositoryProtobufClient) BatchSaveFuels(ctx context.Context, in *FuelBatch) (*FuelBatchResult, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveFuels")
+	caller := c.callBatchSaveFuels
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelBatch) (*FuelBatchResult, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelBatch) when calling interceptor")
+					}
+					return c.callBatchSaveFuels(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &fuelRepositoryServer{
-		FuelRepository:   svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
+func (c *fuelRepositoryProtobufClient) callBatchSaveFuels(ctx context.Context, in *FuelBatch) (*FuelBatchResult, error) {
+	out := new(FuelBatchResult)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
-}
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *fuelRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *fuelRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
-		return
-	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
-		return
-	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+	return out, nil
 }
 
-// FuelRepositoryPathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const FuelRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.FuelRepository/"
-
-func (s *fuelRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+func (c *fuelRepositoryProtobufClient) GetFuelPrices(ctx context.Context, in *FuelPriceFilter) (*FuelPriceCollection, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
 	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
-
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuelPrices")
+	caller := c.callGetFuelPrices
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelPriceFilter) (*FuelPriceCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelPriceFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelPriceFilter) when calling interceptor")
+					}
+					return c.callGetFuelPrices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelPriceCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelPriceCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+func (c *fuelRepositoryProtobufClient) callGetFuelPrices(ctx context.Context, in *FuelPriceFilter) (*FuelPriceCollection, error) {
+	out := new(FuelPriceCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "xelbot.com.autonotes.server.FuelRepository" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	switch method {
-	case "GetFuels":
-		s.serveGetFuels(ctx, resp, req)
-		return
-	case "FindFuel":
-		s.serveFindFuel(ctx, resp, req)
-		return
-	case "GetFillingStations":
-		s.serveGetFillingStations(ctx, resp, req)
-		return
-	case "GetFuelTypes":
-		s.serveGetFuelTypes(ctx, resp, req)
-		return
-	case "SaveFuel":
-		s.serveSaveFuel(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
+	return out, nil
 }
 
-func (s *fuelRepositoryServer) serveGetFuels(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveGetFuelsJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveGetFuelsProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+func (c *fuelRepositoryProtobufClient) CreateFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFuelType")
+	caller := c.callCreateFuelType
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return c.callCreateFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (s *fuelRepositoryServer) serveGetFuelsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFuels")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *fuelRepositoryProtobufClient) callCreateFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	out := new(FuelType)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(FuelFilter)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.FuelRepository.GetFuels
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FuelFilter) (*FuelCollection, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *fuelRepositoryProtobufClient) RenameFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "RenameFuelType")
+	caller := c.callRenameFuelType
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FuelFilter)
+					typedReq, ok := req.(*FuelType)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FuelFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
 					}
-					return s.FuelRepository.GetFuels(ctx, typedReq)
+					return c.callRenameFuelType(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FuelCollection)
+				typedResp, ok := resp.(*FuelType)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FuelCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *FuelCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *fuelRepositoryProtobufClient) callRenameFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	out := new(FuelType)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelCollection and nil error while calling GetFuels. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	return out, nil
+}
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
+func (c *fuelRepositoryProtobufClient) MoveFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveFuelType")
+	caller := c.callMoveFuelType
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return c.callMoveFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	callResponseSent(ctx, s.hooks)
+	return caller(ctx, in)
 }
 
-func (s *fuelRepositoryServer) serveGetFuelsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFuels")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *fuelRepositoryProtobufClient) callMoveFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	out := new(FuelType)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(FuelFilter)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.FuelRepository.GetFuels
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FuelFilter) (*FuelCollection, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *fuelRepositoryProtobufClient) MergeFuelTypes(ctx context.Context, in *FuelTypeMerge) (*FuelType, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "MergeFuelTypes")
+	caller := c.callMergeFuelTypes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelTypeMerge) (*FuelType, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FuelFilter)
+					typedReq, ok := req.(*FuelTypeMerge)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FuelFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FuelTypeMerge) when calling interceptor")
 					}
-					return s.FuelRepository.GetFuels(ctx, typedReq)
+					return c.callMergeFuelTypes(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FuelCollection)
+				typedResp, ok := resp.(*FuelType)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FuelCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *FuelCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *fuelRepositoryProtobufClient) callMergeFuelTypes(ctx context.Context, in *FuelTypeMerge) (*FuelType, error) {
+	out := new(FuelType)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelCollection and nil error while calling GetFuels. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
-	}
+	return out, nil
+}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+// ==========================
+// FuelRepository JSON Client
+// ==========================
+
+type fuelRepositoryJSONClient struct {
+	client      HTTPClient
+	urls        [11]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
 }
 
-func (s *fuelRepositoryServer) serveFindFuel(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
+// NewFuelRepositoryJSONClient creates a JSON client that implements the FuelRepository interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewFuelRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) FuelRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
 	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveFindFuelJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveFindFuelProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
 	}
-}
 
-func (s *fuelRepositoryServer) serveFindFuelJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindFuel")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "FuelRepository")
+	urls := [11]string{
+		serviceURL + "GetFuels",
+		serviceURL + "FindFuel",
+		serviceURL + "GetFillingStations",
+		serviceURL + "GetFuelTypes",
+		serviceURL + "SaveFuel",
+		serviceURL + "BatchSaveFuels",
+		serviceURL + "GetFuelPrices",
+		serviceURL + "CreateFuelType",
+		serviceURL + "RenameFuelType",
+		serviceURL + "MoveFuelType",
+		serviceURL + "MergeFuelTypes",
 	}
-	reqContent := new(IdRequest)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
+
+	return &fuelRepositoryJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
 	}
+}
 
-	handler := s.FuelRepository.FindFuel
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *IdRequest) (*Fuel, error) {
-			resp, err := s.interceptor(
+func (c *fuelRepositoryJSONClient) GetFuels(ctx context.Context, in *FuelFilter) (*FuelCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuels")
+	caller := c.callGetFuels
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelFilter) (*FuelCollection, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*FuelFilter)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FuelFilter) when calling interceptor")
 					}
-					return s.FuelRepository.FindFuel(ctx, typedReq)
+					return c.callGetFuels(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Fuel)
+				typedResp, ok := resp.(*FuelCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *Fuel
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Fuel and nil error while calling FindFuel. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
+func (c *fuelRepositoryJSONClient) callGetFuels(ctx context.Context, in *FuelFilter) (*FuelCollection, error) {
+	out := new(FuelCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *fuelRepositoryServer) serveFindFuelProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
+func (c *fuelRepositoryJSONClient) FindFuel(ctx context.Context, in *IdRequest) (*Fuel, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
 	ctx = ctxsetters.WithMethodName(ctx, "FindFuel")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(IdRequest)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
-
-	handler := s.FuelRepository.FindFuel
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *IdRequest) (*Fuel, error) {
-			resp, err := s.interceptor(
+	caller := c.callFindFuel
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*Fuel, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*IdRequest)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.FuelRepository.FindFuel(ctx, typedReq)
+					return c.callFindFuel(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2657,93 +2917,40 @@ func (s *fuelRepositoryServer) serveFindFuelProtobuf(ctx context.Context, resp h
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *Fuel
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Fuel and nil error while calling FindFuel. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
+func (c *fuelRepositoryJSONClient) callFindFuel(ctx context.Context, in *IdRequest) (*Fuel, error) {
+	out := new(Fuel)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-func (s *fuelRepositoryServer) serveGetFillingStations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveGetFillingStationsJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveGetFillingStationsProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
-	}
+	return out, nil
 }
 
-func (s *fuelRepositoryServer) serveGetFillingStationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
+func (c *fuelRepositoryJSONClient) GetFillingStations(ctx context.Context, in *google_protobuf.Empty) (*FillingStationCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
 	ctx = ctxsetters.WithMethodName(ctx, "GetFillingStations")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(google_protobuf.Empty)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-
-	handler := s.FuelRepository.GetFillingStations
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*FillingStationCollection, error) {
-			resp, err := s.interceptor(
+	caller := c.callGetFillingStations
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*FillingStationCollection, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.FuelRepository.GetFillingStations(ctx, typedReq)
+					return c.callGetFillingStations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2756,603 +2963,334 @@ func (s *fuelRepositoryServer) serveGetFillingStationsJSON(ctx context.Context,
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *FillingStationCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FillingStationCollection and nil error while calling GetFillingStations. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
+func (c *fuelRepositoryJSONClient) callGetFillingStations(ctx context.Context, in *google_protobuf.Empty) (*FillingStationCollection, error) {
+	out := new(FillingStationCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *fuelRepositoryServer) serveGetFillingStationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFillingStations")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(google_protobuf.Empty)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
-
-	handler := s.FuelRepository.GetFillingStations
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*FillingStationCollection, error) {
-			resp, err := s.interceptor(
+func (c *fuelRepositoryJSONClient) GetFuelTypes(ctx context.Context, in *google_protobuf.Empty) (*FuelTypeCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuelTypes")
+	caller := c.callGetFuelTypes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*FuelTypeCollection, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.FuelRepository.GetFillingStations(ctx, typedReq)
+					return c.callGetFuelTypes(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FillingStationCollection)
+				typedResp, ok := resp.(*FuelTypeCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FillingStationCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelTypeCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *FillingStationCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *fuelRepositoryJSONClient) callGetFuelTypes(ctx context.Context, in *google_protobuf.Empty) (*FuelTypeCollection, error) {
+	out := new(FuelTypeCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FillingStationCollection and nil error while calling GetFillingStations. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *fuelRepositoryServer) serveGetFuelTypes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveGetFuelTypesJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveGetFuelTypesProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+func (c *fuelRepositoryJSONClient) SaveFuel(ctx context.Context, in *Fuel) (*Fuel, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveFuel")
+	caller := c.callSaveFuel
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Fuel) (*Fuel, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Fuel)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Fuel) when calling interceptor")
+					}
+					return c.callSaveFuel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Fuel)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (s *fuelRepositoryServer) serveGetFuelTypesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFuelTypes")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *fuelRepositoryJSONClient) callSaveFuel(ctx context.Context, in *Fuel) (*Fuel, error) {
+	out := new(Fuel)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(google_protobuf.Empty)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.FuelRepository.GetFuelTypes
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*FuelTypeCollection, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *fuelRepositoryJSONClient) BatchSaveFuels(ctx context.Context, in *FuelBatch) (*FuelBatchResult, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveFuels")
+	caller := c.callBatchSaveFuels
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelBatch) (*FuelBatchResult, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*FuelBatch)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FuelBatch) when calling interceptor")
 					}
-					return s.FuelRepository.GetFuelTypes(ctx, typedReq)
+					return c.callBatchSaveFuels(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FuelTypeCollection)
+				typedResp, ok := resp.(*FuelBatchResult)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FuelTypeCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelBatchResult) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *FuelTypeCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *fuelRepositoryJSONClient) callBatchSaveFuels(ctx context.Context, in *FuelBatch) (*FuelBatchResult, error) {
+	out := new(FuelBatchResult)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelTypeCollection and nil error while calling GetFuelTypes. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *fuelRepositoryServer) serveGetFuelTypesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFuelTypes")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(google_protobuf.Empty)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
-
-	handler := s.FuelRepository.GetFuelTypes
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*FuelTypeCollection, error) {
-			resp, err := s.interceptor(
+func (c *fuelRepositoryJSONClient) GetFuelPrices(ctx context.Context, in *FuelPriceFilter) (*FuelPriceCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuelPrices")
+	caller := c.callGetFuelPrices
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelPriceFilter) (*FuelPriceCollection, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*FuelPriceFilter)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FuelPriceFilter) when calling interceptor")
 					}
-					return s.FuelRepository.GetFuelTypes(ctx, typedReq)
+					return c.callGetFuelPrices(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FuelTypeCollection)
+				typedResp, ok := resp.(*FuelPriceCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FuelTypeCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelPriceCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *FuelTypeCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *fuelRepositoryJSONClient) callGetFuelPrices(ctx context.Context, in *FuelPriceFilter) (*FuelPriceCollection, error) {
+	out := new(FuelPriceCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelTypeCollection and nil error while calling GetFuelTypes. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *fuelRepositoryServer) serveSaveFuel(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveSaveFuelJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveSaveFuelProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+func (c *fuelRepositoryJSONClient) CreateFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFuelType")
+	caller := c.callCreateFuelType
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return c.callCreateFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (s *fuelRepositoryServer) serveSaveFuelJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveFuel")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *fuelRepositoryJSONClient) callCreateFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	out := new(FuelType)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(Fuel)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.FuelRepository.SaveFuel
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Fuel) (*Fuel, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *fuelRepositoryJSONClient) RenameFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "RenameFuelType")
+	caller := c.callRenameFuelType
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Fuel)
+					typedReq, ok := req.(*FuelType)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Fuel) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
 					}
-					return s.FuelRepository.SaveFuel(ctx, typedReq)
+					return c.callRenameFuelType(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Fuel)
+				typedResp, ok := resp.(*FuelType)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *Fuel
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Fuel and nil error while calling SaveFuel. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
+func (c *fuelRepositoryJSONClient) callRenameFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	out := new(FuelType)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *fuelRepositoryServer) serveSaveFuelProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveFuel")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(Fuel)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
-
-	handler := s.FuelRepository.SaveFuel
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Fuel) (*Fuel, error) {
-			resp, err := s.interceptor(
+func (c *fuelRepositoryJSONClient) MoveFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveFuelType")
+	caller := c.callMoveFuelType
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Fuel)
+					typedReq, ok := req.(*FuelType)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Fuel) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
 					}
-					return s.FuelRepository.SaveFuel(ctx, typedReq)
+					return c.callMoveFuelType(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Fuel)
+				typedResp, ok := resp.(*FuelType)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *Fuel
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Fuel and nil error while calling SaveFuel. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
-	}
-
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
-}
-
-func (s *fuelRepositoryServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 1
-}
-
-func (s *fuelRepositoryServer) ProtocGenTwirpVersion() string {
-	return "v8.1.3"
-}
-
-// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
-// that is everything in a Twirp route except for the <Method>. This can be used for routing,
-// for example to identify the requests that are targeted to this service in a mux.
-func (s *fuelRepositoryServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "FuelRepository")
-}
-
-// =========================
-// OrderRepository Interface
-// =========================
-
-type OrderRepository interface {
-	GetOrders(context.Context, *OrderFilter) (*OrderCollection, error)
-
-	FindOrder(context.Context, *IdRequest) (*Order, error)
-
-	GetOrderTypes(context.Context, *google_protobuf.Empty) (*OrderTypeCollection, error)
-
-	SaveOrder(context.Context, *Order) (*Order, error)
-
-	GetExpenses(context.Context, *ExpenseFilter) (*ExpenseCollection, error)
-
-	FindExpense(context.Context, *IdRequest) (*Expense, error)
-
-	SaveExpense(context.Context, *Expense) (*Expense, error)
-}
-
-// ===============================
-// OrderRepository Protobuf Client
-// ===============================
-
-type orderRepositoryProtobufClient struct {
-	client      HTTPClient
-	urls        [7]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
-
-// NewOrderRepositoryProtobufClient creates a Protobuf client that implements the OrderRepository interface.
-// It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewOrderRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) OrderRepository {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
-	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
-
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
-	}
-
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "OrderRepository")
-	urls := [7]string{
-		serviceURL + "GetOrders",
-		serviceURL + "FindOrder",
-		serviceURL + "GetOrderTypes",
-		serviceURL + "SaveOrder",
-		serviceURL + "GetExpenses",
-		serviceURL + "FindExpense",
-		serviceURL + "SaveExpense",
-	}
-
-	return &orderRepositoryProtobufClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
-	}
-}
-
-func (c *orderRepositoryProtobufClient) GetOrders(ctx context.Context, in *OrderFilter) (*OrderCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetOrders")
-	caller := c.callGetOrders
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *OrderFilter) (*OrderCollection, error) {
-			resp, err := c.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*OrderFilter)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*OrderFilter) when calling interceptor")
-					}
-					return c.callGetOrders(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*OrderCollection)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*OrderCollection) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
-	}
-	return caller(ctx, in)
-}
-
-func (c *orderRepositoryProtobufClient) callGetOrders(ctx context.Context, in *OrderFilter) (*OrderCollection, error) {
-	out := new(OrderCollection)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *fuelRepositoryJSONClient) callMoveFuelType(ctx context.Context, in *FuelType) (*FuelType, error) {
+	out := new(FuelType)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3367,26 +3305,26 @@ func (c *orderRepositoryProtobufClient) callGetOrders(ctx context.Context, in *O
 	return out, nil
 }
 
-func (c *orderRepositoryProtobufClient) FindOrder(ctx context.Context, in *IdRequest) (*Order, error) {
+func (c *fuelRepositoryJSONClient) MergeFuelTypes(ctx context.Context, in *FuelTypeMerge) (*FuelType, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "FindOrder")
-	caller := c.callFindOrder
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "MergeFuelTypes")
+	caller := c.callMergeFuelTypes
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *IdRequest) (*Order, error) {
+		caller = func(ctx context.Context, req *FuelTypeMerge) (*FuelType, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*FuelTypeMerge)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FuelTypeMerge) when calling interceptor")
 					}
-					return c.callFindOrder(ctx, typedReq)
+					return c.callMergeFuelTypes(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Order)
+				typedResp, ok := resp.(*FuelType)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3396,9 +3334,9 @@ func (c *orderRepositoryProtobufClient) FindOrder(ctx context.Context, in *IdReq
 	return caller(ctx, in)
 }
 
-func (c *orderRepositoryProtobufClient) callFindOrder(ctx context.Context, in *IdRequest) (*Order, error) {
-	out := new(Order)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *fuelRepositoryJSONClient) callMergeFuelTypes(ctx context.Context, in *FuelTypeMerge) (*FuelType, error) {
+	out := new(FuelType)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3413,538 +3351,11018 @@ func (c *orderRepositoryProtobufClient) callFindOrder(ctx context.Context, in *I
 	return out, nil
 }
 
-func (c *orderRepositoryProtobufClient) GetOrderTypes(ctx context.Context, in *google_protobuf.Empty) (*OrderTypeCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetOrderTypes")
-	caller := c.callGetOrderTypes
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*OrderTypeCollection, error) {
-			resp, err := c.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
-					}
-					return c.callGetOrderTypes(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*OrderTypeCollection)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*OrderTypeCollection) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
-	}
-	return caller(ctx, in)
+// =============================
+// FuelRepository Server Handler
+// =============================
+
+type fuelRepositoryServer struct {
+	FuelRepository
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
 }
 
-func (c *orderRepositoryProtobufClient) callGetOrderTypes(ctx context.Context, in *google_protobuf.Empty) (*OrderTypeCollection, error) {
-	out := new(OrderTypeCollection)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
-	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+// NewFuelRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewFuelRepositoryServer(svc FuelRepository, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	return &fuelRepositoryServer{
+		FuelRepository:   svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
 
-	return out, nil
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *fuelRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
 }
 
-func (c *orderRepositoryProtobufClient) SaveOrder(ctx context.Context, in *Order) (*Order, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveOrder")
-	caller := c.callSaveOrder
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Order) (*Order, error) {
-			resp, err := c.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Order)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Order) when calling interceptor")
-					}
-					return c.callSaveOrder(ctx, typedReq)
-				},
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *fuelRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// FuelRepositoryPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const FuelRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.FuelRepository/"
+
+func (s *fuelRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "FuelRepository")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "xelbot.com.autonotes.server.FuelRepository" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetFuels":
+		s.serveGetFuels(ctx, resp, req)
+		return
+	case "FindFuel":
+		s.serveFindFuel(ctx, resp, req)
+		return
+	case "GetFillingStations":
+		s.serveGetFillingStations(ctx, resp, req)
+		return
+	case "GetFuelTypes":
+		s.serveGetFuelTypes(ctx, resp, req)
+		return
+	case "SaveFuel":
+		s.serveSaveFuel(ctx, resp, req)
+		return
+	case "BatchSaveFuels":
+		s.serveBatchSaveFuels(ctx, resp, req)
+		return
+	case "GetFuelPrices":
+		s.serveGetFuelPrices(ctx, resp, req)
+		return
+	case "CreateFuelType":
+		s.serveCreateFuelType(ctx, resp, req)
+		return
+	case "RenameFuelType":
+		s.serveRenameFuelType(ctx, resp, req)
+		return
+	case "MoveFuelType":
+		s.serveMoveFuelType(ctx, resp, req)
+		return
+	case "MergeFuelTypes":
+		s.serveMergeFuelTypes(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *fuelRepositoryServer) serveGetFuels(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetFuelsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetFuelsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveGetFuelsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuels")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FuelFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.GetFuels
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelFilter) (*FuelCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelFilter) when calling interceptor")
+					}
+					return s.FuelRepository.GetFuels(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelCollection and nil error while calling GetFuels. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveGetFuelsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuels")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FuelFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.GetFuels
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelFilter) (*FuelCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelFilter) when calling interceptor")
+					}
+					return s.FuelRepository.GetFuels(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelCollection and nil error while calling GetFuels. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveFindFuel(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindFuelJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindFuelProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveFindFuelJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindFuel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.FindFuel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*Fuel, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.FuelRepository.FindFuel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Fuel)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Fuel
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Fuel and nil error while calling FindFuel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveFindFuelProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindFuel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.FindFuel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*Fuel, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.FuelRepository.FindFuel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Fuel)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Fuel
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Fuel and nil error while calling FindFuel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveGetFillingStations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetFillingStationsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetFillingStationsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveGetFillingStationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFillingStations")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.GetFillingStations
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*FillingStationCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.FuelRepository.GetFillingStations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FillingStationCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FillingStationCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FillingStationCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FillingStationCollection and nil error while calling GetFillingStations. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveGetFillingStationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFillingStations")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.GetFillingStations
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*FillingStationCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.FuelRepository.GetFillingStations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FillingStationCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FillingStationCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FillingStationCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FillingStationCollection and nil error while calling GetFillingStations. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveGetFuelTypes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetFuelTypesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetFuelTypesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveGetFuelTypesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuelTypes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.GetFuelTypes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*FuelTypeCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.FuelRepository.GetFuelTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelTypeCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelTypeCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelTypeCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelTypeCollection and nil error while calling GetFuelTypes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveGetFuelTypesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuelTypes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.GetFuelTypes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*FuelTypeCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.FuelRepository.GetFuelTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelTypeCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelTypeCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelTypeCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelTypeCollection and nil error while calling GetFuelTypes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveSaveFuel(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveFuelJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveFuelProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveSaveFuelJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveFuel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(Fuel)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.SaveFuel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Fuel) (*Fuel, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Fuel)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Fuel) when calling interceptor")
+					}
+					return s.FuelRepository.SaveFuel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Fuel)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Fuel
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Fuel and nil error while calling SaveFuel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveSaveFuelProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveFuel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(Fuel)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.SaveFuel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Fuel) (*Fuel, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Fuel)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Fuel) when calling interceptor")
+					}
+					return s.FuelRepository.SaveFuel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Fuel)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Fuel) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Fuel
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Fuel and nil error while calling SaveFuel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveBatchSaveFuels(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBatchSaveFuelsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBatchSaveFuelsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveBatchSaveFuelsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveFuels")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FuelBatch)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.BatchSaveFuels
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelBatch) (*FuelBatchResult, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelBatch) when calling interceptor")
+					}
+					return s.FuelRepository.BatchSaveFuels(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelBatchResult
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelBatchResult and nil error while calling BatchSaveFuels. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveBatchSaveFuelsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveFuels")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FuelBatch)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.BatchSaveFuels
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelBatch) (*FuelBatchResult, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelBatch) when calling interceptor")
+					}
+					return s.FuelRepository.BatchSaveFuels(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelBatchResult
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelBatchResult and nil error while calling BatchSaveFuels. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveGetFuelPrices(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetFuelPricesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetFuelPricesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveGetFuelPricesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuelPrices")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FuelPriceFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.GetFuelPrices
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelPriceFilter) (*FuelPriceCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelPriceFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelPriceFilter) when calling interceptor")
+					}
+					return s.FuelRepository.GetFuelPrices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelPriceCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelPriceCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelPriceCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelPriceCollection and nil error while calling GetFuelPrices. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveGetFuelPricesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFuelPrices")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FuelPriceFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.GetFuelPrices
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelPriceFilter) (*FuelPriceCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelPriceFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelPriceFilter) when calling interceptor")
+					}
+					return s.FuelRepository.GetFuelPrices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelPriceCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelPriceCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelPriceCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelPriceCollection and nil error while calling GetFuelPrices. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveCreateFuelType(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateFuelTypeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateFuelTypeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveCreateFuelTypeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFuelType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FuelType)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.CreateFuelType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return s.FuelRepository.CreateFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelType
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelType and nil error while calling CreateFuelType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveCreateFuelTypeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFuelType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FuelType)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.CreateFuelType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return s.FuelRepository.CreateFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelType
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelType and nil error while calling CreateFuelType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveRenameFuelType(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRenameFuelTypeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRenameFuelTypeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveRenameFuelTypeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RenameFuelType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FuelType)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.RenameFuelType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return s.FuelRepository.RenameFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelType
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelType and nil error while calling RenameFuelType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveRenameFuelTypeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RenameFuelType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FuelType)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.RenameFuelType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return s.FuelRepository.RenameFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelType
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelType and nil error while calling RenameFuelType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveMoveFuelType(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMoveFuelTypeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMoveFuelTypeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveMoveFuelTypeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveFuelType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FuelType)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.MoveFuelType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return s.FuelRepository.MoveFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelType
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelType and nil error while calling MoveFuelType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveMoveFuelTypeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveFuelType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FuelType)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.MoveFuelType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelType) (*FuelType, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelType)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelType) when calling interceptor")
+					}
+					return s.FuelRepository.MoveFuelType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelType
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelType and nil error while calling MoveFuelType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveMergeFuelTypes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMergeFuelTypesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMergeFuelTypesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *fuelRepositoryServer) serveMergeFuelTypesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MergeFuelTypes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FuelTypeMerge)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FuelRepository.MergeFuelTypes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelTypeMerge) (*FuelType, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelTypeMerge)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelTypeMerge) when calling interceptor")
+					}
+					return s.FuelRepository.MergeFuelTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelType
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelType and nil error while calling MergeFuelTypes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) serveMergeFuelTypesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MergeFuelTypes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FuelTypeMerge)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FuelRepository.MergeFuelTypes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FuelTypeMerge) (*FuelType, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FuelTypeMerge)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FuelTypeMerge) when calling interceptor")
+					}
+					return s.FuelRepository.MergeFuelTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FuelType)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FuelType) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FuelType
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FuelType and nil error while calling MergeFuelTypes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *fuelRepositoryServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 1
+}
+
+func (s *fuelRepositoryServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *fuelRepositoryServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "FuelRepository")
+}
+
+// =========================
+// OrderRepository Interface
+// =========================
+
+type OrderRepository interface {
+	GetOrders(context.Context, *OrderFilter) (*OrderCollection, error)
+
+	FindOrder(context.Context, *IdRequest) (*Order, error)
+
+	GetOrderTypes(context.Context, *google_protobuf.Empty) (*OrderTypeCollection, error)
+
+	SaveOrder(context.Context, *Order) (*Order, error)
+
+	BatchSaveOrders(context.Context, *OrderBatch) (*OrderBatchResult, error)
+
+	GetOrderInventory(context.Context, *OrderInventoryFilter) (*OrderInventory, error)
+
+	MarkOrderUsed(context.Context, *OrderUsage) (*Order, error)
+
+	GetExpenses(context.Context, *ExpenseFilter) (*ExpenseCollection, error)
+
+	FindExpense(context.Context, *IdRequest) (*Expense, error)
+
+	SaveExpense(context.Context, *Expense) (*Expense, error)
+
+	BatchSaveExpenses(context.Context, *ExpenseBatch) (*ExpenseBatchResult, error)
+
+	// built-in categories and the categories of the user
+	GetExpenseCategories(context.Context, *google_protobuf.Empty) (*ExpenseCategoryCollection, error)
+
+	SaveExpenseCategory(context.Context, *ExpenseCategory) (*ExpenseCategory, error)
+
+	GetRecurringExpenses(context.Context, *google_protobuf.Empty) (*RecurringExpenseCollection, error)
+
+	SaveRecurringExpense(context.Context, *RecurringExpense) (*RecurringExpense, error)
+
+	// created expenses are kept
+	DeleteRecurringExpense(context.Context, *IdRequest) (*google_protobuf.Empty, error)
+
+	GetUpcomingExpenses(context.Context, *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error)
+}
+
+// ===============================
+// OrderRepository Protobuf Client
+// ===============================
+
+type orderRepositoryProtobufClient struct {
+	client      HTTPClient
+	urls        [17]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewOrderRepositoryProtobufClient creates a Protobuf client that implements the OrderRepository interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewOrderRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) OrderRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "OrderRepository")
+	urls := [17]string{
+		serviceURL + "GetOrders",
+		serviceURL + "FindOrder",
+		serviceURL + "GetOrderTypes",
+		serviceURL + "SaveOrder",
+		serviceURL + "BatchSaveOrders",
+		serviceURL + "GetOrderInventory",
+		serviceURL + "MarkOrderUsed",
+		serviceURL + "GetExpenses",
+		serviceURL + "FindExpense",
+		serviceURL + "SaveExpense",
+		serviceURL + "BatchSaveExpenses",
+		serviceURL + "GetExpenseCategories",
+		serviceURL + "SaveExpenseCategory",
+		serviceURL + "GetRecurringExpenses",
+		serviceURL + "SaveRecurringExpense",
+		serviceURL + "DeleteRecurringExpense",
+		serviceURL + "GetUpcomingExpenses",
+	}
+
+	return &orderRepositoryProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *orderRepositoryProtobufClient) GetOrders(ctx context.Context, in *OrderFilter) (*OrderCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrders")
+	caller := c.callGetOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OrderFilter) (*OrderCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderFilter) when calling interceptor")
+					}
+					return c.callGetOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callGetOrders(ctx context.Context, in *OrderFilter) (*OrderCollection, error) {
+	out := new(OrderCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) FindOrder(ctx context.Context, in *IdRequest) (*Order, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "FindOrder")
+	caller := c.callFindOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*Order, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callFindOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callFindOrder(ctx context.Context, in *IdRequest) (*Order, error) {
+	out := new(Order)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) GetOrderTypes(ctx context.Context, in *google_protobuf.Empty) (*OrderTypeCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderTypes")
+	caller := c.callGetOrderTypes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*OrderTypeCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetOrderTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderTypeCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderTypeCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callGetOrderTypes(ctx context.Context, in *google_protobuf.Empty) (*OrderTypeCollection, error) {
+	out := new(OrderTypeCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) SaveOrder(ctx context.Context, in *Order) (*Order, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveOrder")
+	caller := c.callSaveOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Order) (*Order, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Order)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Order) when calling interceptor")
+					}
+					return c.callSaveOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callSaveOrder(ctx context.Context, in *Order) (*Order, error) {
+	out := new(Order)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) BatchSaveOrders(ctx context.Context, in *OrderBatch) (*OrderBatchResult, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveOrders")
+	caller := c.callBatchSaveOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OrderBatch) (*OrderBatchResult, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderBatch) when calling interceptor")
+					}
+					return c.callBatchSaveOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callBatchSaveOrders(ctx context.Context, in *OrderBatch) (*OrderBatchResult, error) {
+	out := new(OrderBatchResult)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) GetOrderInventory(ctx context.Context, in *OrderInventoryFilter) (*OrderInventory, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderInventory")
+	caller := c.callGetOrderInventory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OrderInventoryFilter) (*OrderInventory, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderInventoryFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderInventoryFilter) when calling interceptor")
+					}
+					return c.callGetOrderInventory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderInventory)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderInventory) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callGetOrderInventory(ctx context.Context, in *OrderInventoryFilter) (*OrderInventory, error) {
+	out := new(OrderInventory)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) MarkOrderUsed(ctx context.Context, in *OrderUsage) (*Order, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "MarkOrderUsed")
+	caller := c.callMarkOrderUsed
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OrderUsage) (*Order, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderUsage)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderUsage) when calling interceptor")
+					}
+					return c.callMarkOrderUsed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callMarkOrderUsed(ctx context.Context, in *OrderUsage) (*Order, error) {
+	out := new(Order)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) GetExpenses(ctx context.Context, in *ExpenseFilter) (*ExpenseCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpenses")
+	caller := c.callGetExpenses
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExpenseFilter) (*ExpenseCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseFilter) when calling interceptor")
+					}
+					return c.callGetExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callGetExpenses(ctx context.Context, in *ExpenseFilter) (*ExpenseCollection, error) {
+	out := new(ExpenseCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) FindExpense(ctx context.Context, in *IdRequest) (*Expense, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "FindExpense")
+	caller := c.callFindExpense
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*Expense, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callFindExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Expense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callFindExpense(ctx context.Context, in *IdRequest) (*Expense, error) {
+	out := new(Expense)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) SaveExpense(ctx context.Context, in *Expense) (*Expense, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExpense")
+	caller := c.callSaveExpense
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Expense) (*Expense, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Expense)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Expense) when calling interceptor")
+					}
+					return c.callSaveExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Expense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callSaveExpense(ctx context.Context, in *Expense) (*Expense, error) {
+	out := new(Expense)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) BatchSaveExpenses(ctx context.Context, in *ExpenseBatch) (*ExpenseBatchResult, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveExpenses")
+	caller := c.callBatchSaveExpenses
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExpenseBatch) (*ExpenseBatchResult, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseBatch) when calling interceptor")
+					}
+					return c.callBatchSaveExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callBatchSaveExpenses(ctx context.Context, in *ExpenseBatch) (*ExpenseBatchResult, error) {
+	out := new(ExpenseBatchResult)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) GetExpenseCategories(ctx context.Context, in *google_protobuf.Empty) (*ExpenseCategoryCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpenseCategories")
+	caller := c.callGetExpenseCategories
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ExpenseCategoryCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetExpenseCategories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCategoryCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCategoryCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callGetExpenseCategories(ctx context.Context, in *google_protobuf.Empty) (*ExpenseCategoryCollection, error) {
+	out := new(ExpenseCategoryCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) SaveExpenseCategory(ctx context.Context, in *ExpenseCategory) (*ExpenseCategory, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExpenseCategory")
+	caller := c.callSaveExpenseCategory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExpenseCategory) (*ExpenseCategory, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseCategory)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseCategory) when calling interceptor")
+					}
+					return c.callSaveExpenseCategory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCategory)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCategory) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callSaveExpenseCategory(ctx context.Context, in *ExpenseCategory) (*ExpenseCategory, error) {
+	out := new(ExpenseCategory)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) GetRecurringExpenses(ctx context.Context, in *google_protobuf.Empty) (*RecurringExpenseCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecurringExpenses")
+	caller := c.callGetRecurringExpenses
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*RecurringExpenseCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetRecurringExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecurringExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecurringExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callGetRecurringExpenses(ctx context.Context, in *google_protobuf.Empty) (*RecurringExpenseCollection, error) {
+	out := new(RecurringExpenseCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) SaveRecurringExpense(ctx context.Context, in *RecurringExpense) (*RecurringExpense, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveRecurringExpense")
+	caller := c.callSaveRecurringExpense
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RecurringExpense) (*RecurringExpense, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecurringExpense)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecurringExpense) when calling interceptor")
+					}
+					return c.callSaveRecurringExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecurringExpense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecurringExpense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callSaveRecurringExpense(ctx context.Context, in *RecurringExpense) (*RecurringExpense, error) {
+	out := new(RecurringExpense)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) DeleteRecurringExpense(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRecurringExpense")
+	caller := c.callDeleteRecurringExpense
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callDeleteRecurringExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callDeleteRecurringExpense(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryProtobufClient) GetUpcomingExpenses(ctx context.Context, in *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetUpcomingExpenses")
+	caller := c.callGetUpcomingExpenses
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpcomingExpenseFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpcomingExpenseFilter) when calling interceptor")
+					}
+					return c.callGetUpcomingExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpcomingExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpcomingExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryProtobufClient) callGetUpcomingExpenses(ctx context.Context, in *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+	out := new(UpcomingExpenseCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// OrderRepository JSON Client
+// ===========================
+
+type orderRepositoryJSONClient struct {
+	client      HTTPClient
+	urls        [17]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewOrderRepositoryJSONClient creates a JSON client that implements the OrderRepository interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewOrderRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) OrderRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "OrderRepository")
+	urls := [17]string{
+		serviceURL + "GetOrders",
+		serviceURL + "FindOrder",
+		serviceURL + "GetOrderTypes",
+		serviceURL + "SaveOrder",
+		serviceURL + "BatchSaveOrders",
+		serviceURL + "GetOrderInventory",
+		serviceURL + "MarkOrderUsed",
+		serviceURL + "GetExpenses",
+		serviceURL + "FindExpense",
+		serviceURL + "SaveExpense",
+		serviceURL + "BatchSaveExpenses",
+		serviceURL + "GetExpenseCategories",
+		serviceURL + "SaveExpenseCategory",
+		serviceURL + "GetRecurringExpenses",
+		serviceURL + "SaveRecurringExpense",
+		serviceURL + "DeleteRecurringExpense",
+		serviceURL + "GetUpcomingExpenses",
+	}
+
+	return &orderRepositoryJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *orderRepositoryJSONClient) GetOrders(ctx context.Context, in *OrderFilter) (*OrderCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrders")
+	caller := c.callGetOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OrderFilter) (*OrderCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderFilter) when calling interceptor")
+					}
+					return c.callGetOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callGetOrders(ctx context.Context, in *OrderFilter) (*OrderCollection, error) {
+	out := new(OrderCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) FindOrder(ctx context.Context, in *IdRequest) (*Order, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "FindOrder")
+	caller := c.callFindOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*Order, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callFindOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callFindOrder(ctx context.Context, in *IdRequest) (*Order, error) {
+	out := new(Order)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) GetOrderTypes(ctx context.Context, in *google_protobuf.Empty) (*OrderTypeCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderTypes")
+	caller := c.callGetOrderTypes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*OrderTypeCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetOrderTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderTypeCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderTypeCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callGetOrderTypes(ctx context.Context, in *google_protobuf.Empty) (*OrderTypeCollection, error) {
+	out := new(OrderTypeCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) SaveOrder(ctx context.Context, in *Order) (*Order, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveOrder")
+	caller := c.callSaveOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Order) (*Order, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Order)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Order) when calling interceptor")
+					}
+					return c.callSaveOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callSaveOrder(ctx context.Context, in *Order) (*Order, error) {
+	out := new(Order)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) BatchSaveOrders(ctx context.Context, in *OrderBatch) (*OrderBatchResult, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveOrders")
+	caller := c.callBatchSaveOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OrderBatch) (*OrderBatchResult, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderBatch) when calling interceptor")
+					}
+					return c.callBatchSaveOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callBatchSaveOrders(ctx context.Context, in *OrderBatch) (*OrderBatchResult, error) {
+	out := new(OrderBatchResult)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) GetOrderInventory(ctx context.Context, in *OrderInventoryFilter) (*OrderInventory, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderInventory")
+	caller := c.callGetOrderInventory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OrderInventoryFilter) (*OrderInventory, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderInventoryFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderInventoryFilter) when calling interceptor")
+					}
+					return c.callGetOrderInventory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderInventory)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderInventory) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callGetOrderInventory(ctx context.Context, in *OrderInventoryFilter) (*OrderInventory, error) {
+	out := new(OrderInventory)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) MarkOrderUsed(ctx context.Context, in *OrderUsage) (*Order, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "MarkOrderUsed")
+	caller := c.callMarkOrderUsed
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OrderUsage) (*Order, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderUsage)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderUsage) when calling interceptor")
+					}
+					return c.callMarkOrderUsed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callMarkOrderUsed(ctx context.Context, in *OrderUsage) (*Order, error) {
+	out := new(Order)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) GetExpenses(ctx context.Context, in *ExpenseFilter) (*ExpenseCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpenses")
+	caller := c.callGetExpenses
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExpenseFilter) (*ExpenseCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseFilter) when calling interceptor")
+					}
+					return c.callGetExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callGetExpenses(ctx context.Context, in *ExpenseFilter) (*ExpenseCollection, error) {
+	out := new(ExpenseCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) FindExpense(ctx context.Context, in *IdRequest) (*Expense, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "FindExpense")
+	caller := c.callFindExpense
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*Expense, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callFindExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Expense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callFindExpense(ctx context.Context, in *IdRequest) (*Expense, error) {
+	out := new(Expense)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) SaveExpense(ctx context.Context, in *Expense) (*Expense, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExpense")
+	caller := c.callSaveExpense
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Expense) (*Expense, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Expense)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Expense) when calling interceptor")
+					}
+					return c.callSaveExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Expense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callSaveExpense(ctx context.Context, in *Expense) (*Expense, error) {
+	out := new(Expense)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) BatchSaveExpenses(ctx context.Context, in *ExpenseBatch) (*ExpenseBatchResult, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveExpenses")
+	caller := c.callBatchSaveExpenses
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExpenseBatch) (*ExpenseBatchResult, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseBatch) when calling interceptor")
+					}
+					return c.callBatchSaveExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callBatchSaveExpenses(ctx context.Context, in *ExpenseBatch) (*ExpenseBatchResult, error) {
+	out := new(ExpenseBatchResult)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) GetExpenseCategories(ctx context.Context, in *google_protobuf.Empty) (*ExpenseCategoryCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpenseCategories")
+	caller := c.callGetExpenseCategories
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ExpenseCategoryCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetExpenseCategories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCategoryCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCategoryCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callGetExpenseCategories(ctx context.Context, in *google_protobuf.Empty) (*ExpenseCategoryCollection, error) {
+	out := new(ExpenseCategoryCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) SaveExpenseCategory(ctx context.Context, in *ExpenseCategory) (*ExpenseCategory, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExpenseCategory")
+	caller := c.callSaveExpenseCategory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExpenseCategory) (*ExpenseCategory, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseCategory)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseCategory) when calling interceptor")
+					}
+					return c.callSaveExpenseCategory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCategory)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCategory) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callSaveExpenseCategory(ctx context.Context, in *ExpenseCategory) (*ExpenseCategory, error) {
+	out := new(ExpenseCategory)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) GetRecurringExpenses(ctx context.Context, in *google_protobuf.Empty) (*RecurringExpenseCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecurringExpenses")
+	caller := c.callGetRecurringExpenses
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*RecurringExpenseCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetRecurringExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecurringExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecurringExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callGetRecurringExpenses(ctx context.Context, in *google_protobuf.Empty) (*RecurringExpenseCollection, error) {
+	out := new(RecurringExpenseCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) SaveRecurringExpense(ctx context.Context, in *RecurringExpense) (*RecurringExpense, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveRecurringExpense")
+	caller := c.callSaveRecurringExpense
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RecurringExpense) (*RecurringExpense, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecurringExpense)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecurringExpense) when calling interceptor")
+					}
+					return c.callSaveRecurringExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecurringExpense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecurringExpense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callSaveRecurringExpense(ctx context.Context, in *RecurringExpense) (*RecurringExpense, error) {
+	out := new(RecurringExpense)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) DeleteRecurringExpense(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRecurringExpense")
+	caller := c.callDeleteRecurringExpense
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callDeleteRecurringExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callDeleteRecurringExpense(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderRepositoryJSONClient) GetUpcomingExpenses(ctx context.Context, in *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetUpcomingExpenses")
+	caller := c.callGetUpcomingExpenses
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpcomingExpenseFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpcomingExpenseFilter) when calling interceptor")
+					}
+					return c.callGetUpcomingExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpcomingExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpcomingExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderRepositoryJSONClient) callGetUpcomingExpenses(ctx context.Context, in *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+	out := new(UpcomingExpenseCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==============================
+// OrderRepository Server Handler
+// ==============================
+
+type orderRepositoryServer struct {
+	OrderRepository
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewOrderRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewOrderRepositoryServer(svc OrderRepository, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &orderRepositoryServer{
+		OrderRepository:  svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *orderRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *orderRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// OrderRepositoryPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const OrderRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.OrderRepository/"
+
+func (s *orderRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "xelbot.com.autonotes.server.OrderRepository" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetOrders":
+		s.serveGetOrders(ctx, resp, req)
+		return
+	case "FindOrder":
+		s.serveFindOrder(ctx, resp, req)
+		return
+	case "GetOrderTypes":
+		s.serveGetOrderTypes(ctx, resp, req)
+		return
+	case "SaveOrder":
+		s.serveSaveOrder(ctx, resp, req)
+		return
+	case "BatchSaveOrders":
+		s.serveBatchSaveOrders(ctx, resp, req)
+		return
+	case "GetOrderInventory":
+		s.serveGetOrderInventory(ctx, resp, req)
+		return
+	case "MarkOrderUsed":
+		s.serveMarkOrderUsed(ctx, resp, req)
+		return
+	case "GetExpenses":
+		s.serveGetExpenses(ctx, resp, req)
+		return
+	case "FindExpense":
+		s.serveFindExpense(ctx, resp, req)
+		return
+	case "SaveExpense":
+		s.serveSaveExpense(ctx, resp, req)
+		return
+	case "BatchSaveExpenses":
+		s.serveBatchSaveExpenses(ctx, resp, req)
+		return
+	case "GetExpenseCategories":
+		s.serveGetExpenseCategories(ctx, resp, req)
+		return
+	case "SaveExpenseCategory":
+		s.serveSaveExpenseCategory(ctx, resp, req)
+		return
+	case "GetRecurringExpenses":
+		s.serveGetRecurringExpenses(ctx, resp, req)
+		return
+	case "SaveRecurringExpense":
+		s.serveSaveRecurringExpense(ctx, resp, req)
+		return
+	case "DeleteRecurringExpense":
+		s.serveDeleteRecurringExpense(ctx, resp, req)
+		return
+	case "GetUpcomingExpenses":
+		s.serveGetUpcomingExpenses(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *orderRepositoryServer) serveGetOrders(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetOrdersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetOrdersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveGetOrdersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(OrderFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.GetOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OrderFilter) (*OrderCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderFilter) when calling interceptor")
+					}
+					return s.OrderRepository.GetOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderCollection and nil error while calling GetOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetOrdersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(OrderFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.GetOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OrderFilter) (*OrderCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderFilter) when calling interceptor")
+					}
+					return s.OrderRepository.GetOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderCollection and nil error while calling GetOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveFindOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveFindOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.FindOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*Order, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.OrderRepository.FindOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Order
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling FindOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveFindOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.FindOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*Order, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.OrderRepository.FindOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Order
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling FindOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetOrderTypes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetOrderTypesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetOrderTypesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveGetOrderTypesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderTypes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.GetOrderTypes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*OrderTypeCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.OrderRepository.GetOrderTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderTypeCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderTypeCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderTypeCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderTypeCollection and nil error while calling GetOrderTypes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetOrderTypesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderTypes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.GetOrderTypes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*OrderTypeCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.OrderRepository.GetOrderTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderTypeCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderTypeCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderTypeCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderTypeCollection and nil error while calling GetOrderTypes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveSaveOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveSaveOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(Order)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.SaveOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Order) (*Order, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Order)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Order) when calling interceptor")
+					}
+					return s.OrderRepository.SaveOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Order
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling SaveOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveSaveOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(Order)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.SaveOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Order) (*Order, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Order)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Order) when calling interceptor")
+					}
+					return s.OrderRepository.SaveOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Order
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling SaveOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveBatchSaveOrders(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBatchSaveOrdersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBatchSaveOrdersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveBatchSaveOrdersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(OrderBatch)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.BatchSaveOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OrderBatch) (*OrderBatchResult, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderBatch) when calling interceptor")
+					}
+					return s.OrderRepository.BatchSaveOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderBatchResult
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderBatchResult and nil error while calling BatchSaveOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveBatchSaveOrdersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(OrderBatch)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.BatchSaveOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OrderBatch) (*OrderBatchResult, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderBatch) when calling interceptor")
+					}
+					return s.OrderRepository.BatchSaveOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderBatchResult
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderBatchResult and nil error while calling BatchSaveOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetOrderInventory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetOrderInventoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetOrderInventoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveGetOrderInventoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderInventory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(OrderInventoryFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.GetOrderInventory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OrderInventoryFilter) (*OrderInventory, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderInventoryFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderInventoryFilter) when calling interceptor")
+					}
+					return s.OrderRepository.GetOrderInventory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderInventory)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderInventory) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderInventory
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderInventory and nil error while calling GetOrderInventory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetOrderInventoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderInventory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(OrderInventoryFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.GetOrderInventory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OrderInventoryFilter) (*OrderInventory, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderInventoryFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderInventoryFilter) when calling interceptor")
+					}
+					return s.OrderRepository.GetOrderInventory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderInventory)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderInventory) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderInventory
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderInventory and nil error while calling GetOrderInventory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveMarkOrderUsed(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMarkOrderUsedJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMarkOrderUsedProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveMarkOrderUsedJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MarkOrderUsed")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(OrderUsage)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.MarkOrderUsed
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OrderUsage) (*Order, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderUsage)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderUsage) when calling interceptor")
+					}
+					return s.OrderRepository.MarkOrderUsed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Order
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling MarkOrderUsed. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveMarkOrderUsedProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MarkOrderUsed")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(OrderUsage)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.MarkOrderUsed
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OrderUsage) (*Order, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OrderUsage)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OrderUsage) when calling interceptor")
+					}
+					return s.OrderRepository.MarkOrderUsed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Order)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Order
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling MarkOrderUsed. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetExpenses(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetExpensesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetExpensesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveGetExpensesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpenses")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ExpenseFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.GetExpenses
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExpenseFilter) (*ExpenseCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseFilter) when calling interceptor")
+					}
+					return s.OrderRepository.GetExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpenseCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseCollection and nil error while calling GetExpenses. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetExpensesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpenses")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ExpenseFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.GetExpenses
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExpenseFilter) (*ExpenseCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseFilter) when calling interceptor")
+					}
+					return s.OrderRepository.GetExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpenseCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseCollection and nil error while calling GetExpenses. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveFindExpense(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindExpenseJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindExpenseProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveFindExpenseJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindExpense")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.FindExpense
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*Expense, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.OrderRepository.FindExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Expense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Expense
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Expense and nil error while calling FindExpense. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveFindExpenseProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindExpense")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.FindExpense
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*Expense, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.OrderRepository.FindExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Expense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Expense
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Expense and nil error while calling FindExpense. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveSaveExpense(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveExpenseJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveExpenseProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveSaveExpenseJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExpense")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(Expense)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.SaveExpense
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Expense) (*Expense, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Expense)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Expense) when calling interceptor")
+					}
+					return s.OrderRepository.SaveExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Expense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Expense
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Expense and nil error while calling SaveExpense. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveSaveExpenseProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExpense")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(Expense)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.SaveExpense
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Expense) (*Expense, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Expense)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Expense) when calling interceptor")
+					}
+					return s.OrderRepository.SaveExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Expense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Expense
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Expense and nil error while calling SaveExpense. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveBatchSaveExpenses(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBatchSaveExpensesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBatchSaveExpensesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveBatchSaveExpensesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveExpenses")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ExpenseBatch)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.BatchSaveExpenses
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExpenseBatch) (*ExpenseBatchResult, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseBatch) when calling interceptor")
+					}
+					return s.OrderRepository.BatchSaveExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpenseBatchResult
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseBatchResult and nil error while calling BatchSaveExpenses. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveBatchSaveExpensesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BatchSaveExpenses")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ExpenseBatch)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.BatchSaveExpenses
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExpenseBatch) (*ExpenseBatchResult, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseBatch)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseBatch) when calling interceptor")
+					}
+					return s.OrderRepository.BatchSaveExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseBatchResult)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseBatchResult) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpenseBatchResult
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseBatchResult and nil error while calling BatchSaveExpenses. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetExpenseCategories(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetExpenseCategoriesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetExpenseCategoriesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveGetExpenseCategoriesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpenseCategories")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.GetExpenseCategories
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ExpenseCategoryCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.OrderRepository.GetExpenseCategories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCategoryCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCategoryCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpenseCategoryCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseCategoryCollection and nil error while calling GetExpenseCategories. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetExpenseCategoriesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExpenseCategories")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.GetExpenseCategories
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ExpenseCategoryCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.OrderRepository.GetExpenseCategories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCategoryCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCategoryCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpenseCategoryCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseCategoryCollection and nil error while calling GetExpenseCategories. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveSaveExpenseCategory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveExpenseCategoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveExpenseCategoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveSaveExpenseCategoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExpenseCategory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ExpenseCategory)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.SaveExpenseCategory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExpenseCategory) (*ExpenseCategory, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseCategory)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseCategory) when calling interceptor")
+					}
+					return s.OrderRepository.SaveExpenseCategory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCategory)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCategory) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpenseCategory
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseCategory and nil error while calling SaveExpenseCategory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveSaveExpenseCategoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveExpenseCategory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ExpenseCategory)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.SaveExpenseCategory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExpenseCategory) (*ExpenseCategory, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExpenseCategory)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExpenseCategory) when calling interceptor")
+					}
+					return s.OrderRepository.SaveExpenseCategory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExpenseCategory)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCategory) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExpenseCategory
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseCategory and nil error while calling SaveExpenseCategory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetRecurringExpenses(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRecurringExpensesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRecurringExpensesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveGetRecurringExpensesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecurringExpenses")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.GetRecurringExpenses
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*RecurringExpenseCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.OrderRepository.GetRecurringExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecurringExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecurringExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RecurringExpenseCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecurringExpenseCollection and nil error while calling GetRecurringExpenses. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetRecurringExpensesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecurringExpenses")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.GetRecurringExpenses
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*RecurringExpenseCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.OrderRepository.GetRecurringExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecurringExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecurringExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RecurringExpenseCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecurringExpenseCollection and nil error while calling GetRecurringExpenses. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveSaveRecurringExpense(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveRecurringExpenseJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveRecurringExpenseProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveSaveRecurringExpenseJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveRecurringExpense")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RecurringExpense)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.SaveRecurringExpense
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RecurringExpense) (*RecurringExpense, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecurringExpense)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecurringExpense) when calling interceptor")
+					}
+					return s.OrderRepository.SaveRecurringExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecurringExpense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecurringExpense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RecurringExpense
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecurringExpense and nil error while calling SaveRecurringExpense. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveSaveRecurringExpenseProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveRecurringExpense")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RecurringExpense)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.SaveRecurringExpense
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RecurringExpense) (*RecurringExpense, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecurringExpense)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecurringExpense) when calling interceptor")
+					}
+					return s.OrderRepository.SaveRecurringExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecurringExpense)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecurringExpense) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RecurringExpense
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecurringExpense and nil error while calling SaveRecurringExpense. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveDeleteRecurringExpense(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteRecurringExpenseJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteRecurringExpenseProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveDeleteRecurringExpenseJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRecurringExpense")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.DeleteRecurringExpense
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.OrderRepository.DeleteRecurringExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteRecurringExpense. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveDeleteRecurringExpenseProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRecurringExpense")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.DeleteRecurringExpense
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.OrderRepository.DeleteRecurringExpense(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteRecurringExpense. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetUpcomingExpenses(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetUpcomingExpensesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetUpcomingExpensesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderRepositoryServer) serveGetUpcomingExpensesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetUpcomingExpenses")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpcomingExpenseFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderRepository.GetUpcomingExpenses
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpcomingExpenseFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpcomingExpenseFilter) when calling interceptor")
+					}
+					return s.OrderRepository.GetUpcomingExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpcomingExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpcomingExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpcomingExpenseCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpcomingExpenseCollection and nil error while calling GetUpcomingExpenses. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) serveGetUpcomingExpensesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetUpcomingExpenses")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpcomingExpenseFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderRepository.GetUpcomingExpenses
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpcomingExpenseFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpcomingExpenseFilter) when calling interceptor")
+					}
+					return s.OrderRepository.GetUpcomingExpenses(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpcomingExpenseCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpcomingExpenseCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpcomingExpenseCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpcomingExpenseCollection and nil error while calling GetUpcomingExpenses. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderRepositoryServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 2
+}
+
+func (s *orderRepositoryServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *orderRepositoryServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "OrderRepository")
+}
+
+// =======================
+// CarRepository Interface
+// =======================
+
+type CarRepository interface {
+	GetServices(context.Context, *ServiceFilter) (*ServiceCollection, error)
+
+	FindService(context.Context, *IdRequest) (*Service, error)
+
+	SaveService(context.Context, *Service) (*Service, error)
+
+	GetMileages(context.Context, *MileageFilter) (*MileageCollection, error)
+
+	SaveMileage(context.Context, *Mileage) (*Mileage, error)
+
+	GetTrips(context.Context, *TripFilter) (*TripCollection, error)
+
+	StartTrip(context.Context, *TripStart) (*Trip, error)
+
+	CloseTrip(context.Context, *TripClose) (*Trip, error)
+
+	// removes the trip, the mileages are kept
+	DeleteTrip(context.Context, *IdRequest) (*google_protobuf.Empty, error)
+
+	// fuels, expenses and services of the car within the mileage or the dates of the trip
+	GetTripReport(context.Context, *IdRequest) (*TripReport, error)
+
+	EstimateOdometer(context.Context, *OdometerRequest) (*OdometerEstimate, error)
+
+	// scans the mileages of the car by its id
+	GetMileageAnomalies(context.Context, *IdRequest) (*MileageAnomalyCollection, error)
+
+	// applies the fix and returns the remaining anomalies of the car
+	FixMileage(context.Context, *MileageFix) (*MileageAnomalyCollection, error)
+}
+
+// =============================
+// CarRepository Protobuf Client
+// =============================
+
+type carRepositoryProtobufClient struct {
+	client      HTTPClient
+	urls        [13]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewCarRepositoryProtobufClient creates a Protobuf client that implements the CarRepository interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewCarRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) CarRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "CarRepository")
+	urls := [13]string{
+		serviceURL + "GetServices",
+		serviceURL + "FindService",
+		serviceURL + "SaveService",
+		serviceURL + "GetMileages",
+		serviceURL + "SaveMileage",
+		serviceURL + "GetTrips",
+		serviceURL + "StartTrip",
+		serviceURL + "CloseTrip",
+		serviceURL + "DeleteTrip",
+		serviceURL + "GetTripReport",
+		serviceURL + "EstimateOdometer",
+		serviceURL + "GetMileageAnomalies",
+		serviceURL + "FixMileage",
+	}
+
+	return &carRepositoryProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *carRepositoryProtobufClient) GetServices(ctx context.Context, in *ServiceFilter) (*ServiceCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetServices")
+	caller := c.callGetServices
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ServiceFilter) (*ServiceCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ServiceFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ServiceFilter) when calling interceptor")
+					}
+					return c.callGetServices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ServiceCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ServiceCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callGetServices(ctx context.Context, in *ServiceFilter) (*ServiceCollection, error) {
+	out := new(ServiceCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) FindService(ctx context.Context, in *IdRequest) (*Service, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "FindService")
+	caller := c.callFindService
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*Service, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callFindService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Service)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callFindService(ctx context.Context, in *IdRequest) (*Service, error) {
+	out := new(Service)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) SaveService(ctx context.Context, in *Service) (*Service, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveService")
+	caller := c.callSaveService
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Service) (*Service, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Service)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Service) when calling interceptor")
+					}
+					return c.callSaveService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Service)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callSaveService(ctx context.Context, in *Service) (*Service, error) {
+	out := new(Service)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) GetMileages(ctx context.Context, in *MileageFilter) (*MileageCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMileages")
+	caller := c.callGetMileages
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MileageFilter) (*MileageCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MileageFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MileageFilter) when calling interceptor")
+					}
+					return c.callGetMileages(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MileageCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callGetMileages(ctx context.Context, in *MileageFilter) (*MileageCollection, error) {
+	out := new(MileageCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) SaveMileage(ctx context.Context, in *Mileage) (*Mileage, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveMileage")
+	caller := c.callSaveMileage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Mileage) (*Mileage, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Mileage)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Mileage) when calling interceptor")
+					}
+					return c.callSaveMileage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Mileage)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Mileage) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callSaveMileage(ctx context.Context, in *Mileage) (*Mileage, error) {
+	out := new(Mileage)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) GetTrips(ctx context.Context, in *TripFilter) (*TripCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTrips")
+	caller := c.callGetTrips
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TripFilter) (*TripCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripFilter) when calling interceptor")
+					}
+					return c.callGetTrips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TripCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TripCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callGetTrips(ctx context.Context, in *TripFilter) (*TripCollection, error) {
+	out := new(TripCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) StartTrip(ctx context.Context, in *TripStart) (*Trip, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "StartTrip")
+	caller := c.callStartTrip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TripStart) (*Trip, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripStart)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripStart) when calling interceptor")
+					}
+					return c.callStartTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Trip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Trip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callStartTrip(ctx context.Context, in *TripStart) (*Trip, error) {
+	out := new(Trip)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) CloseTrip(ctx context.Context, in *TripClose) (*Trip, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "CloseTrip")
+	caller := c.callCloseTrip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TripClose) (*Trip, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripClose)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripClose) when calling interceptor")
+					}
+					return c.callCloseTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Trip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Trip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callCloseTrip(ctx context.Context, in *TripClose) (*Trip, error) {
+	out := new(Trip)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) DeleteTrip(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTrip")
+	caller := c.callDeleteTrip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callDeleteTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callDeleteTrip(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) GetTripReport(ctx context.Context, in *IdRequest) (*TripReport, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTripReport")
+	caller := c.callGetTripReport
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*TripReport, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callGetTripReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TripReport)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TripReport) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callGetTripReport(ctx context.Context, in *IdRequest) (*TripReport, error) {
+	out := new(TripReport)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) EstimateOdometer(ctx context.Context, in *OdometerRequest) (*OdometerEstimate, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "EstimateOdometer")
+	caller := c.callEstimateOdometer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OdometerRequest) (*OdometerEstimate, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OdometerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OdometerRequest) when calling interceptor")
+					}
+					return c.callEstimateOdometer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OdometerEstimate)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OdometerEstimate) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callEstimateOdometer(ctx context.Context, in *OdometerRequest) (*OdometerEstimate, error) {
+	out := new(OdometerEstimate)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) GetMileageAnomalies(ctx context.Context, in *IdRequest) (*MileageAnomalyCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMileageAnomalies")
+	caller := c.callGetMileageAnomalies
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*MileageAnomalyCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callGetMileageAnomalies(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MileageAnomalyCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageAnomalyCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callGetMileageAnomalies(ctx context.Context, in *IdRequest) (*MileageAnomalyCollection, error) {
+	out := new(MileageAnomalyCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryProtobufClient) FixMileage(ctx context.Context, in *MileageFix) (*MileageAnomalyCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "FixMileage")
+	caller := c.callFixMileage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MileageFix) (*MileageAnomalyCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MileageFix)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MileageFix) when calling interceptor")
+					}
+					return c.callFixMileage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MileageAnomalyCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageAnomalyCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryProtobufClient) callFixMileage(ctx context.Context, in *MileageFix) (*MileageAnomalyCollection, error) {
+	out := new(MileageAnomalyCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// CarRepository JSON Client
+// =========================
+
+type carRepositoryJSONClient struct {
+	client      HTTPClient
+	urls        [13]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewCarRepositoryJSONClient creates a JSON client that implements the CarRepository interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewCarRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) CarRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "CarRepository")
+	urls := [13]string{
+		serviceURL + "GetServices",
+		serviceURL + "FindService",
+		serviceURL + "SaveService",
+		serviceURL + "GetMileages",
+		serviceURL + "SaveMileage",
+		serviceURL + "GetTrips",
+		serviceURL + "StartTrip",
+		serviceURL + "CloseTrip",
+		serviceURL + "DeleteTrip",
+		serviceURL + "GetTripReport",
+		serviceURL + "EstimateOdometer",
+		serviceURL + "GetMileageAnomalies",
+		serviceURL + "FixMileage",
+	}
+
+	return &carRepositoryJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *carRepositoryJSONClient) GetServices(ctx context.Context, in *ServiceFilter) (*ServiceCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetServices")
+	caller := c.callGetServices
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ServiceFilter) (*ServiceCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ServiceFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ServiceFilter) when calling interceptor")
+					}
+					return c.callGetServices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ServiceCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ServiceCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callGetServices(ctx context.Context, in *ServiceFilter) (*ServiceCollection, error) {
+	out := new(ServiceCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) FindService(ctx context.Context, in *IdRequest) (*Service, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "FindService")
+	caller := c.callFindService
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*Service, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callFindService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Service)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callFindService(ctx context.Context, in *IdRequest) (*Service, error) {
+	out := new(Service)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) SaveService(ctx context.Context, in *Service) (*Service, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveService")
+	caller := c.callSaveService
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Service) (*Service, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Service)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Service) when calling interceptor")
+					}
+					return c.callSaveService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Service)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callSaveService(ctx context.Context, in *Service) (*Service, error) {
+	out := new(Service)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) GetMileages(ctx context.Context, in *MileageFilter) (*MileageCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMileages")
+	caller := c.callGetMileages
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MileageFilter) (*MileageCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MileageFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MileageFilter) when calling interceptor")
+					}
+					return c.callGetMileages(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MileageCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callGetMileages(ctx context.Context, in *MileageFilter) (*MileageCollection, error) {
+	out := new(MileageCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) SaveMileage(ctx context.Context, in *Mileage) (*Mileage, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveMileage")
+	caller := c.callSaveMileage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Mileage) (*Mileage, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Mileage)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Mileage) when calling interceptor")
+					}
+					return c.callSaveMileage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Mileage)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Mileage) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callSaveMileage(ctx context.Context, in *Mileage) (*Mileage, error) {
+	out := new(Mileage)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) GetTrips(ctx context.Context, in *TripFilter) (*TripCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTrips")
+	caller := c.callGetTrips
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TripFilter) (*TripCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripFilter) when calling interceptor")
+					}
+					return c.callGetTrips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TripCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TripCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callGetTrips(ctx context.Context, in *TripFilter) (*TripCollection, error) {
+	out := new(TripCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) StartTrip(ctx context.Context, in *TripStart) (*Trip, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "StartTrip")
+	caller := c.callStartTrip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TripStart) (*Trip, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripStart)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripStart) when calling interceptor")
+					}
+					return c.callStartTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Trip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Trip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callStartTrip(ctx context.Context, in *TripStart) (*Trip, error) {
+	out := new(Trip)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) CloseTrip(ctx context.Context, in *TripClose) (*Trip, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "CloseTrip")
+	caller := c.callCloseTrip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TripClose) (*Trip, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripClose)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripClose) when calling interceptor")
+					}
+					return c.callCloseTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Trip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Trip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callCloseTrip(ctx context.Context, in *TripClose) (*Trip, error) {
+	out := new(Trip)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) DeleteTrip(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTrip")
+	caller := c.callDeleteTrip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callDeleteTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callDeleteTrip(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) GetTripReport(ctx context.Context, in *IdRequest) (*TripReport, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTripReport")
+	caller := c.callGetTripReport
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*TripReport, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callGetTripReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TripReport)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TripReport) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callGetTripReport(ctx context.Context, in *IdRequest) (*TripReport, error) {
+	out := new(TripReport)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) EstimateOdometer(ctx context.Context, in *OdometerRequest) (*OdometerEstimate, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "EstimateOdometer")
+	caller := c.callEstimateOdometer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OdometerRequest) (*OdometerEstimate, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OdometerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OdometerRequest) when calling interceptor")
+					}
+					return c.callEstimateOdometer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OdometerEstimate)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OdometerEstimate) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callEstimateOdometer(ctx context.Context, in *OdometerRequest) (*OdometerEstimate, error) {
+	out := new(OdometerEstimate)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) GetMileageAnomalies(ctx context.Context, in *IdRequest) (*MileageAnomalyCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMileageAnomalies")
+	caller := c.callGetMileageAnomalies
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*MileageAnomalyCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return c.callGetMileageAnomalies(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MileageAnomalyCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageAnomalyCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callGetMileageAnomalies(ctx context.Context, in *IdRequest) (*MileageAnomalyCollection, error) {
+	out := new(MileageAnomalyCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *carRepositoryJSONClient) FixMileage(ctx context.Context, in *MileageFix) (*MileageAnomalyCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "FixMileage")
+	caller := c.callFixMileage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MileageFix) (*MileageAnomalyCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MileageFix)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MileageFix) when calling interceptor")
+					}
+					return c.callFixMileage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MileageAnomalyCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageAnomalyCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *carRepositoryJSONClient) callFixMileage(ctx context.Context, in *MileageFix) (*MileageAnomalyCollection, error) {
+	out := new(MileageAnomalyCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// CarRepository Server Handler
+// ============================
+
+type carRepositoryServer struct {
+	CarRepository
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewCarRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewCarRepositoryServer(svc CarRepository, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &carRepositoryServer{
+		CarRepository:    svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *carRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *carRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// CarRepositoryPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const CarRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.CarRepository/"
+
+func (s *carRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "xelbot.com.autonotes.server.CarRepository" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetServices":
+		s.serveGetServices(ctx, resp, req)
+		return
+	case "FindService":
+		s.serveFindService(ctx, resp, req)
+		return
+	case "SaveService":
+		s.serveSaveService(ctx, resp, req)
+		return
+	case "GetMileages":
+		s.serveGetMileages(ctx, resp, req)
+		return
+	case "SaveMileage":
+		s.serveSaveMileage(ctx, resp, req)
+		return
+	case "GetTrips":
+		s.serveGetTrips(ctx, resp, req)
+		return
+	case "StartTrip":
+		s.serveStartTrip(ctx, resp, req)
+		return
+	case "CloseTrip":
+		s.serveCloseTrip(ctx, resp, req)
+		return
+	case "DeleteTrip":
+		s.serveDeleteTrip(ctx, resp, req)
+		return
+	case "GetTripReport":
+		s.serveGetTripReport(ctx, resp, req)
+		return
+	case "EstimateOdometer":
+		s.serveEstimateOdometer(ctx, resp, req)
+		return
+	case "GetMileageAnomalies":
+		s.serveGetMileageAnomalies(ctx, resp, req)
+		return
+	case "FixMileage":
+		s.serveFixMileage(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *carRepositoryServer) serveGetServices(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetServicesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetServicesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveGetServicesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetServices")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ServiceFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.GetServices
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ServiceFilter) (*ServiceCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ServiceFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ServiceFilter) when calling interceptor")
+					}
+					return s.CarRepository.GetServices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ServiceCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ServiceCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ServiceCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ServiceCollection and nil error while calling GetServices. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveGetServicesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetServices")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ServiceFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.GetServices
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ServiceFilter) (*ServiceCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ServiceFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ServiceFilter) when calling interceptor")
+					}
+					return s.CarRepository.GetServices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ServiceCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ServiceCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ServiceCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ServiceCollection and nil error while calling GetServices. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveFindService(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindServiceJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindServiceProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveFindServiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindService")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.FindService
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*Service, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.CarRepository.FindService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Service)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Service
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Service and nil error while calling FindService. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveFindServiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindService")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.FindService
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*Service, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.CarRepository.FindService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Service)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Service
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Service and nil error while calling FindService. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveSaveService(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveServiceJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveServiceProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveSaveServiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveService")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(Service)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.SaveService
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Service) (*Service, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Service)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Service) when calling interceptor")
+					}
+					return s.CarRepository.SaveService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Service)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Service
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Service and nil error while calling SaveService. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveSaveServiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveService")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(Service)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.SaveService
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Service) (*Service, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Service)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Service) when calling interceptor")
+					}
+					return s.CarRepository.SaveService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Service)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Service
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Service and nil error while calling SaveService. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveGetMileages(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetMileagesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetMileagesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveGetMileagesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMileages")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MileageFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.GetMileages
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MileageFilter) (*MileageCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MileageFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MileageFilter) when calling interceptor")
+					}
+					return s.CarRepository.GetMileages(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MileageCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MileageCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MileageCollection and nil error while calling GetMileages. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveGetMileagesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMileages")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MileageFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.GetMileages
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MileageFilter) (*MileageCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MileageFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MileageFilter) when calling interceptor")
+					}
+					return s.CarRepository.GetMileages(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MileageCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MileageCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MileageCollection and nil error while calling GetMileages. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveSaveMileage(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveMileageJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveMileageProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveSaveMileageJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveMileage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(Mileage)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.SaveMileage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Mileage) (*Mileage, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Mileage)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Mileage) when calling interceptor")
+					}
+					return s.CarRepository.SaveMileage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Mileage)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Mileage) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Mileage
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Mileage and nil error while calling SaveMileage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveSaveMileageProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveMileage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(Mileage)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.SaveMileage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Mileage) (*Mileage, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Mileage)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Mileage) when calling interceptor")
+					}
+					return s.CarRepository.SaveMileage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Mileage)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Mileage) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Mileage
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Mileage and nil error while calling SaveMileage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveGetTrips(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetTripsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetTripsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveGetTripsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTrips")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TripFilter)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.GetTrips
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TripFilter) (*TripCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripFilter) when calling interceptor")
+					}
+					return s.CarRepository.GetTrips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TripCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TripCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TripCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TripCollection and nil error while calling GetTrips. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveGetTripsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTrips")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TripFilter)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.GetTrips
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TripFilter) (*TripCollection, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripFilter) when calling interceptor")
+					}
+					return s.CarRepository.GetTrips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TripCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TripCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TripCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TripCollection and nil error while calling GetTrips. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveStartTrip(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStartTripJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStartTripProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveStartTripJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartTrip")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TripStart)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.StartTrip
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TripStart) (*Trip, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripStart)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripStart) when calling interceptor")
+					}
+					return s.CarRepository.StartTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Trip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Trip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Trip
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Trip and nil error while calling StartTrip. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveStartTripProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartTrip")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TripStart)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.StartTrip
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TripStart) (*Trip, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripStart)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripStart) when calling interceptor")
+					}
+					return s.CarRepository.StartTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Trip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Trip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Trip
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Trip and nil error while calling StartTrip. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveCloseTrip(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCloseTripJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCloseTripProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveCloseTripJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CloseTrip")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TripClose)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.CloseTrip
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TripClose) (*Trip, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripClose)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripClose) when calling interceptor")
+					}
+					return s.CarRepository.CloseTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Trip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Trip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Trip
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Trip and nil error while calling CloseTrip. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveCloseTripProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CloseTrip")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TripClose)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.CloseTrip
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TripClose) (*Trip, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TripClose)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TripClose) when calling interceptor")
+					}
+					return s.CarRepository.CloseTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Trip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Trip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Trip
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Trip and nil error while calling CloseTrip. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveDeleteTrip(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteTripJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteTripProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveDeleteTripJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTrip")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.DeleteTrip
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.CarRepository.DeleteTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteTrip. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveDeleteTripProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTrip")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.DeleteTrip
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.CarRepository.DeleteTrip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteTrip. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) serveGetTripReport(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetTripReportJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetTripReportProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveGetTripReportJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTripReport")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.GetTripReport
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*TripReport, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IdRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+					}
+					return s.CarRepository.GetTripReport(ctx, typedReq)
+				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Order)
+				typedResp, ok := resp.(*TripReport)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TripReport) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *orderRepositoryProtobufClient) callSaveOrder(ctx context.Context, in *Order) (*Order, error) {
-	out := new(Order)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	// Call service method
+	var respContent *TripReport
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TripReport and nil error while calling GetTripReport. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *orderRepositoryProtobufClient) GetExpenses(ctx context.Context, in *ExpenseFilter) (*ExpenseCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetExpenses")
-	caller := c.callGetExpenses
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ExpenseFilter) (*ExpenseCollection, error) {
-			resp, err := c.interceptor(
+func (s *carRepositoryServer) serveGetTripReportProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTripReport")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.GetTripReport
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*TripReport, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ExpenseFilter)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ExpenseFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return c.callGetExpenses(ctx, typedReq)
+					return s.CarRepository.GetTripReport(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ExpenseCollection)
+				typedResp, ok := resp.(*TripReport)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TripReport) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *orderRepositoryProtobufClient) callGetExpenses(ctx context.Context, in *ExpenseFilter) (*ExpenseCollection, error) {
-	out := new(ExpenseCollection)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	// Call service method
+	var respContent *TripReport
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TripReport and nil error while calling GetTripReport. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *orderRepositoryProtobufClient) FindExpense(ctx context.Context, in *IdRequest) (*Expense, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "FindExpense")
-	caller := c.callFindExpense
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *IdRequest) (*Expense, error) {
-			resp, err := c.interceptor(
+func (s *carRepositoryServer) serveEstimateOdometer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveEstimateOdometerJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveEstimateOdometerProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveEstimateOdometerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EstimateOdometer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(OdometerRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.EstimateOdometer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OdometerRequest) (*OdometerEstimate, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*OdometerRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*OdometerRequest) when calling interceptor")
 					}
-					return c.callFindExpense(ctx, typedReq)
+					return s.CarRepository.EstimateOdometer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Expense)
+				typedResp, ok := resp.(*OdometerEstimate)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*OdometerEstimate) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
+
+	// Call service method
+	var respContent *OdometerEstimate
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OdometerEstimate and nil error while calling EstimateOdometer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *orderRepositoryProtobufClient) callFindExpense(ctx context.Context, in *IdRequest) (*Expense, error) {
-	out := new(Expense)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+func (s *carRepositoryServer) serveEstimateOdometerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EstimateOdometer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(OdometerRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
-
-func (c *orderRepositoryProtobufClient) SaveExpense(ctx context.Context, in *Expense) (*Expense, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveExpense")
-	caller := c.callSaveExpense
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Expense) (*Expense, error) {
-			resp, err := c.interceptor(
+	handler := s.CarRepository.EstimateOdometer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OdometerRequest) (*OdometerEstimate, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Expense)
+					typedReq, ok := req.(*OdometerRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Expense) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*OdometerRequest) when calling interceptor")
 					}
-					return c.callSaveExpense(ctx, typedReq)
+					return s.CarRepository.EstimateOdometer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Expense)
+				typedResp, ok := resp.(*OdometerEstimate)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*OdometerEstimate) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *orderRepositoryProtobufClient) callSaveExpense(ctx context.Context, in *Expense) (*Expense, error) {
-	out := new(Expense)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	// Call service method
+	var respContent *OdometerEstimate
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OdometerEstimate and nil error while calling EstimateOdometer. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-// ===========================
-// OrderRepository JSON Client
-// ===========================
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
 
-type orderRepositoryJSONClient struct {
-	client      HTTPClient
-	urls        [7]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// NewOrderRepositoryJSONClient creates a JSON client that implements the OrderRepository interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewOrderRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) OrderRepository {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (s *carRepositoryServer) serveGetMileageAnomalies(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
 	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetMileageAnomaliesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetMileageAnomaliesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
 	}
+}
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (s *carRepositoryServer) serveGetMileageAnomaliesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMileageAnomalies")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "OrderRepository")
-	urls := [7]string{
-		serviceURL + "GetOrders",
-		serviceURL + "FindOrder",
-		serviceURL + "GetOrderTypes",
-		serviceURL + "SaveOrder",
-		serviceURL + "GetExpenses",
-		serviceURL + "FindExpense",
-		serviceURL + "SaveExpense",
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
 	}
-
-	return &orderRepositoryJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+	reqContent := new(IdRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
 	}
-}
 
-func (c *orderRepositoryJSONClient) GetOrders(ctx context.Context, in *OrderFilter) (*OrderCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetOrders")
-	caller := c.callGetOrders
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *OrderFilter) (*OrderCollection, error) {
-			resp, err := c.interceptor(
+	handler := s.CarRepository.GetMileageAnomalies
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*MileageAnomalyCollection, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*OrderFilter)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*OrderFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return c.callGetOrders(ctx, typedReq)
+					return s.CarRepository.GetMileageAnomalies(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*OrderCollection)
+				typedResp, ok := resp.(*MileageAnomalyCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*OrderCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageAnomalyCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *orderRepositoryJSONClient) callGetOrders(ctx context.Context, in *OrderFilter) (*OrderCollection, error) {
-	out := new(OrderCollection)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	// Call service method
+	var respContent *MileageAnomalyCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MileageAnomalyCollection and nil error while calling GetMileageAnomalies. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
-}
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
 
-func (c *orderRepositoryJSONClient) FindOrder(ctx context.Context, in *IdRequest) (*Order, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "FindOrder")
-	caller := c.callFindOrder
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *IdRequest) (*Order, error) {
-			resp, err := c.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
-					}
-					return c.callFindOrder(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*Order)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
-	return caller(ctx, in)
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *orderRepositoryJSONClient) callFindOrder(ctx context.Context, in *IdRequest) (*Order, error) {
-	out := new(Order)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (s *carRepositoryServer) serveGetMileageAnomaliesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMileageAnomalies")
+	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IdRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
 
-func (c *orderRepositoryJSONClient) GetOrderTypes(ctx context.Context, in *google_protobuf.Empty) (*OrderTypeCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetOrderTypes")
-	caller := c.callGetOrderTypes
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*OrderTypeCollection, error) {
-			resp, err := c.interceptor(
+	handler := s.CarRepository.GetMileageAnomalies
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IdRequest) (*MileageAnomalyCollection, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return c.callGetOrderTypes(ctx, typedReq)
+					return s.CarRepository.GetMileageAnomalies(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*OrderTypeCollection)
+				typedResp, ok := resp.(*MileageAnomalyCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*OrderTypeCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageAnomalyCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *orderRepositoryJSONClient) callGetOrderTypes(ctx context.Context, in *google_protobuf.Empty) (*OrderTypeCollection, error) {
-	out := new(OrderTypeCollection)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	// Call service method
+	var respContent *MileageAnomalyCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MileageAnomalyCollection and nil error while calling GetMileageAnomalies. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *orderRepositoryJSONClient) SaveOrder(ctx context.Context, in *Order) (*Order, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveOrder")
-	caller := c.callSaveOrder
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Order) (*Order, error) {
-			resp, err := c.interceptor(
+func (s *carRepositoryServer) serveFixMileage(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFixMileageJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFixMileageProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *carRepositoryServer) serveFixMileageJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FixMileage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MileageFix)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CarRepository.FixMileage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MileageFix) (*MileageAnomalyCollection, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Order)
+					typedReq, ok := req.(*MileageFix)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Order) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*MileageFix) when calling interceptor")
 					}
-					return c.callSaveOrder(ctx, typedReq)
+					return s.CarRepository.FixMileage(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Order)
+				typedResp, ok := resp.(*MileageAnomalyCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageAnomalyCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *orderRepositoryJSONClient) callSaveOrder(ctx context.Context, in *Order) (*Order, error) {
-	out := new(Order)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	// Call service method
+	var respContent *MileageAnomalyCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MileageAnomalyCollection and nil error while calling FixMileage. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *orderRepositoryJSONClient) GetExpenses(ctx context.Context, in *ExpenseFilter) (*ExpenseCollection, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetExpenses")
-	caller := c.callGetExpenses
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ExpenseFilter) (*ExpenseCollection, error) {
-			resp, err := c.interceptor(
+func (s *carRepositoryServer) serveFixMileageProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FixMileage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MileageFix)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CarRepository.FixMileage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MileageFix) (*MileageAnomalyCollection, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ExpenseFilter)
+					typedReq, ok := req.(*MileageFix)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ExpenseFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*MileageFix) when calling interceptor")
 					}
-					return c.callGetExpenses(ctx, typedReq)
+					return s.CarRepository.FixMileage(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ExpenseCollection)
+				typedResp, ok := resp.(*MileageAnomalyCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*MileageAnomalyCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *orderRepositoryJSONClient) callGetExpenses(ctx context.Context, in *ExpenseFilter) (*ExpenseCollection, error) {
-	out := new(ExpenseCollection)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	// Call service method
+	var respContent *MileageAnomalyCollection
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MileageAnomalyCollection and nil error while calling FixMileage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *carRepositoryServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 3
+}
+
+func (s *carRepositoryServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *carRepositoryServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "CarRepository")
+}
+
+// ========================
+// SyncRepository Interface
+// ========================
+
+type SyncRepository interface {
+	GetChanges(context.Context, *SyncRequest) (*SyncPage, error)
+}
+
+// ==============================
+// SyncRepository Protobuf Client
+// ==============================
+
+type syncRepositoryProtobufClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewSyncRepositoryProtobufClient creates a Protobuf client that implements the SyncRepository interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewSyncRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) SyncRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "SyncRepository")
+	urls := [1]string{
+		serviceURL + "GetChanges",
+	}
 
-	return out, nil
+	return &syncRepositoryProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
 }
 
-func (c *orderRepositoryJSONClient) FindExpense(ctx context.Context, in *IdRequest) (*Expense, error) {
+func (c *syncRepositoryProtobufClient) GetChanges(ctx context.Context, in *SyncRequest) (*SyncPage, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "FindExpense")
-	caller := c.callFindExpense
+	ctx = ctxsetters.WithServiceName(ctx, "SyncRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetChanges")
+	caller := c.callGetChanges
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *IdRequest) (*Expense, error) {
+		caller = func(ctx context.Context, req *SyncRequest) (*SyncPage, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*SyncRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SyncRequest) when calling interceptor")
 					}
-					return c.callFindExpense(ctx, typedReq)
+					return c.callGetChanges(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Expense)
+				typedResp, ok := resp.(*SyncPage)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncPage) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3954,9 +14372,9 @@ func (c *orderRepositoryJSONClient) FindExpense(ctx context.Context, in *IdReque
 	return caller(ctx, in)
 }
 
-func (c *orderRepositoryJSONClient) callFindExpense(ctx context.Context, in *IdRequest) (*Expense, error) {
-	out := new(Expense)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+func (c *syncRepositoryProtobufClient) callGetChanges(ctx context.Context, in *SyncRequest) (*SyncPage, error) {
+	out := new(SyncPage)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3971,26 +14389,72 @@ func (c *orderRepositoryJSONClient) callFindExpense(ctx context.Context, in *IdR
 	return out, nil
 }
 
-func (c *orderRepositoryJSONClient) SaveExpense(ctx context.Context, in *Expense) (*Expense, error) {
+// ==========================
+// SyncRepository JSON Client
+// ==========================
+
+type syncRepositoryJSONClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewSyncRepositoryJSONClient creates a JSON client that implements the SyncRepository interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewSyncRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) SyncRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "SyncRepository")
+	urls := [1]string{
+		serviceURL + "GetChanges",
+	}
+
+	return &syncRepositoryJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *syncRepositoryJSONClient) GetChanges(ctx context.Context, in *SyncRequest) (*SyncPage, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveExpense")
-	caller := c.callSaveExpense
+	ctx = ctxsetters.WithServiceName(ctx, "SyncRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetChanges")
+	caller := c.callGetChanges
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Expense) (*Expense, error) {
+		caller = func(ctx context.Context, req *SyncRequest) (*SyncPage, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Expense)
+					typedReq, ok := req.(*SyncRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Expense) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SyncRequest) when calling interceptor")
 					}
-					return c.callSaveExpense(ctx, typedReq)
+					return c.callGetChanges(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Expense)
+				typedResp, ok := resp.(*SyncPage)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncPage) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4000,9 +14464,9 @@ func (c *orderRepositoryJSONClient) SaveExpense(ctx context.Context, in *Expense
 	return caller(ctx, in)
 }
 
-func (c *orderRepositoryJSONClient) callSaveExpense(ctx context.Context, in *Expense) (*Expense, error) {
-	out := new(Expense)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+func (c *syncRepositoryJSONClient) callGetChanges(ctx context.Context, in *SyncRequest) (*SyncPage, error) {
+	out := new(SyncPage)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -4017,12 +14481,12 @@ func (c *orderRepositoryJSONClient) callSaveExpense(ctx context.Context, in *Exp
 	return out, nil
 }
 
-// ==============================
-// OrderRepository Server Handler
-// ==============================
+// =============================
+// SyncRepository Server Handler
+// =============================
 
-type orderRepositoryServer struct {
-	OrderRepository
+type syncRepositoryServer struct {
+	SyncRepository
 	interceptor      twirp.Interceptor
 	hooks            *twirp.ServerHooks
 	pathPrefix       string // prefix for routing
@@ -4030,10 +14494,10 @@ type orderRepositoryServer struct {
 	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
 }
 
-// NewOrderRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
+// NewSyncRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
 // HTTP requests that are routed to the right method in the provided svc implementation.
 // The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewOrderRepositoryServer(svc OrderRepository, opts ...interface{}) TwirpServer {
+func NewSyncRepositoryServer(svc SyncRepository, opts ...interface{}) TwirpServer {
 	serverOpts := newServerOpts(opts)
 
 	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
@@ -4046,8 +14510,8 @@ func NewOrderRepositoryServer(svc OrderRepository, opts ...interface{}) TwirpSer
 		pathPrefix = "/twirp" // default prefix
 	}
 
-	return &orderRepositoryServer{
-		OrderRepository:  svc,
+	return &syncRepositoryServer{
+		SyncRepository:   svc,
 		hooks:            serverOpts.Hooks,
 		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
 		pathPrefix:       pathPrefix,
@@ -4058,12 +14522,12 @@ func NewOrderRepositoryServer(svc OrderRepository, opts ...interface{}) TwirpSer
 
 // writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
 // If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *orderRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+func (s *syncRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
 	writeError(ctx, resp, err, s.hooks)
 }
 
 // handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *orderRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+func (s *syncRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
 	if context.Canceled == ctx.Err() {
 		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
 		return
@@ -4075,16 +14539,16 @@ func (s *orderRepositoryServer) handleRequestBodyError(ctx context.Context, resp
 	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
 }
 
-// OrderRepositoryPathPrefix is a convenience constant that may identify URL paths.
+// SyncRepositoryPathPrefix is a convenience constant that may identify URL paths.
 // Should be used with caution, it only matches routes generated by Twirp Go clients,
 // with the default "/twirp" prefix and default CamelCase service and method names.
 // More info: https://twitchtv.github.io/twirp/docs/routing.html
-const OrderRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.OrderRepository/"
+const SyncRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.SyncRepository/"
 
-func (s *orderRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+func (s *syncRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "OrderRepository")
+	ctx = ctxsetters.WithServiceName(ctx, "SyncRepository")
 	ctx = ctxsetters.WithResponseWriter(ctx, resp)
 
 	var err error
@@ -4102,7 +14566,7 @@ func (s *orderRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Re
 
 	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
 	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "xelbot.com.autonotes.server.OrderRepository" {
+	if pkgService != "xelbot.com.autonotes.server.SyncRepository" {
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
@@ -4114,26 +14578,8 @@ func (s *orderRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Re
 	}
 
 	switch method {
-	case "GetOrders":
-		s.serveGetOrders(ctx, resp, req)
-		return
-	case "FindOrder":
-		s.serveFindOrder(ctx, resp, req)
-		return
-	case "GetOrderTypes":
-		s.serveGetOrderTypes(ctx, resp, req)
-		return
-	case "SaveOrder":
-		s.serveSaveOrder(ctx, resp, req)
-		return
-	case "GetExpenses":
-		s.serveGetExpenses(ctx, resp, req)
-		return
-	case "FindExpense":
-		s.serveFindExpense(ctx, resp, req)
-		return
-	case "SaveExpense":
-		s.serveSaveExpense(ctx, resp, req)
+	case "GetChanges":
+		s.serveGetChanges(ctx, resp, req)
 		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
@@ -4142,7 +14588,7 @@ func (s *orderRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Re
 	}
 }
 
-func (s *orderRepositoryServer) serveGetOrders(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *syncRepositoryServer) serveGetChanges(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4150,9 +14596,9 @@ func (s *orderRepositoryServer) serveGetOrders(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetOrdersJSON(ctx, resp, req)
+		s.serveGetChangesJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetOrdersProtobuf(ctx, resp, req)
+		s.serveGetChangesProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4160,9 +14606,9 @@ func (s *orderRepositoryServer) serveGetOrders(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *orderRepositoryServer) serveGetOrdersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *syncRepositoryServer) serveGetChangesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetOrders")
+	ctx = ctxsetters.WithMethodName(ctx, "GetChanges")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4175,29 +14621,29 @@ func (s *orderRepositoryServer) serveGetOrdersJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(OrderFilter)
+	reqContent := new(SyncRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.OrderRepository.GetOrders
+	handler := s.SyncRepository.GetChanges
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *OrderFilter) (*OrderCollection, error) {
+		handler = func(ctx context.Context, req *SyncRequest) (*SyncPage, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*OrderFilter)
+					typedReq, ok := req.(*SyncRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*OrderFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SyncRequest) when calling interceptor")
 					}
-					return s.OrderRepository.GetOrders(ctx, typedReq)
+					return s.SyncRepository.GetChanges(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*OrderCollection)
+				typedResp, ok := resp.(*SyncPage)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*OrderCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncPage) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4206,7 +14652,7 @@ func (s *orderRepositoryServer) serveGetOrdersJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *OrderCollection
+	var respContent *SyncPage
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4217,7 +14663,7 @@ func (s *orderRepositoryServer) serveGetOrdersJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderCollection and nil error while calling GetOrders. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncPage and nil error while calling GetChanges. nil responses are not supported"))
 		return
 	}
 
@@ -4240,449 +14686,700 @@ func (s *orderRepositoryServer) serveGetOrdersJSON(ctx context.Context, resp htt
 		twerr := twirp.NewError(twirp.Unknown, msg)
 		ctx = callError(ctx, s.hooks, twerr)
 	}
-	callResponseSent(ctx, s.hooks)
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *syncRepositoryServer) serveGetChangesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetChanges")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SyncRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SyncRepository.GetChanges
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SyncRequest) (*SyncPage, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SyncRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SyncRequest) when calling interceptor")
+					}
+					return s.SyncRepository.GetChanges(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncPage)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncPage) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncPage
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncPage and nil error while calling GetChanges. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *syncRepositoryServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 4
+}
+
+func (s *syncRepositoryServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *syncRepositoryServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "SyncRepository")
+}
+
+// ==============================
+// AttachmentRepository Interface
+// ==============================
+
+type AttachmentRepository interface {
+	GetAttachments(context.Context, *AttachmentFilter) (*AttachmentCollection, error)
+
+	UploadAttachment(context.Context, *AttachmentUpload) (*Attachment, error)
+
+	DownloadAttachment(context.Context, *AttachmentRequest) (*AttachmentContent, error)
+
+	DeleteAttachment(context.Context, *IdRequest) (*google_protobuf.Empty, error)
+}
+
+// ====================================
+// AttachmentRepository Protobuf Client
+// ====================================
+
+type attachmentRepositoryProtobufClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAttachmentRepositoryProtobufClient creates a Protobuf client that implements the AttachmentRepository interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewAttachmentRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AttachmentRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "AttachmentRepository")
+	urls := [4]string{
+		serviceURL + "GetAttachments",
+		serviceURL + "UploadAttachment",
+		serviceURL + "DownloadAttachment",
+		serviceURL + "DeleteAttachment",
+	}
+
+	return &attachmentRepositoryProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *attachmentRepositoryProtobufClient) GetAttachments(ctx context.Context, in *AttachmentFilter) (*AttachmentCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAttachments")
+	caller := c.callGetAttachments
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AttachmentFilter) (*AttachmentCollection, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AttachmentFilter)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentFilter) when calling interceptor")
+					}
+					return c.callGetAttachments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AttachmentCollection)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AttachmentCollection) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-func (s *orderRepositoryServer) serveGetOrdersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetOrders")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *attachmentRepositoryProtobufClient) callGetAttachments(ctx context.Context, in *AttachmentFilter) (*AttachmentCollection, error) {
+	out := new(AttachmentCollection)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(OrderFilter)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.OrderRepository.GetOrders
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *OrderFilter) (*OrderCollection, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *attachmentRepositoryProtobufClient) UploadAttachment(ctx context.Context, in *AttachmentUpload) (*Attachment, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "UploadAttachment")
+	caller := c.callUploadAttachment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AttachmentUpload) (*Attachment, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*OrderFilter)
+					typedReq, ok := req.(*AttachmentUpload)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*OrderFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentUpload) when calling interceptor")
 					}
-					return s.OrderRepository.GetOrders(ctx, typedReq)
+					return c.callUploadAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*OrderCollection)
+				typedResp, ok := resp.(*Attachment)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*OrderCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Attachment) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *OrderCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *attachmentRepositoryProtobufClient) callUploadAttachment(ctx context.Context, in *AttachmentUpload) (*Attachment, error) {
+	out := new(Attachment)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderCollection and nil error while calling GetOrders. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *orderRepositoryServer) serveFindOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveFindOrderJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveFindOrderProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+func (c *attachmentRepositoryProtobufClient) DownloadAttachment(ctx context.Context, in *AttachmentRequest) (*AttachmentContent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DownloadAttachment")
+	caller := c.callDownloadAttachment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AttachmentRequest) (*AttachmentContent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AttachmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentRequest) when calling interceptor")
+					}
+					return c.callDownloadAttachment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AttachmentContent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AttachmentContent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (s *orderRepositoryServer) serveFindOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindOrder")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *attachmentRepositoryProtobufClient) callDownloadAttachment(ctx context.Context, in *AttachmentRequest) (*AttachmentContent, error) {
+	out := new(AttachmentContent)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(IdRequest)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.OrderRepository.FindOrder
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *IdRequest) (*Order, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *attachmentRepositoryProtobufClient) DeleteAttachment(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAttachment")
+	caller := c.callDeleteAttachment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*IdRequest)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.OrderRepository.FindOrder(ctx, typedReq)
+					return c.callDeleteAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Order)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *Order
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *attachmentRepositoryProtobufClient) callDeleteAttachment(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling FindOrder. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
-	}
+	return out, nil
+}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+// ================================
+// AttachmentRepository JSON Client
+// ================================
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+type attachmentRepositoryJSONClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
 }
 
-func (s *orderRepositoryServer) serveFindOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindOrder")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+// NewAttachmentRepositoryJSONClient creates a JSON client that implements the AttachmentRepository interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAttachmentRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AttachmentRepository {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
 	}
 
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
 	}
-	reqContent := new(IdRequest)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
 
-	handler := s.OrderRepository.FindOrder
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *IdRequest) (*Order, error) {
-			resp, err := s.interceptor(
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "AttachmentRepository")
+	urls := [4]string{
+		serviceURL + "GetAttachments",
+		serviceURL + "UploadAttachment",
+		serviceURL + "DownloadAttachment",
+		serviceURL + "DeleteAttachment",
+	}
+
+	return &attachmentRepositoryJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *attachmentRepositoryJSONClient) GetAttachments(ctx context.Context, in *AttachmentFilter) (*AttachmentCollection, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAttachments")
+	caller := c.callGetAttachments
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AttachmentFilter) (*AttachmentCollection, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*AttachmentFilter)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentFilter) when calling interceptor")
 					}
-					return s.OrderRepository.FindOrder(ctx, typedReq)
+					return c.callGetAttachments(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Order)
+				typedResp, ok := resp.(*AttachmentCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AttachmentCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *Order
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *attachmentRepositoryJSONClient) callGetAttachments(ctx context.Context, in *AttachmentFilter) (*AttachmentCollection, error) {
+	out := new(AttachmentCollection)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling FindOrder. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *orderRepositoryServer) serveGetOrderTypes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveGetOrderTypesJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveGetOrderTypesProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+func (c *attachmentRepositoryJSONClient) UploadAttachment(ctx context.Context, in *AttachmentUpload) (*Attachment, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "UploadAttachment")
+	caller := c.callUploadAttachment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AttachmentUpload) (*Attachment, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AttachmentUpload)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentUpload) when calling interceptor")
+					}
+					return c.callUploadAttachment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Attachment)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Attachment) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (s *orderRepositoryServer) serveGetOrderTypesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetOrderTypes")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *attachmentRepositoryJSONClient) callUploadAttachment(ctx context.Context, in *AttachmentUpload) (*Attachment, error) {
+	out := new(Attachment)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(google_protobuf.Empty)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.OrderRepository.GetOrderTypes
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*OrderTypeCollection, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *attachmentRepositoryJSONClient) DownloadAttachment(ctx context.Context, in *AttachmentRequest) (*AttachmentContent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DownloadAttachment")
+	caller := c.callDownloadAttachment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AttachmentRequest) (*AttachmentContent, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*AttachmentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentRequest) when calling interceptor")
 					}
-					return s.OrderRepository.GetOrderTypes(ctx, typedReq)
+					return c.callDownloadAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*OrderTypeCollection)
+				typedResp, ok := resp.(*AttachmentContent)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*OrderTypeCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AttachmentContent) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *OrderTypeCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderTypeCollection and nil error while calling GetOrderTypes. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
+func (c *attachmentRepositoryJSONClient) callDownloadAttachment(ctx context.Context, in *AttachmentRequest) (*AttachmentContent, error) {
+	out := new(AttachmentContent)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *orderRepositoryServer) serveGetOrderTypesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetOrderTypes")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(google_protobuf.Empty)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
-
-	handler := s.OrderRepository.GetOrderTypes
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*OrderTypeCollection, error) {
-			resp, err := s.interceptor(
+func (c *attachmentRepositoryJSONClient) DeleteAttachment(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAttachment")
+	caller := c.callDeleteAttachment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.OrderRepository.GetOrderTypes(ctx, typedReq)
+					return c.callDeleteAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*OrderTypeCollection)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*OrderTypeCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
+
+func (c *attachmentRepositoryJSONClient) callDeleteAttachment(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===================================
+// AttachmentRepository Server Handler
+// ===================================
+
+type attachmentRepositoryServer struct {
+	AttachmentRepository
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewAttachmentRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAttachmentRepositoryServer(svc AttachmentRepository, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &attachmentRepositoryServer{
+		AttachmentRepository: svc,
+		hooks:                serverOpts.Hooks,
+		interceptor:          twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:           pathPrefix,
+		jsonSkipDefaults:     jsonSkipDefaults,
+		jsonCamelCase:        jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *attachmentRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *attachmentRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// AttachmentRepositoryPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AttachmentRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.AttachmentRepository/"
 
-	// Call service method
-	var respContent *OrderTypeCollection
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
+func (s *attachmentRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
+	ctx = ctxsetters.WithServiceName(ctx, "AttachmentRepository")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
 
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderTypeCollection and nil error while calling GetOrderTypes. nil responses are not supported"))
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "xelbot.com.autonotes.server.AttachmentRepository" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
+	switch method {
+	case "GetAttachments":
+		s.serveGetAttachments(ctx, resp, req)
+		return
+	case "UploadAttachment":
+		s.serveUploadAttachment(ctx, resp, req)
+		return
+	case "DownloadAttachment":
+		s.serveDownloadAttachment(ctx, resp, req)
+		return
+	case "DeleteAttachment":
+		s.serveDeleteAttachment(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
 	}
-	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) serveSaveOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveGetAttachments(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4690,9 +15387,9 @@ func (s *orderRepositoryServer) serveSaveOrder(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSaveOrderJSON(ctx, resp, req)
+		s.serveGetAttachmentsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSaveOrderProtobuf(ctx, resp, req)
+		s.serveGetAttachmentsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4700,9 +15397,9 @@ func (s *orderRepositoryServer) serveSaveOrder(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *orderRepositoryServer) serveSaveOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveGetAttachmentsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveOrder")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAttachments")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4715,29 +15412,29 @@ func (s *orderRepositoryServer) serveSaveOrderJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(Order)
+	reqContent := new(AttachmentFilter)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.OrderRepository.SaveOrder
+	handler := s.AttachmentRepository.GetAttachments
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Order) (*Order, error) {
+		handler = func(ctx context.Context, req *AttachmentFilter) (*AttachmentCollection, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Order)
+					typedReq, ok := req.(*AttachmentFilter)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Order) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentFilter) when calling interceptor")
 					}
-					return s.OrderRepository.SaveOrder(ctx, typedReq)
+					return s.AttachmentRepository.GetAttachments(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Order)
+				typedResp, ok := resp.(*AttachmentCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AttachmentCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4746,7 +15443,7 @@ func (s *orderRepositoryServer) serveSaveOrderJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *Order
+	var respContent *AttachmentCollection
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4757,7 +15454,7 @@ func (s *orderRepositoryServer) serveSaveOrderJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling SaveOrder. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AttachmentCollection and nil error while calling GetAttachments. nil responses are not supported"))
 		return
 	}
 
@@ -4783,9 +15480,9 @@ func (s *orderRepositoryServer) serveSaveOrderJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) serveSaveOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveGetAttachmentsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveOrder")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAttachments")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4797,28 +15494,28 @@ func (s *orderRepositoryServer) serveSaveOrderProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(Order)
+	reqContent := new(AttachmentFilter)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.OrderRepository.SaveOrder
+	handler := s.AttachmentRepository.GetAttachments
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Order) (*Order, error) {
+		handler = func(ctx context.Context, req *AttachmentFilter) (*AttachmentCollection, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Order)
+					typedReq, ok := req.(*AttachmentFilter)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Order) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentFilter) when calling interceptor")
 					}
-					return s.OrderRepository.SaveOrder(ctx, typedReq)
+					return s.AttachmentRepository.GetAttachments(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Order)
+				typedResp, ok := resp.(*AttachmentCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Order) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AttachmentCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4827,7 +15524,7 @@ func (s *orderRepositoryServer) serveSaveOrderProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *Order
+	var respContent *AttachmentCollection
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4838,7 +15535,7 @@ func (s *orderRepositoryServer) serveSaveOrderProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Order and nil error while calling SaveOrder. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AttachmentCollection and nil error while calling GetAttachments. nil responses are not supported"))
 		return
 	}
 
@@ -4862,7 +15559,7 @@ func (s *orderRepositoryServer) serveSaveOrderProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) serveGetExpenses(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveUploadAttachment(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4870,9 +15567,9 @@ func (s *orderRepositoryServer) serveGetExpenses(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetExpensesJSON(ctx, resp, req)
+		s.serveUploadAttachmentJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetExpensesProtobuf(ctx, resp, req)
+		s.serveUploadAttachmentProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4880,9 +15577,9 @@ func (s *orderRepositoryServer) serveGetExpenses(ctx context.Context, resp http.
 	}
 }
 
-func (s *orderRepositoryServer) serveGetExpensesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveUploadAttachmentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetExpenses")
+	ctx = ctxsetters.WithMethodName(ctx, "UploadAttachment")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4895,29 +15592,29 @@ func (s *orderRepositoryServer) serveGetExpensesJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ExpenseFilter)
+	reqContent := new(AttachmentUpload)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.OrderRepository.GetExpenses
+	handler := s.AttachmentRepository.UploadAttachment
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ExpenseFilter) (*ExpenseCollection, error) {
+		handler = func(ctx context.Context, req *AttachmentUpload) (*Attachment, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ExpenseFilter)
+					typedReq, ok := req.(*AttachmentUpload)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ExpenseFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentUpload) when calling interceptor")
 					}
-					return s.OrderRepository.GetExpenses(ctx, typedReq)
+					return s.AttachmentRepository.UploadAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ExpenseCollection)
+				typedResp, ok := resp.(*Attachment)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Attachment) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4926,7 +15623,7 @@ func (s *orderRepositoryServer) serveGetExpensesJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *ExpenseCollection
+	var respContent *Attachment
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4937,7 +15634,7 @@ func (s *orderRepositoryServer) serveGetExpensesJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseCollection and nil error while calling GetExpenses. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Attachment and nil error while calling UploadAttachment. nil responses are not supported"))
 		return
 	}
 
@@ -4963,9 +15660,9 @@ func (s *orderRepositoryServer) serveGetExpensesJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) serveGetExpensesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveUploadAttachmentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetExpenses")
+	ctx = ctxsetters.WithMethodName(ctx, "UploadAttachment")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4977,28 +15674,28 @@ func (s *orderRepositoryServer) serveGetExpensesProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ExpenseFilter)
+	reqContent := new(AttachmentUpload)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.OrderRepository.GetExpenses
+	handler := s.AttachmentRepository.UploadAttachment
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ExpenseFilter) (*ExpenseCollection, error) {
+		handler = func(ctx context.Context, req *AttachmentUpload) (*Attachment, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ExpenseFilter)
+					typedReq, ok := req.(*AttachmentUpload)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ExpenseFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentUpload) when calling interceptor")
 					}
-					return s.OrderRepository.GetExpenses(ctx, typedReq)
+					return s.AttachmentRepository.UploadAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ExpenseCollection)
+				typedResp, ok := resp.(*Attachment)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ExpenseCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Attachment) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5007,7 +15704,7 @@ func (s *orderRepositoryServer) serveGetExpensesProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *ExpenseCollection
+	var respContent *Attachment
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5018,7 +15715,7 @@ func (s *orderRepositoryServer) serveGetExpensesProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExpenseCollection and nil error while calling GetExpenses. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Attachment and nil error while calling UploadAttachment. nil responses are not supported"))
 		return
 	}
 
@@ -5042,7 +15739,7 @@ func (s *orderRepositoryServer) serveGetExpensesProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) serveFindExpense(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveDownloadAttachment(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -5050,9 +15747,9 @@ func (s *orderRepositoryServer) serveFindExpense(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveFindExpenseJSON(ctx, resp, req)
+		s.serveDownloadAttachmentJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveFindExpenseProtobuf(ctx, resp, req)
+		s.serveDownloadAttachmentProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -5060,9 +15757,9 @@ func (s *orderRepositoryServer) serveFindExpense(ctx context.Context, resp http.
 	}
 }
 
-func (s *orderRepositoryServer) serveFindExpenseJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveDownloadAttachmentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindExpense")
+	ctx = ctxsetters.WithMethodName(ctx, "DownloadAttachment")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5075,29 +15772,29 @@ func (s *orderRepositoryServer) serveFindExpenseJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(IdRequest)
+	reqContent := new(AttachmentRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.OrderRepository.FindExpense
+	handler := s.AttachmentRepository.DownloadAttachment
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *IdRequest) (*Expense, error) {
+		handler = func(ctx context.Context, req *AttachmentRequest) (*AttachmentContent, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*AttachmentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentRequest) when calling interceptor")
 					}
-					return s.OrderRepository.FindExpense(ctx, typedReq)
+					return s.AttachmentRepository.DownloadAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Expense)
+				typedResp, ok := resp.(*AttachmentContent)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AttachmentContent) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5106,7 +15803,7 @@ func (s *orderRepositoryServer) serveFindExpenseJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *Expense
+	var respContent *AttachmentContent
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5117,7 +15814,7 @@ func (s *orderRepositoryServer) serveFindExpenseJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Expense and nil error while calling FindExpense. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AttachmentContent and nil error while calling DownloadAttachment. nil responses are not supported"))
 		return
 	}
 
@@ -5143,9 +15840,9 @@ func (s *orderRepositoryServer) serveFindExpenseJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) serveFindExpenseProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveDownloadAttachmentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindExpense")
+	ctx = ctxsetters.WithMethodName(ctx, "DownloadAttachment")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5157,28 +15854,28 @@ func (s *orderRepositoryServer) serveFindExpenseProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(IdRequest)
+	reqContent := new(AttachmentRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.OrderRepository.FindExpense
+	handler := s.AttachmentRepository.DownloadAttachment
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *IdRequest) (*Expense, error) {
+		handler = func(ctx context.Context, req *AttachmentRequest) (*AttachmentContent, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*AttachmentRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AttachmentRequest) when calling interceptor")
 					}
-					return s.OrderRepository.FindExpense(ctx, typedReq)
+					return s.AttachmentRepository.DownloadAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Expense)
+				typedResp, ok := resp.(*AttachmentContent)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AttachmentContent) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5187,7 +15884,7 @@ func (s *orderRepositoryServer) serveFindExpenseProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *Expense
+	var respContent *AttachmentContent
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5198,7 +15895,7 @@ func (s *orderRepositoryServer) serveFindExpenseProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Expense and nil error while calling FindExpense. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AttachmentContent and nil error while calling DownloadAttachment. nil responses are not supported"))
 		return
 	}
 
@@ -5222,7 +15919,7 @@ func (s *orderRepositoryServer) serveFindExpenseProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) serveSaveExpense(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveDeleteAttachment(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -5230,9 +15927,9 @@ func (s *orderRepositoryServer) serveSaveExpense(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSaveExpenseJSON(ctx, resp, req)
+		s.serveDeleteAttachmentJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSaveExpenseProtobuf(ctx, resp, req)
+		s.serveDeleteAttachmentProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -5240,9 +15937,9 @@ func (s *orderRepositoryServer) serveSaveExpense(ctx context.Context, resp http.
 	}
 }
 
-func (s *orderRepositoryServer) serveSaveExpenseJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveDeleteAttachmentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveExpense")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAttachment")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5255,29 +15952,29 @@ func (s *orderRepositoryServer) serveSaveExpenseJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(Expense)
+	reqContent := new(IdRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.OrderRepository.SaveExpense
+	handler := s.AttachmentRepository.DeleteAttachment
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Expense) (*Expense, error) {
+		handler = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Expense)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Expense) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.OrderRepository.SaveExpense(ctx, typedReq)
+					return s.AttachmentRepository.DeleteAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Expense)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5286,7 +15983,7 @@ func (s *orderRepositoryServer) serveSaveExpenseJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *Expense
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5297,7 +15994,7 @@ func (s *orderRepositoryServer) serveSaveExpenseJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Expense and nil error while calling SaveExpense. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteAttachment. nil responses are not supported"))
 		return
 	}
 
@@ -5323,9 +16020,9 @@ func (s *orderRepositoryServer) serveSaveExpenseJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) serveSaveExpenseProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *attachmentRepositoryServer) serveDeleteAttachmentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveExpense")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAttachment")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -5337,28 +16034,28 @@ func (s *orderRepositoryServer) serveSaveExpenseProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(Expense)
+	reqContent := new(IdRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.OrderRepository.SaveExpense
+	handler := s.AttachmentRepository.DeleteAttachment
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Expense) (*Expense, error) {
+		handler = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Expense)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Expense) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.OrderRepository.SaveExpense(ctx, typedReq)
+					return s.AttachmentRepository.DeleteAttachment(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Expense)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Expense) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5367,7 +16064,7 @@ func (s *orderRepositoryServer) serveSaveExpenseProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *Expense
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5378,7 +16075,7 @@ func (s *orderRepositoryServer) serveSaveExpenseProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Expense and nil error while calling SaveExpense. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteAttachment. nil responses are not supported"))
 		return
 	}
 
@@ -5402,51 +16099,53 @@ func (s *orderRepositoryServer) serveSaveExpenseProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *orderRepositoryServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 2
+func (s *attachmentRepositoryServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 5
 }
 
-func (s *orderRepositoryServer) ProtocGenTwirpVersion() string {
+func (s *attachmentRepositoryServer) ProtocGenTwirpVersion() string {
 	return "v8.1.3"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
 // that is everything in a Twirp route except for the <Method>. This can be used for routing,
 // for example to identify the requests that are targeted to this service in a mux.
-func (s *orderRepositoryServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "OrderRepository")
+func (s *attachmentRepositoryServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "AttachmentRepository")
 }
 
 // =======================
-// CarRepository Interface
+// TagRepository Interface
 // =======================
 
-type CarRepository interface {
-	GetServices(context.Context, *ServiceFilter) (*ServiceCollection, error)
+type TagRepository interface {
+	GetTags(context.Context, *google_protobuf.Empty) (*TagCollection, error)
 
-	FindService(context.Context, *IdRequest) (*Service, error)
+	// creates a tag without id, renames it otherwise
+	SaveTag(context.Context, *Tag) (*Tag, error)
 
-	SaveService(context.Context, *Service) (*Service, error)
+	// detaches the tag from the records, the records are kept
+	DeleteTag(context.Context, *IdRequest) (*google_protobuf.Empty, error)
 
-	GetMileages(context.Context, *MileageFilter) (*MileageCollection, error)
+	SetRecordTags(context.Context, *RecordTags) (*TagCollection, error)
 
-	SaveMileage(context.Context, *Mileage) (*Mileage, error)
+	GetTagSummary(context.Context, *IdRequest) (*TagSummary, error)
 }
 
 // =============================
-// CarRepository Protobuf Client
+// TagRepository Protobuf Client
 // =============================
 
-type carRepositoryProtobufClient struct {
+type tagRepositoryProtobufClient struct {
 	client      HTTPClient
 	urls        [5]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewCarRepositoryProtobufClient creates a Protobuf client that implements the CarRepository interface.
+// NewTagRepositoryProtobufClient creates a Protobuf client that implements the TagRepository interface.
 // It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewCarRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) CarRepository {
+func NewTagRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TagRepository {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -5466,16 +16165,16 @@ func NewCarRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...t
 
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "CarRepository")
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "TagRepository")
 	urls := [5]string{
-		serviceURL + "GetServices",
-		serviceURL + "FindService",
-		serviceURL + "SaveService",
-		serviceURL + "GetMileages",
-		serviceURL + "SaveMileage",
+		serviceURL + "GetTags",
+		serviceURL + "SaveTag",
+		serviceURL + "DeleteTag",
+		serviceURL + "SetRecordTags",
+		serviceURL + "GetTagSummary",
 	}
 
-	return &carRepositoryProtobufClient{
+	return &tagRepositoryProtobufClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -5483,26 +16182,26 @@ func NewCarRepositoryProtobufClient(baseURL string, client HTTPClient, opts ...t
 	}
 }
 
-func (c *carRepositoryProtobufClient) GetServices(ctx context.Context, in *ServiceFilter) (*ServiceCollection, error) {
+func (c *tagRepositoryProtobufClient) GetTags(ctx context.Context, in *google_protobuf.Empty) (*TagCollection, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetServices")
-	caller := c.callGetServices
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTags")
+	caller := c.callGetTags
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ServiceFilter) (*ServiceCollection, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*TagCollection, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ServiceFilter)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ServiceFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callGetServices(ctx, typedReq)
+					return c.callGetTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ServiceCollection)
+				typedResp, ok := resp.(*TagCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ServiceCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5512,8 +16211,8 @@ func (c *carRepositoryProtobufClient) GetServices(ctx context.Context, in *Servi
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryProtobufClient) callGetServices(ctx context.Context, in *ServiceFilter) (*ServiceCollection, error) {
-	out := new(ServiceCollection)
+func (c *tagRepositoryProtobufClient) callGetTags(ctx context.Context, in *google_protobuf.Empty) (*TagCollection, error) {
+	out := new(TagCollection)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5529,26 +16228,26 @@ func (c *carRepositoryProtobufClient) callGetServices(ctx context.Context, in *S
 	return out, nil
 }
 
-func (c *carRepositoryProtobufClient) FindService(ctx context.Context, in *IdRequest) (*Service, error) {
+func (c *tagRepositoryProtobufClient) SaveTag(ctx context.Context, in *Tag) (*Tag, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "FindService")
-	caller := c.callFindService
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveTag")
+	caller := c.callSaveTag
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *IdRequest) (*Service, error) {
+		caller = func(ctx context.Context, req *Tag) (*Tag, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*Tag)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*Tag) when calling interceptor")
 					}
-					return c.callFindService(ctx, typedReq)
+					return c.callSaveTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Service)
+				typedResp, ok := resp.(*Tag)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Tag) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5558,8 +16257,8 @@ func (c *carRepositoryProtobufClient) FindService(ctx context.Context, in *IdReq
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryProtobufClient) callFindService(ctx context.Context, in *IdRequest) (*Service, error) {
-	out := new(Service)
+func (c *tagRepositoryProtobufClient) callSaveTag(ctx context.Context, in *Tag) (*Tag, error) {
+	out := new(Tag)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5575,26 +16274,26 @@ func (c *carRepositoryProtobufClient) callFindService(ctx context.Context, in *I
 	return out, nil
 }
 
-func (c *carRepositoryProtobufClient) SaveService(ctx context.Context, in *Service) (*Service, error) {
+func (c *tagRepositoryProtobufClient) DeleteTag(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveService")
-	caller := c.callSaveService
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTag")
+	caller := c.callDeleteTag
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Service) (*Service, error) {
+		caller = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Service)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Service) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return c.callSaveService(ctx, typedReq)
+					return c.callDeleteTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Service)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5604,8 +16303,8 @@ func (c *carRepositoryProtobufClient) SaveService(ctx context.Context, in *Servi
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryProtobufClient) callSaveService(ctx context.Context, in *Service) (*Service, error) {
-	out := new(Service)
+func (c *tagRepositoryProtobufClient) callDeleteTag(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5621,26 +16320,26 @@ func (c *carRepositoryProtobufClient) callSaveService(ctx context.Context, in *S
 	return out, nil
 }
 
-func (c *carRepositoryProtobufClient) GetMileages(ctx context.Context, in *MileageFilter) (*MileageCollection, error) {
+func (c *tagRepositoryProtobufClient) SetRecordTags(ctx context.Context, in *RecordTags) (*TagCollection, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetMileages")
-	caller := c.callGetMileages
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRecordTags")
+	caller := c.callSetRecordTags
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *MileageFilter) (*MileageCollection, error) {
+		caller = func(ctx context.Context, req *RecordTags) (*TagCollection, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*MileageFilter)
+					typedReq, ok := req.(*RecordTags)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*MileageFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RecordTags) when calling interceptor")
 					}
-					return c.callGetMileages(ctx, typedReq)
+					return c.callSetRecordTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*MileageCollection)
+				typedResp, ok := resp.(*TagCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*MileageCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5650,8 +16349,8 @@ func (c *carRepositoryProtobufClient) GetMileages(ctx context.Context, in *Milea
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryProtobufClient) callGetMileages(ctx context.Context, in *MileageFilter) (*MileageCollection, error) {
-	out := new(MileageCollection)
+func (c *tagRepositoryProtobufClient) callSetRecordTags(ctx context.Context, in *RecordTags) (*TagCollection, error) {
+	out := new(TagCollection)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5667,26 +16366,26 @@ func (c *carRepositoryProtobufClient) callGetMileages(ctx context.Context, in *M
 	return out, nil
 }
 
-func (c *carRepositoryProtobufClient) SaveMileage(ctx context.Context, in *Mileage) (*Mileage, error) {
+func (c *tagRepositoryProtobufClient) GetTagSummary(ctx context.Context, in *IdRequest) (*TagSummary, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveMileage")
-	caller := c.callSaveMileage
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTagSummary")
+	caller := c.callGetTagSummary
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Mileage) (*Mileage, error) {
+		caller = func(ctx context.Context, req *IdRequest) (*TagSummary, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Mileage)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Mileage) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return c.callSaveMileage(ctx, typedReq)
+					return c.callGetTagSummary(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Mileage)
+				typedResp, ok := resp.(*TagSummary)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Mileage) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagSummary) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5696,8 +16395,8 @@ func (c *carRepositoryProtobufClient) SaveMileage(ctx context.Context, in *Milea
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryProtobufClient) callSaveMileage(ctx context.Context, in *Mileage) (*Mileage, error) {
-	out := new(Mileage)
+func (c *tagRepositoryProtobufClient) callGetTagSummary(ctx context.Context, in *IdRequest) (*TagSummary, error) {
+	out := new(TagSummary)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5714,19 +16413,19 @@ func (c *carRepositoryProtobufClient) callSaveMileage(ctx context.Context, in *M
 }
 
 // =========================
-// CarRepository JSON Client
+// TagRepository JSON Client
 // =========================
 
-type carRepositoryJSONClient struct {
+type tagRepositoryJSONClient struct {
 	client      HTTPClient
 	urls        [5]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewCarRepositoryJSONClient creates a JSON client that implements the CarRepository interface.
+// NewTagRepositoryJSONClient creates a JSON client that implements the TagRepository interface.
 // It communicates using JSON and can be configured with a custom HTTPClient.
-func NewCarRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) CarRepository {
+func NewTagRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TagRepository {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -5746,16 +16445,16 @@ func NewCarRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp
 
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "CarRepository")
+	serviceURL += baseServicePath(pathPrefix, "xelbot.com.autonotes.server", "TagRepository")
 	urls := [5]string{
-		serviceURL + "GetServices",
-		serviceURL + "FindService",
-		serviceURL + "SaveService",
-		serviceURL + "GetMileages",
-		serviceURL + "SaveMileage",
+		serviceURL + "GetTags",
+		serviceURL + "SaveTag",
+		serviceURL + "DeleteTag",
+		serviceURL + "SetRecordTags",
+		serviceURL + "GetTagSummary",
 	}
 
-	return &carRepositoryJSONClient{
+	return &tagRepositoryJSONClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -5763,26 +16462,26 @@ func NewCarRepositoryJSONClient(baseURL string, client HTTPClient, opts ...twirp
 	}
 }
 
-func (c *carRepositoryJSONClient) GetServices(ctx context.Context, in *ServiceFilter) (*ServiceCollection, error) {
+func (c *tagRepositoryJSONClient) GetTags(ctx context.Context, in *google_protobuf.Empty) (*TagCollection, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetServices")
-	caller := c.callGetServices
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTags")
+	caller := c.callGetTags
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ServiceFilter) (*ServiceCollection, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*TagCollection, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ServiceFilter)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ServiceFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callGetServices(ctx, typedReq)
+					return c.callGetTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ServiceCollection)
+				typedResp, ok := resp.(*TagCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ServiceCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5792,8 +16491,8 @@ func (c *carRepositoryJSONClient) GetServices(ctx context.Context, in *ServiceFi
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryJSONClient) callGetServices(ctx context.Context, in *ServiceFilter) (*ServiceCollection, error) {
-	out := new(ServiceCollection)
+func (c *tagRepositoryJSONClient) callGetTags(ctx context.Context, in *google_protobuf.Empty) (*TagCollection, error) {
+	out := new(TagCollection)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5809,26 +16508,26 @@ func (c *carRepositoryJSONClient) callGetServices(ctx context.Context, in *Servi
 	return out, nil
 }
 
-func (c *carRepositoryJSONClient) FindService(ctx context.Context, in *IdRequest) (*Service, error) {
+func (c *tagRepositoryJSONClient) SaveTag(ctx context.Context, in *Tag) (*Tag, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "FindService")
-	caller := c.callFindService
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveTag")
+	caller := c.callSaveTag
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *IdRequest) (*Service, error) {
+		caller = func(ctx context.Context, req *Tag) (*Tag, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*Tag)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*Tag) when calling interceptor")
 					}
-					return c.callFindService(ctx, typedReq)
+					return c.callSaveTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Service)
+				typedResp, ok := resp.(*Tag)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Tag) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5838,8 +16537,8 @@ func (c *carRepositoryJSONClient) FindService(ctx context.Context, in *IdRequest
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryJSONClient) callFindService(ctx context.Context, in *IdRequest) (*Service, error) {
-	out := new(Service)
+func (c *tagRepositoryJSONClient) callSaveTag(ctx context.Context, in *Tag) (*Tag, error) {
+	out := new(Tag)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5855,26 +16554,26 @@ func (c *carRepositoryJSONClient) callFindService(ctx context.Context, in *IdReq
 	return out, nil
 }
 
-func (c *carRepositoryJSONClient) SaveService(ctx context.Context, in *Service) (*Service, error) {
+func (c *tagRepositoryJSONClient) DeleteTag(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveService")
-	caller := c.callSaveService
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTag")
+	caller := c.callDeleteTag
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Service) (*Service, error) {
+		caller = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Service)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Service) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return c.callSaveService(ctx, typedReq)
+					return c.callDeleteTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Service)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5884,8 +16583,8 @@ func (c *carRepositoryJSONClient) SaveService(ctx context.Context, in *Service)
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryJSONClient) callSaveService(ctx context.Context, in *Service) (*Service, error) {
-	out := new(Service)
+func (c *tagRepositoryJSONClient) callDeleteTag(ctx context.Context, in *IdRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5901,26 +16600,26 @@ func (c *carRepositoryJSONClient) callSaveService(ctx context.Context, in *Servi
 	return out, nil
 }
 
-func (c *carRepositoryJSONClient) GetMileages(ctx context.Context, in *MileageFilter) (*MileageCollection, error) {
+func (c *tagRepositoryJSONClient) SetRecordTags(ctx context.Context, in *RecordTags) (*TagCollection, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "GetMileages")
-	caller := c.callGetMileages
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRecordTags")
+	caller := c.callSetRecordTags
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *MileageFilter) (*MileageCollection, error) {
+		caller = func(ctx context.Context, req *RecordTags) (*TagCollection, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*MileageFilter)
+					typedReq, ok := req.(*RecordTags)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*MileageFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RecordTags) when calling interceptor")
 					}
-					return c.callGetMileages(ctx, typedReq)
+					return c.callSetRecordTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*MileageCollection)
+				typedResp, ok := resp.(*TagCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*MileageCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5930,8 +16629,8 @@ func (c *carRepositoryJSONClient) GetMileages(ctx context.Context, in *MileageFi
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryJSONClient) callGetMileages(ctx context.Context, in *MileageFilter) (*MileageCollection, error) {
-	out := new(MileageCollection)
+func (c *tagRepositoryJSONClient) callSetRecordTags(ctx context.Context, in *RecordTags) (*TagCollection, error) {
+	out := new(TagCollection)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5947,26 +16646,26 @@ func (c *carRepositoryJSONClient) callGetMileages(ctx context.Context, in *Milea
 	return out, nil
 }
 
-func (c *carRepositoryJSONClient) SaveMileage(ctx context.Context, in *Mileage) (*Mileage, error) {
+func (c *tagRepositoryJSONClient) GetTagSummary(ctx context.Context, in *IdRequest) (*TagSummary, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
-	ctx = ctxsetters.WithMethodName(ctx, "SaveMileage")
-	caller := c.callSaveMileage
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTagSummary")
+	caller := c.callGetTagSummary
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Mileage) (*Mileage, error) {
+		caller = func(ctx context.Context, req *IdRequest) (*TagSummary, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Mileage)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Mileage) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return c.callSaveMileage(ctx, typedReq)
+					return c.callGetTagSummary(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Mileage)
+				typedResp, ok := resp.(*TagSummary)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Mileage) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagSummary) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5976,8 +16675,8 @@ func (c *carRepositoryJSONClient) SaveMileage(ctx context.Context, in *Mileage)
 	return caller(ctx, in)
 }
 
-func (c *carRepositoryJSONClient) callSaveMileage(ctx context.Context, in *Mileage) (*Mileage, error) {
-	out := new(Mileage)
+func (c *tagRepositoryJSONClient) callGetTagSummary(ctx context.Context, in *IdRequest) (*TagSummary, error) {
+	out := new(TagSummary)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -5994,11 +16693,11 @@ func (c *carRepositoryJSONClient) callSaveMileage(ctx context.Context, in *Milea
 }
 
 // ============================
-// CarRepository Server Handler
+// TagRepository Server Handler
 // ============================
 
-type carRepositoryServer struct {
-	CarRepository
+type tagRepositoryServer struct {
+	TagRepository
 	interceptor      twirp.Interceptor
 	hooks            *twirp.ServerHooks
 	pathPrefix       string // prefix for routing
@@ -6006,10 +16705,10 @@ type carRepositoryServer struct {
 	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
 }
 
-// NewCarRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
+// NewTagRepositoryServer builds a TwirpServer that can be used as an http.Handler to handle
 // HTTP requests that are routed to the right method in the provided svc implementation.
 // The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewCarRepositoryServer(svc CarRepository, opts ...interface{}) TwirpServer {
+func NewTagRepositoryServer(svc TagRepository, opts ...interface{}) TwirpServer {
 	serverOpts := newServerOpts(opts)
 
 	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
@@ -6022,8 +16721,8 @@ func NewCarRepositoryServer(svc CarRepository, opts ...interface{}) TwirpServer
 		pathPrefix = "/twirp" // default prefix
 	}
 
-	return &carRepositoryServer{
-		CarRepository:    svc,
+	return &tagRepositoryServer{
+		TagRepository:    svc,
 		hooks:            serverOpts.Hooks,
 		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
 		pathPrefix:       pathPrefix,
@@ -6034,12 +16733,12 @@ func NewCarRepositoryServer(svc CarRepository, opts ...interface{}) TwirpServer
 
 // writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
 // If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *carRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+func (s *tagRepositoryServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
 	writeError(ctx, resp, err, s.hooks)
 }
 
 // handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *carRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+func (s *tagRepositoryServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
 	if context.Canceled == ctx.Err() {
 		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
 		return
@@ -6051,16 +16750,16 @@ func (s *carRepositoryServer) handleRequestBodyError(ctx context.Context, resp h
 	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
 }
 
-// CarRepositoryPathPrefix is a convenience constant that may identify URL paths.
+// TagRepositoryPathPrefix is a convenience constant that may identify URL paths.
 // Should be used with caution, it only matches routes generated by Twirp Go clients,
 // with the default "/twirp" prefix and default CamelCase service and method names.
 // More info: https://twitchtv.github.io/twirp/docs/routing.html
-const CarRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.CarRepository/"
+const TagRepositoryPathPrefix = "/twirp/xelbot.com.autonotes.server.TagRepository/"
 
-func (s *carRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	ctx = ctxsetters.WithPackageName(ctx, "xelbot.com.autonotes.server")
-	ctx = ctxsetters.WithServiceName(ctx, "CarRepository")
+	ctx = ctxsetters.WithServiceName(ctx, "TagRepository")
 	ctx = ctxsetters.WithResponseWriter(ctx, resp)
 
 	var err error
@@ -6078,7 +16777,7 @@ func (s *carRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Requ
 
 	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
 	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "xelbot.com.autonotes.server.CarRepository" {
+	if pkgService != "xelbot.com.autonotes.server.TagRepository" {
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
@@ -6090,20 +16789,20 @@ func (s *carRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Requ
 	}
 
 	switch method {
-	case "GetServices":
-		s.serveGetServices(ctx, resp, req)
+	case "GetTags":
+		s.serveGetTags(ctx, resp, req)
 		return
-	case "FindService":
-		s.serveFindService(ctx, resp, req)
+	case "SaveTag":
+		s.serveSaveTag(ctx, resp, req)
 		return
-	case "SaveService":
-		s.serveSaveService(ctx, resp, req)
+	case "DeleteTag":
+		s.serveDeleteTag(ctx, resp, req)
 		return
-	case "GetMileages":
-		s.serveGetMileages(ctx, resp, req)
+	case "SetRecordTags":
+		s.serveSetRecordTags(ctx, resp, req)
 		return
-	case "SaveMileage":
-		s.serveSaveMileage(ctx, resp, req)
+	case "GetTagSummary":
+		s.serveGetTagSummary(ctx, resp, req)
 		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
@@ -6112,7 +16811,7 @@ func (s *carRepositoryServer) ServeHTTP(resp http.ResponseWriter, req *http.Requ
 	}
 }
 
-func (s *carRepositoryServer) serveGetServices(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveGetTags(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6120,9 +16819,9 @@ func (s *carRepositoryServer) serveGetServices(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetServicesJSON(ctx, resp, req)
+		s.serveGetTagsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetServicesProtobuf(ctx, resp, req)
+		s.serveGetTagsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6130,9 +16829,9 @@ func (s *carRepositoryServer) serveGetServices(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *carRepositoryServer) serveGetServicesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveGetTagsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetServices")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTags")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6145,29 +16844,29 @@ func (s *carRepositoryServer) serveGetServicesJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ServiceFilter)
+	reqContent := new(google_protobuf.Empty)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.CarRepository.GetServices
+	handler := s.TagRepository.GetTags
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ServiceFilter) (*ServiceCollection, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*TagCollection, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ServiceFilter)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ServiceFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.CarRepository.GetServices(ctx, typedReq)
+					return s.TagRepository.GetTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ServiceCollection)
+				typedResp, ok := resp.(*TagCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ServiceCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6176,7 +16875,7 @@ func (s *carRepositoryServer) serveGetServicesJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *ServiceCollection
+	var respContent *TagCollection
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6187,7 +16886,7 @@ func (s *carRepositoryServer) serveGetServicesJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ServiceCollection and nil error while calling GetServices. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TagCollection and nil error while calling GetTags. nil responses are not supported"))
 		return
 	}
 
@@ -6213,9 +16912,9 @@ func (s *carRepositoryServer) serveGetServicesJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveGetServicesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveGetTagsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetServices")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTags")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6227,28 +16926,28 @@ func (s *carRepositoryServer) serveGetServicesProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ServiceFilter)
+	reqContent := new(google_protobuf.Empty)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.CarRepository.GetServices
+	handler := s.TagRepository.GetTags
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ServiceFilter) (*ServiceCollection, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*TagCollection, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ServiceFilter)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ServiceFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.CarRepository.GetServices(ctx, typedReq)
+					return s.TagRepository.GetTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ServiceCollection)
+				typedResp, ok := resp.(*TagCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ServiceCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6257,7 +16956,7 @@ func (s *carRepositoryServer) serveGetServicesProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *ServiceCollection
+	var respContent *TagCollection
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6268,7 +16967,7 @@ func (s *carRepositoryServer) serveGetServicesProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ServiceCollection and nil error while calling GetServices. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TagCollection and nil error while calling GetTags. nil responses are not supported"))
 		return
 	}
 
@@ -6292,7 +16991,7 @@ func (s *carRepositoryServer) serveGetServicesProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveFindService(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveSaveTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6300,9 +16999,9 @@ func (s *carRepositoryServer) serveFindService(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveFindServiceJSON(ctx, resp, req)
+		s.serveSaveTagJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveFindServiceProtobuf(ctx, resp, req)
+		s.serveSaveTagProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6310,9 +17009,9 @@ func (s *carRepositoryServer) serveFindService(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *carRepositoryServer) serveFindServiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveSaveTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindService")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveTag")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6325,29 +17024,29 @@ func (s *carRepositoryServer) serveFindServiceJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(IdRequest)
+	reqContent := new(Tag)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.CarRepository.FindService
+	handler := s.TagRepository.SaveTag
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *IdRequest) (*Service, error) {
+		handler = func(ctx context.Context, req *Tag) (*Tag, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*Tag)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*Tag) when calling interceptor")
 					}
-					return s.CarRepository.FindService(ctx, typedReq)
+					return s.TagRepository.SaveTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Service)
+				typedResp, ok := resp.(*Tag)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Tag) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6356,7 +17055,7 @@ func (s *carRepositoryServer) serveFindServiceJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *Service
+	var respContent *Tag
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6367,7 +17066,7 @@ func (s *carRepositoryServer) serveFindServiceJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Service and nil error while calling FindService. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Tag and nil error while calling SaveTag. nil responses are not supported"))
 		return
 	}
 
@@ -6393,9 +17092,9 @@ func (s *carRepositoryServer) serveFindServiceJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveFindServiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveSaveTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindService")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveTag")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6407,28 +17106,28 @@ func (s *carRepositoryServer) serveFindServiceProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(IdRequest)
+	reqContent := new(Tag)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.CarRepository.FindService
+	handler := s.TagRepository.SaveTag
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *IdRequest) (*Service, error) {
+		handler = func(ctx context.Context, req *Tag) (*Tag, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*IdRequest)
+					typedReq, ok := req.(*Tag)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*Tag) when calling interceptor")
 					}
-					return s.CarRepository.FindService(ctx, typedReq)
+					return s.TagRepository.SaveTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Service)
+				typedResp, ok := resp.(*Tag)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Tag) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6437,7 +17136,7 @@ func (s *carRepositoryServer) serveFindServiceProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *Service
+	var respContent *Tag
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6448,7 +17147,7 @@ func (s *carRepositoryServer) serveFindServiceProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Service and nil error while calling FindService. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Tag and nil error while calling SaveTag. nil responses are not supported"))
 		return
 	}
 
@@ -6472,7 +17171,7 @@ func (s *carRepositoryServer) serveFindServiceProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveSaveService(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveDeleteTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6480,9 +17179,9 @@ func (s *carRepositoryServer) serveSaveService(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSaveServiceJSON(ctx, resp, req)
+		s.serveDeleteTagJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSaveServiceProtobuf(ctx, resp, req)
+		s.serveDeleteTagProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6490,9 +17189,9 @@ func (s *carRepositoryServer) serveSaveService(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *carRepositoryServer) serveSaveServiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveDeleteTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTag")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6505,29 +17204,29 @@ func (s *carRepositoryServer) serveSaveServiceJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(Service)
+	reqContent := new(IdRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.CarRepository.SaveService
+	handler := s.TagRepository.DeleteTag
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Service) (*Service, error) {
+		handler = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Service)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Service) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.CarRepository.SaveService(ctx, typedReq)
+					return s.TagRepository.DeleteTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Service)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6536,7 +17235,7 @@ func (s *carRepositoryServer) serveSaveServiceJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *Service
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6547,7 +17246,7 @@ func (s *carRepositoryServer) serveSaveServiceJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Service and nil error while calling SaveService. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteTag. nil responses are not supported"))
 		return
 	}
 
@@ -6573,9 +17272,9 @@ func (s *carRepositoryServer) serveSaveServiceJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveSaveServiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveDeleteTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTag")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6587,28 +17286,28 @@ func (s *carRepositoryServer) serveSaveServiceProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(Service)
+	reqContent := new(IdRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.CarRepository.SaveService
+	handler := s.TagRepository.DeleteTag
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Service) (*Service, error) {
+		handler = func(ctx context.Context, req *IdRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Service)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Service) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.CarRepository.SaveService(ctx, typedReq)
+					return s.TagRepository.DeleteTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Service)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Service) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6617,7 +17316,7 @@ func (s *carRepositoryServer) serveSaveServiceProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *Service
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6628,7 +17327,7 @@ func (s *carRepositoryServer) serveSaveServiceProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Service and nil error while calling SaveService. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteTag. nil responses are not supported"))
 		return
 	}
 
@@ -6652,7 +17351,7 @@ func (s *carRepositoryServer) serveSaveServiceProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveGetMileages(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveSetRecordTags(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6660,9 +17359,9 @@ func (s *carRepositoryServer) serveGetMileages(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetMileagesJSON(ctx, resp, req)
+		s.serveSetRecordTagsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetMileagesProtobuf(ctx, resp, req)
+		s.serveSetRecordTagsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6670,9 +17369,9 @@ func (s *carRepositoryServer) serveGetMileages(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *carRepositoryServer) serveGetMileagesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveSetRecordTagsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetMileages")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRecordTags")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6685,29 +17384,29 @@ func (s *carRepositoryServer) serveGetMileagesJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(MileageFilter)
+	reqContent := new(RecordTags)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.CarRepository.GetMileages
+	handler := s.TagRepository.SetRecordTags
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *MileageFilter) (*MileageCollection, error) {
+		handler = func(ctx context.Context, req *RecordTags) (*TagCollection, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*MileageFilter)
+					typedReq, ok := req.(*RecordTags)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*MileageFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RecordTags) when calling interceptor")
 					}
-					return s.CarRepository.GetMileages(ctx, typedReq)
+					return s.TagRepository.SetRecordTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*MileageCollection)
+				typedResp, ok := resp.(*TagCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*MileageCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6716,7 +17415,7 @@ func (s *carRepositoryServer) serveGetMileagesJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *MileageCollection
+	var respContent *TagCollection
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6727,7 +17426,7 @@ func (s *carRepositoryServer) serveGetMileagesJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *MileageCollection and nil error while calling GetMileages. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TagCollection and nil error while calling SetRecordTags. nil responses are not supported"))
 		return
 	}
 
@@ -6753,9 +17452,9 @@ func (s *carRepositoryServer) serveGetMileagesJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveGetMileagesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveSetRecordTagsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetMileages")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRecordTags")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6767,28 +17466,28 @@ func (s *carRepositoryServer) serveGetMileagesProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(MileageFilter)
+	reqContent := new(RecordTags)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.CarRepository.GetMileages
+	handler := s.TagRepository.SetRecordTags
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *MileageFilter) (*MileageCollection, error) {
+		handler = func(ctx context.Context, req *RecordTags) (*TagCollection, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*MileageFilter)
+					typedReq, ok := req.(*RecordTags)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*MileageFilter) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RecordTags) when calling interceptor")
 					}
-					return s.CarRepository.GetMileages(ctx, typedReq)
+					return s.TagRepository.SetRecordTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*MileageCollection)
+				typedResp, ok := resp.(*TagCollection)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*MileageCollection) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagCollection) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6797,7 +17496,7 @@ func (s *carRepositoryServer) serveGetMileagesProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *MileageCollection
+	var respContent *TagCollection
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6808,7 +17507,7 @@ func (s *carRepositoryServer) serveGetMileagesProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *MileageCollection and nil error while calling GetMileages. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TagCollection and nil error while calling SetRecordTags. nil responses are not supported"))
 		return
 	}
 
@@ -6832,7 +17531,7 @@ func (s *carRepositoryServer) serveGetMileagesProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveSaveMileage(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveGetTagSummary(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -6840,9 +17539,9 @@ func (s *carRepositoryServer) serveSaveMileage(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSaveMileageJSON(ctx, resp, req)
+		s.serveGetTagSummaryJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSaveMileageProtobuf(ctx, resp, req)
+		s.serveGetTagSummaryProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -6850,9 +17549,9 @@ func (s *carRepositoryServer) serveSaveMileage(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *carRepositoryServer) serveSaveMileageJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveGetTagSummaryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveMileage")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTagSummary")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6865,29 +17564,29 @@ func (s *carRepositoryServer) serveSaveMileageJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(Mileage)
+	reqContent := new(IdRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.CarRepository.SaveMileage
+	handler := s.TagRepository.GetTagSummary
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Mileage) (*Mileage, error) {
+		handler = func(ctx context.Context, req *IdRequest) (*TagSummary, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Mileage)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Mileage) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.CarRepository.SaveMileage(ctx, typedReq)
+					return s.TagRepository.GetTagSummary(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Mileage)
+				typedResp, ok := resp.(*TagSummary)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Mileage) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagSummary) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6896,7 +17595,7 @@ func (s *carRepositoryServer) serveSaveMileageJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *Mileage
+	var respContent *TagSummary
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6907,7 +17606,7 @@ func (s *carRepositoryServer) serveSaveMileageJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Mileage and nil error while calling SaveMileage. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TagSummary and nil error while calling GetTagSummary. nil responses are not supported"))
 		return
 	}
 
@@ -6933,9 +17632,9 @@ func (s *carRepositoryServer) serveSaveMileageJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) serveSaveMileageProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagRepositoryServer) serveGetTagSummaryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SaveMileage")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTagSummary")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -6947,28 +17646,28 @@ func (s *carRepositoryServer) serveSaveMileageProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(Mileage)
+	reqContent := new(IdRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.CarRepository.SaveMileage
+	handler := s.TagRepository.GetTagSummary
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Mileage) (*Mileage, error) {
+		handler = func(ctx context.Context, req *IdRequest) (*TagSummary, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Mileage)
+					typedReq, ok := req.(*IdRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Mileage) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IdRequest) when calling interceptor")
 					}
-					return s.CarRepository.SaveMileage(ctx, typedReq)
+					return s.TagRepository.GetTagSummary(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Mileage)
+				typedResp, ok := resp.(*TagSummary)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Mileage) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*TagSummary) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -6977,7 +17676,7 @@ func (s *carRepositoryServer) serveSaveMileageProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *Mileage
+	var respContent *TagSummary
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -6988,7 +17687,7 @@ func (s *carRepositoryServer) serveSaveMileageProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Mileage and nil error while calling SaveMileage. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TagSummary and nil error while calling GetTagSummary. nil responses are not supported"))
 		return
 	}
 
@@ -7012,19 +17711,19 @@ func (s *carRepositoryServer) serveSaveMileageProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *carRepositoryServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 3
+func (s *tagRepositoryServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 6
 }
 
-func (s *carRepositoryServer) ProtocGenTwirpVersion() string {
+func (s *tagRepositoryServer) ProtocGenTwirpVersion() string {
 	return "v8.1.3"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
 // that is everything in a Twirp route except for the <Method>. This can be used for routing,
 // for example to identify the requests that are targeted to this service in a mux.
-func (s *carRepositoryServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "CarRepository")
+func (s *tagRepositoryServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "xelbot.com.autonotes.server", "TagRepository")
 }
 
 // =====
@@ -7593,107 +18292,355 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 1629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x92, 0xdb, 0xc4,
-	0x16, 0x8e, 0x2c, 0xc9, 0x3f, 0xc7, 0x99, 0x19, 0xa7, 0xef, 0xcd, 0xbd, 0x2e, 0xa7, 0x6e, 0x65,
-	0xa2, 0xba, 0x90, 0x61, 0x12, 0x3c, 0xc1, 0x21, 0x05, 0x09, 0x29, 0xc0, 0x38, 0x1e, 0x67, 0x0a,
-	0x66, 0xc6, 0x91, 0x9d, 0xca, 0x6f, 0xd5, 0xa0, 0x48, 0x3d, 0x46, 0x94, 0x6c, 0x19, 0xa9, 0x3d,
-	0x15, 0x3f, 0x00, 0x1b, 0x16, 0x2c, 0x61, 0x4b, 0x51, 0x6c, 0xd9, 0xf3, 0x0c, 0x6c, 0xd8, 0xc0,
-	0x82, 0x2d, 0x4f, 0xc1, 0x82, 0x05, 0xd5, 0xad, 0x6e, 0x45, 0xf2, 0x64, 0xec, 0xd6, 0xcc, 0xc0,
-	0x82, 0x9d, 0xfa, 0xf8, 0x9c, 0xa3, 0xee, 0xef, 0xfb, 0xba, 0xfb, 0x1c, 0x19, 0xce, 0x86, 0x38,
-	0x38, 0xc0, 0x41, 0x7d, 0x1c, 0xf8, 0xc4, 0x47, 0x17, 0x9e, 0x63, 0xef, 0x99, 0x4f, 0xea, 0xb6,
-	0x3f, 0xac, 0x5b, 0x13, 0xe2, 0x8f, 0x7c, 0x82, 0xc3, 0x7a, 0xe4, 0x52, 0xbb, 0x30, 0xf0, 0xfd,
-	0x81, 0x87, 0x37, 0x98, 0xeb, 0xb3, 0xc9, 0xfe, 0x06, 0x1e, 0x8e, 0xc9, 0x34, 0x8a, 0xac, 0x5d,
-	0x9c, 0xfd, 0x91, 0xb8, 0x43, 0x1c, 0x12, 0x6b, 0x38, 0x8e, 0x1c, 0x8c, 0xb7, 0x41, 0x6b, 0xf9,
-	0x21, 0x41, 0xff, 0x06, 0xfd, 0xc0, 0xf2, 0x26, 0xb8, 0xaa, 0xac, 0x2a, 0x6b, 0xba, 0x19, 0x0d,
-	0x50, 0x0d, 0x8a, 0xf6, 0x24, 0x08, 0xf0, 0xc8, 0x9e, 0x56, 0x73, 0xab, 0xca, 0x5a, 0xc9, 0x8c,
-	0xc7, 0xc6, 0x77, 0x0a, 0xa8, 0x2d, 0x2b, 0x40, 0xcb, 0x90, 0x73, 0x1d, 0x1e, 0x96, 0x73, 0x1d,
-	0x84, 0x40, 0x1b, 0x59, 0x43, 0xcc, 0xfd, 0xd9, 0x33, 0xaa, 0x80, 0x7a, 0xe0, 0x8e, 0xaa, 0x2a,
-	0x33, 0xd1, 0x47, 0xea, 0x35, 0xc5, 0x56, 0x50, 0xd5, 0x58, 0x1c, 0x7b, 0x46, 0x55, 0x28, 0x38,
-	0x78, 0xdf, 0x9a, 0x78, 0xa4, 0xaa, 0xaf, 0x2a, 0x6b, 0x45, 0x53, 0x0c, 0xd1, 0x4d, 0x00, 0x3b,
-	0xc0, 0x16, 0xc1, 0xce, 0x9e, 0x45, 0xaa, 0xf9, 0x55, 0x65, 0xad, 0xdc, 0xa8, 0xd5, 0xa3, 0xb5,
-	0xd5, 0xc5, 0xda, 0xea, 0x7d, 0xb1, 0x36, 0xb3, 0xc4, 0xbd, 0x9b, 0xc4, 0x68, 0xc3, 0x52, 0xcb,
-	0x0a, 0x5a, 0xbe, 0xe7, 0x61, 0x9b, 0xb8, 0xfe, 0x08, 0xbd, 0x09, 0x9a, 0x6d, 0x05, 0x61, 0x55,
-	0x59, 0x55, 0xd7, 0xca, 0x8d, 0xd5, 0xfa, 0x1c, 0x6c, 0xeb, 0x2d, 0x2b, 0x30, 0x99, 0xb7, 0xe1,
-	0xc3, 0xf2, 0xa6, 0xeb, 0x79, 0xee, 0x68, 0xd0, 0x23, 0x16, 0xcb, 0x23, 0xb3, 0xee, 0xf4, 0xbc,
-	0xd5, 0x2c, 0xf3, 0xb6, 0xa1, 0x9a, 0x7e, 0x61, 0x62, 0x09, 0x1d, 0x28, 0x86, 0x91, 0x51, 0x2c,
-	0xe3, 0xca, 0xdc, 0x65, 0xa4, 0x13, 0x99, 0x71, 0xb0, 0x51, 0x87, 0xe2, 0xe6, 0x04, 0x7b, 0xfd,
-	0xe9, 0x18, 0xcb, 0xac, 0xc7, 0xb8, 0x07, 0x48, 0xf8, 0x27, 0xa6, 0xf3, 0x0e, 0xe8, 0x64, 0x3a,
-	0xc6, 0x62, 0x2e, 0xaf, 0xcc, 0x9f, 0x0b, 0x8f, 0x37, 0xa3, 0x18, 0xe3, 0x1b, 0x15, 0x34, 0x6a,
-	0x3b, 0xf4, 0xfe, 0x1b, 0xa0, 0xd9, 0x7e, 0x48, 0xd8, 0xfb, 0xcb, 0x8d, 0x4b, 0xf3, 0x79, 0xf2,
-	0x43, 0x62, 0x32, 0xf7, 0x17, 0x42, 0x56, 0x93, 0x42, 0x6e, 0x43, 0x81, 0x2f, 0x9a, 0x29, 0x2e,
-	0x23, 0x60, 0x22, 0x16, 0xd5, 0x41, 0x73, 0x2c, 0x82, 0x99, 0x3c, 0xe7, 0x33, 0xc9, 0xfc, 0xe8,
-	0xfe, 0x71, 0xdc, 0x90, 0x58, 0x23, 0x1b, 0x33, 0xd5, 0xea, 0x66, 0x3c, 0x46, 0x0d, 0x50, 0x6d,
-	0x2b, 0xa8, 0x16, 0x58, 0xaa, 0xc5, 0x32, 0xa4, 0xce, 0x33, 0x7a, 0x2a, 0x66, 0xd0, 0x13, 0xba,
-	0x09, 0x1a, 0x05, 0xbc, 0x5a, 0x62, 0x41, 0x92, 0x1c, 0xb1, 0x10, 0xe3, 0x0b, 0x05, 0x96, 0xa9,
-	0x29, 0x41, 0xf9, 0x5b, 0xa0, 0xef, 0x4f, 0xb0, 0x27, 0x28, 0xbf, 0xb4, 0x30, 0x9d, 0x19, 0xf9,
-	0xa3, 0xf7, 0x40, 0x1b, 0x62, 0x62, 0x71, 0x56, 0xe7, 0xb3, 0xd0, 0xb5, 0x06, 0xee, 0x88, 0x01,
-	0xbf, 0x8d, 0x89, 0x65, 0xb2, 0x40, 0xe3, 0x6b, 0x05, 0x8a, 0x2d, 0x7e, 0x06, 0x49, 0xed, 0x41,
-	0x44, 0x75, 0xe4, 0x60, 0x7e, 0xf8, 0xb0, 0xe7, 0xe4, 0x49, 0xa3, 0xcd, 0x3b, 0x69, 0xf4, 0x2c,
-	0x3b, 0xf6, 0x53, 0x58, 0xb9, 0x13, 0x65, 0x89, 0xe7, 0xd7, 0x4c, 0x9c, 0x9f, 0x8a, 0x04, 0xf0,
-	0x22, 0xf0, 0xc5, 0x31, 0x4b, 0xf5, 0xbc, 0xef, 0x4f, 0x46, 0x0e, 0x5b, 0x53, 0xd1, 0x8c, 0x06,
-	0xc6, 0x13, 0x40, 0xc2, 0x37, 0xc1, 0x4a, 0x1b, 0x80, 0xc7, 0xb9, 0x92, 0xbb, 0x31, 0x7e, 0x61,
-	0x22, 0xd0, 0x78, 0x17, 0x96, 0xd3, 0xd0, 0x53, 0xbc, 0xa2, 0xdf, 0x09, 0x07, 0x5b, 0x0c, 0x29,
-	0xba, 0x9e, 0xc5, 0x77, 0xa9, 0x6e, 0xb2, 0x67, 0xe3, 0x8f, 0x1c, 0x9c, 0xbd, 0x1f, 0xe2, 0xa0,
-	0x87, 0x09, 0x71, 0x47, 0x83, 0xf0, 0x10, 0x4d, 0x4d, 0x28, 0x73, 0xbc, 0xf7, 0xe8, 0x16, 0xc8,
-	0x49, 0x6e, 0x01, 0xe0, 0x41, 0xf4, 0xd6, 0xe9, 0x42, 0x25, 0x4e, 0x21, 0x10, 0x56, 0xb3, 0x20,
-	0xbc, 0xe2, 0xcc, 0x70, 0x95, 0x66, 0x5e, 0xcb, 0xb6, 0xb7, 0x60, 0x32, 0x76, 0x32, 0x88, 0x86,
-	0x7b, 0x37, 0x09, 0xba, 0x07, 0xe7, 0xc4, 0x3a, 0xe8, 0x06, 0xd9, 0x63, 0x7b, 0x34, 0x9f, 0x65,
-	0x8f, 0x8a, 0x85, 0x08, 0x83, 0xf1, 0xb9, 0x02, 0x40, 0x07, 0x9b, 0xae, 0x47, 0x70, 0x40, 0x05,
-	0xe4, 0xb9, 0x43, 0x57, 0x30, 0x17, 0x0d, 0x28, 0x6f, 0x63, 0x6b, 0x80, 0x05, 0x6f, 0xf4, 0x19,
-	0x9d, 0x87, 0xbc, 0x6d, 0x05, 0x7b, 0xae, 0x23, 0xce, 0x4e, 0xdb, 0x0a, 0xb6, 0x1c, 0xf4, 0x5f,
-	0x28, 0xd0, 0x59, 0x51, 0x7b, 0x74, 0x5b, 0xe7, 0xe9, 0x70, 0xcb, 0x41, 0xff, 0x03, 0xe0, 0x07,
-	0x23, 0xfd, 0x4d, 0x67, 0xbf, 0x95, 0xb8, 0x65, 0xcb, 0x31, 0x2e, 0x40, 0x69, 0xcb, 0x31, 0xf1,
-	0x67, 0x13, 0x1c, 0x92, 0x59, 0x09, 0x18, 0x1b, 0x50, 0xda, 0x0d, 0x1c, 0x1c, 0x48, 0x5f, 0x3d,
-	0x3d, 0xf8, 0x57, 0x1c, 0x90, 0x90, 0xfc, 0xed, 0xf4, 0xdd, 0xf3, 0xea, 0x5c, 0xcc, 0xe2, 0x04,
-	0xe2, 0xf2, 0xf9, 0x41, 0x05, 0x9d, 0x19, 0x4f, 0xeb, 0xf6, 0x59, 0xa5, 0xca, 0x0e, 0xed, 0xc0,
-	0x1d, 0xb3, 0xbb, 0x26, 0x3a, 0x73, 0x92, 0x26, 0x56, 0x52, 0x59, 0x63, 0xcb, 0x76, 0xc9, 0x94,
-	0xc1, 0x49, 0x4b, 0x2a, 0x3e, 0xce, 0x7c, 0xbd, 0x5c, 0x87, 0xc2, 0x24, 0x94, 0xad, 0x89, 0xf2,
-	0xd4, 0xb5, 0x49, 0x52, 0x77, 0x52, 0xe1, 0xe5, 0x77, 0x52, 0x31, 0xcb, 0x9d, 0x74, 0x2b, 0x75,
-	0xb1, 0xc8, 0x12, 0xc0, 0x62, 0x66, 0xf6, 0x1c, 0x64, 0x39, 0x6d, 0xbf, 0x54, 0x60, 0x85, 0xa5,
-	0x4b, 0x88, 0xe1, 0x16, 0xe4, 0x7d, 0x6a, 0x12, 0x6a, 0x30, 0x16, 0x4f, 0xc6, 0xe4, 0x11, 0x27,
-	0xbf, 0x98, 0x7e, 0xca, 0x41, 0xa1, 0xfd, 0x7c, 0x8c, 0x47, 0x21, 0xfe, 0xfb, 0xd4, 0x24, 0x14,
-	0xa3, 0x49, 0x2a, 0x86, 0x13, 0xac, 0x67, 0x21, 0xf8, 0x36, 0x27, 0x98, 0x4a, 0x6c, 0xb9, 0xb1,
-	0x36, 0x37, 0x88, 0x03, 0x70, 0x24, 0xc5, 0x85, 0x2c, 0x14, 0x7f, 0xa5, 0xc0, 0x39, 0x9e, 0x30,
-	0x41, 0xf2, 0xfb, 0x50, 0xc4, 0x91, 0x51, 0xd0, 0xfc, 0x7f, 0x99, 0x29, 0x99, 0x71, 0xd4, 0xc9,
-	0xa9, 0x1e, 0x40, 0x99, 0x89, 0xe7, 0xaf, 0x3e, 0x61, 0xa9, 0xc8, 0x97, 0xf8, 0xfc, 0x4f, 0xeb,
-	0x5d, 0x82, 0x4d, 0xed, 0x38, 0x6c, 0x1a, 0x5d, 0x58, 0xda, 0x76, 0x3d, 0x6c, 0x0d, 0x4e, 0x6b,
-	0x3e, 0xc6, 0xcf, 0x0a, 0x14, 0x78, 0xca, 0x43, 0xdb, 0x26, 0x79, 0x54, 0xe5, 0x66, 0x8e, 0x2a,
-	0xa1, 0x7c, 0x35, 0x9b, 0xf2, 0xb5, 0xe3, 0x97, 0xdb, 0x7a, 0x56, 0xed, 0xf2, 0x65, 0xa5, 0xb5,
-	0x3b, 0x8c, 0x8c, 0x72, 0xda, 0xe5, 0x19, 0xcc, 0x38, 0xea, 0xe4, 0xda, 0xfd, 0x3e, 0x07, 0x85,
-	0x1e, 0x0e, 0x0e, 0x5c, 0xfb, 0x1f, 0x76, 0x4c, 0x1d, 0xff, 0x1b, 0xc1, 0xbc, 0x2b, 0x91, 0x11,
-	0xc9, 0xf1, 0x4a, 0x13, 0x19, 0x46, 0x46, 0x39, 0x22, 0x79, 0x06, 0x33, 0x8e, 0x3a, 0x39, 0x91,
-	0x5d, 0x58, 0xe2, 0x59, 0x4f, 0x69, 0x2b, 0xae, 0x1f, 0x40, 0x39, 0xb1, 0xe3, 0x51, 0x09, 0xf4,
-	0xf6, 0x76, 0xb7, 0xff, 0xa8, 0x72, 0x06, 0x01, 0xe4, 0x3b, 0x4d, 0xb3, 0xd9, 0x69, 0x57, 0x14,
-	0x6a, 0xee, 0xef, 0xee, 0x7e, 0xd4, 0xab, 0xe4, 0x50, 0x01, 0xd4, 0x7e, 0xf3, 0x61, 0x45, 0x45,
-	0x4b, 0x50, 0xda, 0xda, 0xe9, 0xdd, 0x37, 0x9b, 0x3b, 0xad, 0x76, 0x45, 0x43, 0x45, 0xd0, 0xcc,
-	0xdd, 0xe6, 0x9d, 0x8a, 0x8e, 0xca, 0x50, 0x78, 0xd0, 0xec, 0xdd, 0xdd, 0xda, 0xe9, 0x54, 0xf2,
-	0x74, 0xd0, 0x6d, 0x9a, 0x1f, 0xd2, 0x41, 0x81, 0xa6, 0xd9, 0xed, 0xdf, 0x6d, 0x9b, 0x15, 0x7b,
-	0xfd, 0x22, 0x94, 0xda, 0x41, 0xe0, 0x07, 0x2d, 0xda, 0x9a, 0x15, 0x41, 0x6b, 0x5f, 0xbb, 0xf6,
-	0x46, 0xe5, 0x0c, 0x7f, 0x6a, 0x54, 0x94, 0xc6, 0x2f, 0x2a, 0x2c, 0xd3, 0x86, 0xc2, 0xc4, 0x63,
-	0x3f, 0x74, 0x89, 0x1f, 0x4c, 0xd1, 0x36, 0x14, 0x3a, 0x98, 0x76, 0x02, 0x21, 0xfa, 0xcf, 0x21,
-	0x92, 0xdb, 0xc3, 0x31, 0x99, 0xd6, 0xd6, 0x17, 0xe9, 0x26, 0xc1, 0xe7, 0x43, 0x58, 0xa2, 0xe9,
-	0xe2, 0x1e, 0xe8, 0xc8, 0xa4, 0x1b, 0x52, 0xdd, 0x45, 0x22, 0xf3, 0x63, 0x40, 0x1d, 0x4c, 0x66,
-	0x1b, 0xc3, 0xa3, 0xd2, 0x5f, 0x9d, 0x9b, 0x7e, 0x36, 0x4b, 0x1f, 0x56, 0x3a, 0x98, 0xa4, 0x5a,
-	0xad, 0xa3, 0x12, 0xbf, 0x36, 0x37, 0x71, 0x2a, 0xc5, 0x27, 0x50, 0xe9, 0x59, 0x07, 0x38, 0x65,
-	0x93, 0x0f, 0xcf, 0xf0, 0xa6, 0xc6, 0xaf, 0x6a, 0xf4, 0x61, 0x21, 0xc1, 0xeb, 0xc7, 0x50, 0xec,
-	0x60, 0xd6, 0xcb, 0x84, 0xe8, 0xf2, 0xc2, 0x06, 0x28, 0x52, 0x7e, 0xed, 0xca, 0x42, 0xc7, 0x04,
-	0x21, 0xf7, 0xa1, 0xb8, 0xe9, 0x8e, 0x1c, 0xf6, 0xcd, 0x69, 0x7e, 0xb5, 0x1a, 0x77, 0x2f, 0xb5,
-	0xc5, 0xdf, 0x37, 0x90, 0xcd, 0x78, 0x4e, 0x7f, 0x38, 0x3a, 0x9a, 0x8e, 0x1b, 0x19, 0x3e, 0x3f,
-	0x25, 0xe6, 0xfe, 0x00, 0xce, 0x72, 0x74, 0xe8, 0x0e, 0x3d, 0xae, 0x4a, 0x5f, 0xf2, 0x09, 0xaf,
-	0x0b, 0x45, 0xca, 0x39, 0x5b, 0xc9, 0xe2, 0xc5, 0x4a, 0xe0, 0xd1, 0xf8, 0x56, 0xe7, 0xf5, 0x79,
-	0x82, 0x5c, 0x1b, 0x4a, 0x1d, 0x4c, 0x76, 0xa3, 0x82, 0x7b, 0x6d, 0x71, 0x71, 0xce, 0xe9, 0xbd,
-	0xba, 0xd8, 0x33, 0x85, 0x51, 0x89, 0xf2, 0x1b, 0xb5, 0x75, 0xb2, 0x04, 0x4b, 0x74, 0x0a, 0xe8,
-	0x11, 0x3b, 0x23, 0xe2, 0x16, 0xe6, 0x68, 0xf4, 0xaf, 0xc9, 0xf5, 0x40, 0x89, 0x39, 0xf7, 0xa0,
-	0x44, 0xe1, 0x8f, 0xde, 0x23, 0x31, 0x17, 0xa9, 0xf9, 0xba, 0x50, 0xee, 0x60, 0xd2, 0x16, 0x55,
-	0xef, 0xba, 0x4c, 0xa9, 0xc7, 0x11, 0xaf, 0xcb, 0xf8, 0x26, 0xe6, 0xff, 0x04, 0xca, 0x14, 0x73,
-	0xd1, 0xfe, 0xc8, 0xa2, 0x2e, 0x55, 0xb8, 0xa3, 0x47, 0x50, 0xa6, 0xe0, 0x88, 0xa1, 0x54, 0x90,
-	0x5c, 0xea, 0xc6, 0xef, 0x2a, 0xfb, 0x77, 0x20, 0x21, 0xd1, 0x08, 0xb4, 0x9e, 0xb8, 0xa5, 0xd7,
-	0x65, 0x6e, 0x75, 0x29, 0xd0, 0x0e, 0xd7, 0x10, 0x1c, 0x34, 0x51, 0x8c, 0x9d, 0x0e, 0x68, 0x22,
-	0x1b, 0x07, 0x4d, 0x0c, 0xa5, 0x82, 0x24, 0x53, 0x47, 0x10, 0x6d, 0x8b, 0x8a, 0x74, 0x5d, 0xa6,
-	0x82, 0x95, 0x82, 0xe8, 0x70, 0xbd, 0xcc, 0x57, 0x21, 0xfa, 0x03, 0xa9, 0x62, 0xb9, 0x26, 0xe5,
-	0xf5, 0x41, 0xff, 0xf1, 0xe5, 0x17, 0x6e, 0x1b, 0xd4, 0xed, 0x75, 0xe6, 0xb7, 0x11, 0xf9, 0x6d,
-	0x04, 0x63, 0x9b, 0x3f, 0xfe, 0x98, 0xab, 0x34, 0x27, 0xc4, 0xdf, 0xa1, 0xbf, 0x3e, 0xed, 0x31,
-	0xd3, 0x6f, 0xb9, 0xf3, 0xb3, 0xa6, 0xa7, 0xb4, 0x34, 0x7b, 0x96, 0x67, 0x47, 0xc1, 0xf5, 0x3f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x79, 0x2a, 0xba, 0xc0, 0x1b, 0x00, 0x00,
+	// 5586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x23, 0xc9,
+	0x75, 0x6a, 0xfe, 0xf9, 0x28, 0x51, 0x3d, 0x35, 0x9f, 0xd5, 0x68, 0xd6, 0xde, 0x71, 0x3b, 0xf6,
+	0x8c, 0xb5, 0x3b, 0x9a, 0x59, 0x8d, 0xc7, 0xb3, 0x5e, 0xaf, 0xbd, 0x4b, 0x51, 0x2d, 0x89, 0x19,
+	0x51, 0x94, 0x9b, 0xd4, 0xee, 0x8c, 0xbd, 0x09, 0xdd, 0x43, 0x96, 0xa8, 0xf6, 0x90, 0xdd, 0x74,
+	0x77, 0x53, 0x91, 0x16, 0x30, 0x90, 0x38, 0x09, 0xe2, 0x2c, 0x6c, 0x07, 0xb9, 0xd8, 0xc9, 0x25,
+	0xc9, 0x21, 0xb7, 0xc4, 0x40, 0x2e, 0x41, 0x80, 0xe4, 0x62, 0xe7, 0x98, 0xe4, 0x10, 0x24, 0x40,
+	0x4e, 0x01, 0x02, 0x6c, 0x4e, 0xb9, 0xe5, 0x98, 0xdc, 0x82, 0xfa, 0xf5, 0x87, 0x5a, 0x91, 0xd5,
+	0xe4, 0xcc, 0x06, 0x0e, 0x72, 0x63, 0x55, 0xbd, 0xf7, 0xba, 0xea, 0xd5, 0x7b, 0xaf, 0xde, 0x7b,
+	0xf5, 0x8a, 0xb0, 0xe8, 0x61, 0xf7, 0x04, 0xbb, 0xeb, 0x43, 0xd7, 0xf1, 0x1d, 0x74, 0xe3, 0x14,
+	0xf7, 0x9f, 0x3a, 0xfe, 0x7a, 0xc7, 0x19, 0xac, 0x9b, 0x23, 0xdf, 0xb1, 0x1d, 0x1f, 0x7b, 0xeb,
+	0x0c, 0x64, 0xf5, 0x46, 0xcf, 0x71, 0x7a, 0x7d, 0x7c, 0x97, 0x82, 0x3e, 0x1d, 0x1d, 0xdd, 0xc5,
+	0x83, 0xa1, 0x7f, 0xc6, 0x30, 0x57, 0x5f, 0x19, 0x1f, 0xf4, 0xad, 0x01, 0xf6, 0x7c, 0x73, 0x30,
+	0x64, 0x00, 0xda, 0x1b, 0x90, 0xa9, 0x3a, 0x9e, 0x8f, 0xae, 0x40, 0xf6, 0xc4, 0xec, 0x8f, 0xf0,
+	0x8a, 0x72, 0x53, 0xb9, 0x9d, 0x35, 0x58, 0x03, 0xad, 0x42, 0xa1, 0x33, 0x72, 0x5d, 0x6c, 0x77,
+	0xce, 0x56, 0x52, 0x37, 0x95, 0xdb, 0x45, 0x23, 0x68, 0x6b, 0x7f, 0xaa, 0x40, 0xba, 0x6a, 0xba,
+	0xa8, 0x0c, 0x29, 0xab, 0xcb, 0xd1, 0x52, 0x56, 0x17, 0x21, 0xc8, 0xd8, 0xe6, 0x00, 0x73, 0x78,
+	0xfa, 0x1b, 0xa9, 0x90, 0x3e, 0xb1, 0xec, 0x95, 0x34, 0xed, 0x22, 0x3f, 0x09, 0xd4, 0x19, 0x36,
+	0xdd, 0x95, 0x0c, 0xc5, 0xa3, 0xbf, 0xd1, 0x0a, 0xe4, 0xbb, 0xf8, 0xc8, 0x1c, 0xf5, 0xfd, 0x95,
+	0xec, 0x4d, 0xe5, 0x76, 0xc1, 0x10, 0x4d, 0xf4, 0x65, 0x80, 0x8e, 0x8b, 0x4d, 0x1f, 0x77, 0xdb,
+	0xa6, 0xbf, 0x92, 0xbb, 0xa9, 0xdc, 0x2e, 0x6d, 0xac, 0xae, 0xb3, 0xb5, 0xad, 0x8b, 0xb5, 0xad,
+	0xb7, 0xc4, 0xda, 0x8c, 0x22, 0x87, 0xae, 0xf8, 0x9a, 0x0e, 0x4b, 0x55, 0xd3, 0xad, 0x3a, 0xfd,
+	0x3e, 0xee, 0xf8, 0x96, 0x63, 0xa3, 0x2f, 0x42, 0xa6, 0x63, 0xba, 0xde, 0x8a, 0x72, 0x33, 0x7d,
+	0xbb, 0xb4, 0x71, 0x73, 0x7d, 0x02, 0x6f, 0xd7, 0xab, 0xa6, 0x6b, 0x50, 0x68, 0xcd, 0x81, 0xf2,
+	0xb6, 0xd5, 0xef, 0x5b, 0x76, 0xaf, 0xe9, 0x9b, 0x94, 0x8e, 0xcc, 0xba, 0xe3, 0xf3, 0x4e, 0x27,
+	0x99, 0x77, 0x07, 0x56, 0xe2, 0x1f, 0x8c, 0x2c, 0x61, 0x07, 0x0a, 0x1e, 0xeb, 0x14, 0xcb, 0x78,
+	0x75, 0xe2, 0x32, 0xe2, 0x84, 0x8c, 0x00, 0x59, 0xfb, 0x91, 0x02, 0x85, 0xed, 0x11, 0xee, 0xb7,
+	0xce, 0x86, 0x58, 0x6a, 0x41, 0x37, 0xa0, 0x38, 0x34, 0x5d, 0x6c, 0xfb, 0x6d, 0xab, 0x4b, 0xd7,
+	0x93, 0x35, 0x0a, 0xac, 0xa3, 0xd6, 0x45, 0x15, 0x28, 0x74, 0x8e, 0xad, 0x7e, 0xd7, 0xc5, 0xf6,
+	0x4a, 0x86, 0x4e, 0xeb, 0x73, 0x93, 0xa7, 0xc5, 0xbf, 0x6c, 0x04, 0x68, 0xda, 0x0f, 0x14, 0x40,
+	0xa2, 0x3b, 0xb2, 0xe0, 0xaf, 0x40, 0xd6, 0x3f, 0x1b, 0x62, 0xb1, 0x5a, 0x49, 0xb2, 0x0c, 0x07,
+	0x7d, 0x19, 0x32, 0xbe, 0x8b, 0xc9, 0x3a, 0x12, 0xe0, 0x52, 0x14, 0xad, 0x06, 0x4b, 0xa2, 0xa7,
+	0x8e, 0xdd, 0x1e, 0x5d, 0xbf, 0xe7, 0x8c, 0xdc, 0x0e, 0x6e, 0x07, 0xac, 0x2a, 0xb0, 0x8e, 0x5a,
+	0x97, 0x0c, 0xfa, 0xa6, 0xdb, 0xc3, 0x94, 0x39, 0x29, 0x36, 0xc8, 0x3a, 0x6a, 0x5d, 0xed, 0x5f,
+	0x33, 0x90, 0x21, 0xb4, 0xce, 0xb1, 0xf9, 0x01, 0x64, 0x3a, 0x8e, 0xe7, 0x53, 0x84, 0xd2, 0xc6,
+	0x67, 0x26, 0xcb, 0xa3, 0xe3, 0xf9, 0x06, 0x05, 0x0f, 0x15, 0x36, 0x1d, 0x55, 0x58, 0x1d, 0xf2,
+	0x7c, 0x73, 0xa9, 0x66, 0x25, 0x14, 0x0c, 0x81, 0x8b, 0xd6, 0x21, 0xd3, 0x35, 0x7d, 0x4c, 0xd5,
+	0x70, 0xb2, 0xc4, 0x52, 0x38, 0x62, 0x27, 0xba, 0x96, 0xe7, 0x9b, 0x76, 0x07, 0x53, 0xed, 0xcc,
+	0x1a, 0x41, 0x1b, 0x6d, 0x40, 0xba, 0x63, 0xba, 0x2b, 0x79, 0x4a, 0x6a, 0xba, 0xba, 0x11, 0xe0,
+	0x31, 0xbd, 0x29, 0x24, 0xd0, 0x1b, 0xba, 0xdb, 0x67, 0x43, 0xbc, 0x52, 0xa4, 0x48, 0xd2, 0xbb,
+	0x4d, 0x14, 0x60, 0x05, 0xf2, 0x27, 0xd8, 0xf5, 0x08, 0xf3, 0x80, 0x2e, 0x42, 0x34, 0xd1, 0x2e,
+	0x94, 0x3b, 0x8e, 0x7d, 0x82, 0x5d, 0x32, 0x23, 0xba, 0x5b, 0x25, 0xd9, 0xdd, 0x5a, 0x0a, 0x10,
+	0xa9, 0x9d, 0xbd, 0x09, 0xa5, 0x2e, 0xf6, 0x3a, 0xae, 0x35, 0xa4, 0x9b, 0xb4, 0x48, 0x75, 0x2b,
+	0xda, 0x45, 0xec, 0x93, 0x6f, 0xf6, 0xbc, 0x95, 0x25, 0x09, 0xfb, 0xd4, 0x32, 0x7b, 0x06, 0x85,
+	0xd6, 0x3e, 0x54, 0xa0, 0x4c, 0x96, 0x13, 0x51, 0x9a, 0x87, 0x90, 0x3d, 0x1a, 0xe1, 0xbe, 0x50,
+	0x9a, 0xcf, 0x4c, 0x65, 0x85, 0xc1, 0xe0, 0xd1, 0xdb, 0x90, 0x19, 0x60, 0xdf, 0xe4, 0x12, 0x39,
+	0x59, 0x82, 0x0e, 0xcc, 0x9e, 0x65, 0x53, 0xa1, 0xa9, 0x63, 0xdf, 0x34, 0x28, 0xa2, 0xf6, 0x13,
+	0x05, 0x0a, 0x55, 0x7e, 0x4e, 0x48, 0x99, 0x15, 0x44, 0x74, 0xa0, 0x8b, 0xf9, 0x01, 0x41, 0x7f,
+	0x47, 0x4f, 0x83, 0xcc, 0xa4, 0xd3, 0x20, 0x9b, 0xc4, 0xaa, 0x7e, 0x1b, 0x96, 0xb7, 0x18, 0x95,
+	0x60, 0x7e, 0x95, 0xc8, 0x19, 0xa7, 0x48, 0x08, 0x8d, 0x40, 0x0c, 0x8f, 0x42, 0xa2, 0x8b, 0x47,
+	0xce, 0xc8, 0x66, 0x4a, 0x5f, 0x30, 0x58, 0x43, 0xfb, 0x26, 0x20, 0x01, 0x1b, 0xd9, 0x15, 0x1d,
+	0x80, 0xe3, 0x59, 0x92, 0xf6, 0x2c, 0xf8, 0x60, 0x04, 0x51, 0xfb, 0x00, 0xca, 0x71, 0xd6, 0x13,
+	0x7e, 0xb1, 0x71, 0x9f, 0x33, 0x5b, 0x34, 0x09, 0x77, 0xfb, 0x26, 0xb7, 0x30, 0x59, 0x83, 0xfe,
+	0x46, 0xaf, 0x40, 0xc9, 0xc6, 0xa7, 0x7e, 0xbb, 0x33, 0x72, 0x3d, 0xc7, 0xe5, 0x8c, 0x07, 0xd2,
+	0x55, 0xa5, 0x3d, 0xe8, 0x3a, 0x14, 0x8e, 0x4d, 0xaf, 0x3d, 0x70, 0x5c, 0x2c, 0xf8, 0x7f, 0x6c,
+	0x7a, 0x75, 0xc7, 0xc5, 0xda, 0xbf, 0x29, 0x90, 0x3d, 0xb4, 0x2d, 0xdf, 0x43, 0x7a, 0x44, 0xef,
+	0xc9, 0x47, 0xcb, 0x1b, 0x5f, 0x98, 0xb8, 0x94, 0x2d, 0x0e, 0x4c, 0xb0, 0x23, 0x26, 0xe2, 0x6d,
+	0xc8, 0x9d, 0x38, 0xfd, 0x11, 0x17, 0x8a, 0xf2, 0xc6, 0xad, 0x89, 0x44, 0xde, 0xa5, 0xa0, 0x94,
+	0x04, 0x47, 0x43, 0xfb, 0x50, 0xea, 0x38, 0xb6, 0x37, 0x1a, 0x30, 0xad, 0x4a, 0x53, 0x2a, 0xaf,
+	0x4d, 0x51, 0xce, 0x00, 0x9e, 0x92, 0x8a, 0x12, 0xd0, 0xfe, 0x33, 0x03, 0x8b, 0x87, 0x1e, 0x76,
+	0x9b, 0xd8, 0xf7, 0x2d, 0xbb, 0xe7, 0x9d, 0x13, 0xe2, 0x0a, 0x51, 0x63, 0x2a, 0x47, 0x6d, 0x62,
+	0xdc, 0x52, 0x92, 0xc6, 0x0d, 0x38, 0x12, 0xf1, 0x9b, 0x0e, 0x40, 0x0d, 0x48, 0x08, 0xf9, 0x4b,
+	0x27, 0x91, 0xbf, 0xe5, 0xee, 0x98, 0x24, 0xc7, 0xf5, 0x22, 0x93, 0xcc, 0x6a, 0xc2, 0x68, 0xd8,
+	0x4d, 0xa0, 0x52, 0x1c, 0xba, 0xe2, 0xa3, 0xaf, 0xc3, 0x25, 0xb1, 0x0e, 0x62, 0x3e, 0xda, 0xd4,
+	0xfa, 0xe6, 0x92, 0x58, 0x5f, 0xb1, 0x90, 0xc0, 0x13, 0x69, 0xc2, 0x92, 0x90, 0x8d, 0xf6, 0xc8,
+	0xb6, 0x7c, 0x7a, 0x78, 0x24, 0x91, 0xad, 0xdd, 0x05, 0x63, 0xb1, 0x1b, 0x69, 0x7f, 0x5f, 0x51,
+	0x50, 0x1d, 0x4a, 0x4c, 0x5a, 0x18, 0xc9, 0x42, 0x22, 0x49, 0xdb, 0x55, 0x0c, 0x38, 0x09, 0x5a,
+	0x84, 0xdc, 0x2b, 0x50, 0x20, 0xbe, 0xf4, 0x07, 0x8e, 0xcd, 0xce, 0x9a, 0xe2, 0x6e, 0xca, 0x08,
+	0x7a, 0xbe, 0xaf, 0x28, 0x9b, 0x2a, 0x94, 0xdb, 0xb1, 0x55, 0x6c, 0x96, 0x61, 0xb1, 0x1d, 0x99,
+	0xc2, 0x66, 0x09, 0x8a, 0x6d, 0x81, 0xa1, 0x7d, 0x4f, 0x81, 0x45, 0xfd, 0xb4, 0x73, 0x6c, 0xda,
+	0x3d, 0x6c, 0xf0, 0x33, 0x35, 0x66, 0x97, 0x22, 0xbe, 0x37, 0xd1, 0xe8, 0xa7, 0xa6, 0x17, 0xd8,
+	0x50, 0xf2, 0x3b, 0x38, 0xb3, 0xd3, 0x92, 0x67, 0x36, 0x82, 0x8c, 0x4b, 0xe0, 0x89, 0x9c, 0x28,
+	0x06, 0xfd, 0xad, 0x3d, 0x81, 0x6b, 0xd1, 0x39, 0x44, 0xcc, 0xd6, 0xdb, 0x90, 0x25, 0x10, 0xc2,
+	0x62, 0x4d, 0xde, 0x8a, 0x28, 0x0d, 0x83, 0xe1, 0x69, 0xb7, 0x01, 0x45, 0xbb, 0x6b, 0x83, 0xa1,
+	0xe3, 0x52, 0xd3, 0xd4, 0x35, 0x7d, 0x93, 0x2e, 0x70, 0x91, 0x4e, 0xcc, 0xd4, 0x0e, 0x60, 0xe5,
+	0x3c, 0xa4, 0x81, 0x3d, 0x62, 0xfa, 0x57, 0xa1, 0x60, 0xd1, 0x36, 0x0e, 0xdc, 0x2f, 0xd1, 0x26,
+	0x06, 0xd0, 0x7b, 0x66, 0x0d, 0x87, 0x58, 0x38, 0x5f, 0xa2, 0xa9, 0xfd, 0x24, 0x03, 0x40, 0x84,
+	0x6b, 0xdb, 0xea, 0xfb, 0xd8, 0x25, 0xe6, 0xba, 0x6f, 0x0d, 0x2c, 0x61, 0x27, 0x59, 0x83, 0x4c,
+	0x65, 0x68, 0xf6, 0xb0, 0xb0, 0x92, 0xe4, 0x37, 0xba, 0x0a, 0xb9, 0x8e, 0xe9, 0x86, 0xbe, 0x6e,
+	0xb6, 0x63, 0xba, 0xb5, 0x2e, 0x7a, 0x09, 0xf2, 0x44, 0xca, 0x49, 0x3f, 0x8b, 0x5f, 0x72, 0xa4,
+	0x59, 0xeb, 0xa2, 0x4f, 0x01, 0x70, 0x17, 0x8a, 0x8c, 0x65, 0xe9, 0x58, 0x91, 0xf7, 0xd4, 0xba,
+	0xe8, 0x21, 0x14, 0x09, 0xeb, 0xdb, 0x47, 0xae, 0x33, 0x90, 0x88, 0x62, 0x0a, 0x04, 0x78, 0xdb,
+	0x75, 0x06, 0xe8, 0x3e, 0xe4, 0x29, 0xa2, 0xef, 0x70, 0x3f, 0x6a, 0x12, 0x5a, 0x8e, 0x80, 0xb6,
+	0x1c, 0x62, 0xc1, 0x07, 0x96, 0xcd, 0xdc, 0x95, 0x02, 0x63, 0xc8, 0xc0, 0xb2, 0xa9, 0x17, 0x42,
+	0x86, 0xcc, 0x53, 0x36, 0x54, 0xe4, 0x43, 0xe6, 0x29, 0x1d, 0x8a, 0x8a, 0x1d, 0x8c, 0x89, 0xdd,
+	0x4b, 0x90, 0xf7, 0x1c, 0xd7, 0x6f, 0x3f, 0x3d, 0xa3, 0xfe, 0x4f, 0xd1, 0xc8, 0x91, 0xe6, 0xe6,
+	0x19, 0xfa, 0x3a, 0x94, 0xe9, 0x40, 0xd7, 0x72, 0x99, 0xbc, 0x50, 0xc7, 0xa6, 0xbc, 0xb1, 0x36,
+	0x51, 0x4c, 0x9a, 0x8e, 0xeb, 0x6f, 0x09, 0x0c, 0x63, 0xc9, 0x8b, 0x36, 0xd1, 0x35, 0xc8, 0xf1,
+	0xb3, 0x69, 0x89, 0x7d, 0x8a, 0xb5, 0x28, 0x8b, 0x9f, 0x59, 0xc3, 0x76, 0xc7, 0x19, 0xd9, 0xfe,
+	0x4a, 0x99, 0x9e, 0x4c, 0x45, 0xd2, 0x53, 0x25, 0x1d, 0x04, 0xcd, 0xc3, 0xa6, 0xdb, 0x39, 0x5e,
+	0x59, 0xe6, 0x33, 0xa4, 0x2d, 0xb2, 0x93, 0xbe, 0xd9, 0x23, 0xbb, 0xa2, 0xb2, 0x9d, 0xf4, 0xcd,
+	0x5e, 0xad, 0xab, 0xfd, 0xb5, 0x02, 0xb0, 0x69, 0xfa, 0x9d, 0x63, 0xdd, 0x75, 0x1d, 0x37, 0xf0,
+	0x43, 0x94, 0xb8, 0x1f, 0x32, 0xc0, 0x9e, 0x27, 0x44, 0xa3, 0x68, 0x88, 0x26, 0xd2, 0xb9, 0x9f,
+	0x94, 0xa6, 0x2a, 0xf1, 0xfa, 0xc4, 0xb5, 0x86, 0x1f, 0x59, 0x27, 0xa7, 0xb5, 0x6e, 0xfb, 0xee,
+	0x19, 0xf3, 0x96, 0x56, 0x1f, 0x42, 0x31, 0xe8, 0x22, 0x91, 0xf2, 0x33, 0x2c, 0x14, 0x9e, 0xfc,
+	0x0c, 0x1d, 0x7d, 0xf6, 0x75, 0xd6, 0x78, 0x33, 0xf5, 0x86, 0xa2, 0xdd, 0x80, 0x62, 0xad, 0x6b,
+	0xe0, 0xef, 0x8c, 0xb0, 0xe7, 0x8f, 0x9f, 0x50, 0xda, 0xaf, 0x2b, 0x50, 0x24, 0x32, 0x4f, 0x3f,
+	0x3c, 0xbb, 0x2f, 0xf8, 0x26, 0x64, 0x06, 0x84, 0x23, 0xec, 0x60, 0xfe, 0xfc, 0xf4, 0x35, 0xd6,
+	0x9d, 0x2e, 0x36, 0x28, 0x8e, 0xf6, 0xdb, 0x0a, 0x0b, 0x9f, 0x68, 0x7f, 0xcd, 0xc7, 0x03, 0x12,
+	0xeb, 0x10, 0xb2, 0xdc, 0xcf, 0x92, 0x98, 0x05, 0x05, 0x47, 0x5f, 0x85, 0x2c, 0x26, 0xac, 0xe3,
+	0xe7, 0xec, 0x2d, 0x49, 0x4e, 0x1b, 0x0c, 0x4b, 0x6b, 0xc2, 0x72, 0x30, 0x0d, 0x6e, 0x47, 0xde,
+	0x81, 0xac, 0xe5, 0xe3, 0x81, 0xe0, 0xc7, 0xda, 0xd4, 0x99, 0x04, 0x6b, 0x30, 0x18, 0xa2, 0xf6,
+	0x57, 0x0a, 0xa3, 0x7a, 0xe0, 0x5a, 0x1d, 0xcc, 0x0d, 0x4b, 0x4c, 0xbf, 0x95, 0xd9, 0xf4, 0x3b,
+	0x25, 0xad, 0xdf, 0x71, 0x63, 0x93, 0x1e, 0x37, 0x36, 0x17, 0x19, 0x29, 0xed, 0xa7, 0x3c, 0x54,
+	0xa0, 0x33, 0x3f, 0x70, 0x2c, 0xdb, 0x27, 0xb0, 0xf4, 0xec, 0x0e, 0x24, 0x28, 0x47, 0x9a, 0xb5,
+	0x6e, 0x70, 0xa8, 0xa4, 0x24, 0x0f, 0x95, 0x2b, 0x90, 0x1d, 0x12, 0xb2, 0xc2, 0x5e, 0xd2, 0x06,
+	0x7a, 0x19, 0x8a, 0x5d, 0x7c, 0x62, 0x85, 0x71, 0xa9, 0x62, 0x84, 0x1d, 0xe8, 0xd3, 0x00, 0xde,
+	0xc8, 0x1b, 0x5a, 0x1d, 0xcb, 0x19, 0x79, 0x3c, 0xf3, 0x13, 0xe9, 0xd1, 0xfe, 0x21, 0x05, 0x6a,
+	0x30, 0xdf, 0x5d, 0xcb, 0xf3, 0x1d, 0xf7, 0x2c, 0x1a, 0xe8, 0x2a, 0x73, 0x04, 0xba, 0x22, 0x5a,
+	0x4c, 0x25, 0x8f, 0x16, 0xa3, 0x86, 0x32, 0x3d, 0x66, 0x28, 0xab, 0x90, 0x1b, 0x12, 0xc6, 0x7a,
+	0x3c, 0x0f, 0xf2, 0xea, 0x54, 0xc2, 0xe1, 0x66, 0x18, 0x1c, 0x15, 0xdd, 0x80, 0x22, 0xb1, 0xdf,
+	0x8c, 0x9f, 0xec, 0x2c, 0x21, 0x06, 0x9d, 0x42, 0x92, 0x41, 0xf3, 0xa4, 0xc7, 0x07, 0x79, 0xc8,
+	0x6d, 0x9e, 0xf4, 0x82, 0x41, 0x62, 0xde, 0xd9, 0x60, 0x9e, 0x63, 0x9a, 0xa7, 0x74, 0x50, 0xfb,
+	0xb1, 0x02, 0x97, 0x83, 0x2f, 0xc6, 0x02, 0x93, 0x1c, 0x45, 0x10, 0x3a, 0x71, 0x47, 0x6e, 0xce,
+	0x7c, 0x43, 0x0c, 0x8e, 0x8c, 0xde, 0x80, 0x2c, 0x71, 0x6e, 0x3c, 0xce, 0x52, 0x6d, 0x22, 0x15,
+	0x1a, 0x45, 0x18, 0x0c, 0x41, 0xbb, 0x0b, 0xc5, 0x86, 0xdb, 0xc5, 0xae, 0x6c, 0x32, 0x4a, 0x6b,
+	0xc2, 0xe5, 0x00, 0x21, 0xb2, 0x90, 0xb7, 0xe2, 0xc9, 0xa2, 0xc9, 0x36, 0x2b, 0x20, 0xc0, 0xb3,
+	0x45, 0xda, 0x3f, 0x65, 0x20, 0x4b, 0x3b, 0x9f, 0x57, 0xa2, 0x66, 0x2c, 0xe2, 0x4f, 0x9f, 0x8f,
+	0xf8, 0x89, 0x24, 0x99, 0x43, 0xb3, 0x63, 0xf9, 0x67, 0x54, 0x3b, 0x88, 0x24, 0xf1, 0x76, 0xe2,
+	0x4c, 0xcc, 0x7d, 0xc8, 0x8f, 0x3c, 0xd9, 0x34, 0x69, 0x8e, 0x80, 0x56, 0xfc, 0x58, 0xfa, 0x26,
+	0xff, 0xf1, 0xe9, 0x9b, 0x42, 0x92, 0xf4, 0xcd, 0x9b, 0xb1, 0x1c, 0x8c, 0xec, 0x06, 0x30, 0xb5,
+	0x8a, 0x07, 0x31, 0x90, 0x24, 0x88, 0x89, 0xe4, 0x6f, 0x4a, 0xd3, 0xf2, 0x37, 0x8b, 0x33, 0xe6,
+	0x6f, 0x66, 0xcb, 0xce, 0xfc, 0x48, 0x81, 0x65, 0xba, 0xd0, 0x88, 0x98, 0xbe, 0x09, 0x39, 0x87,
+	0x74, 0x09, 0x39, 0xd5, 0xa6, 0xb3, 0xc9, 0xe0, 0x18, 0xf3, 0x67, 0x68, 0xfe, 0x5d, 0x81, 0x65,
+	0xfd, 0x74, 0x88, 0x6d, 0x0f, 0x57, 0x4d, 0x1f, 0xf7, 0x88, 0x49, 0x9d, 0x3b, 0xff, 0xfb, 0x16,
+	0xdf, 0xf6, 0x0c, 0xf5, 0x15, 0x6e, 0x4f, 0x09, 0x11, 0xe8, 0xc7, 0x23, 0x1b, 0x4f, 0x3c, 0xb7,
+	0x33, 0xcf, 0xc7, 0x03, 0x7e, 0x04, 0xf0, 0xd6, 0x3c, 0xb9, 0x7f, 0x0b, 0xae, 0x8f, 0x2d, 0x32,
+	0xc2, 0xff, 0x3d, 0x80, 0x0e, 0xeb, 0x0d, 0x13, 0x31, 0xaf, 0xc9, 0xcc, 0x59, 0xd0, 0x32, 0x22,
+	0xf8, 0xda, 0xbf, 0x64, 0x20, 0xcf, 0xc7, 0x3f, 0x39, 0xc3, 0x21, 0x8c, 0x43, 0x46, 0xd2, 0x38,
+	0x70, 0x5d, 0xce, 0x26, 0xd1, 0x65, 0xb1, 0xa9, 0xb9, 0x99, 0x36, 0x35, 0xbe, 0x79, 0xf9, 0x19,
+	0xb5, 0xb9, 0x30, 0x4d, 0x9b, 0x8b, 0x33, 0x6a, 0xf3, 0x2e, 0xb1, 0xbc, 0x6c, 0x37, 0xb9, 0xa9,
+	0x49, 0x26, 0x01, 0x01, 0x36, 0xfa, 0x0c, 0x2c, 0xba, 0x98, 0x9c, 0xff, 0x96, 0x4d, 0xa3, 0x0c,
+	0x66, 0x80, 0x4a, 0x41, 0x5f, 0xad, 0x1b, 0x98, 0x8e, 0xc5, 0x44, 0xa6, 0xe3, 0xef, 0x33, 0xa0,
+	0x1a, 0x82, 0xca, 0x27, 0x2e, 0x61, 0x5c, 0x62, 0x32, 0x49, 0x24, 0x26, 0xca, 0xd4, 0xec, 0x5c,
+	0x4c, 0xdd, 0x87, 0xe2, 0x91, 0x4b, 0xe2, 0x1b, 0xe2, 0x63, 0x31, 0x01, 0xbc, 0x37, 0x91, 0x14,
+	0x63, 0x14, 0xb6, 0x3b, 0x78, 0x5b, 0xe0, 0x19, 0x21, 0x09, 0x9a, 0x3d, 0xb0, 0x7d, 0xec, 0x9e,
+	0x98, 0x7d, 0x71, 0xce, 0x89, 0x36, 0x91, 0x54, 0xcf, 0x37, 0x49, 0x0c, 0x4b, 0x34, 0x4a, 0xe2,
+	0xca, 0x81, 0x42, 0x6f, 0x11, 0xb5, 0x7a, 0x00, 0x05, 0x6c, 0x77, 0x19, 0x62, 0x71, 0x2a, 0x62,
+	0x1e, 0xdb, 0x5d, 0x8a, 0xf6, 0x10, 0x8a, 0x34, 0x05, 0x4b, 0xf1, 0xa6, 0x1f, 0x74, 0x05, 0x02,
+	0x4c, 0x11, 0xe3, 0x4a, 0x55, 0x4a, 0x62, 0x11, 0x4d, 0x58, 0x1d, 0x17, 0xa6, 0x88, 0x49, 0xac,
+	0xc6, 0xa3, 0xa2, 0x3b, 0x12, 0xbc, 0x0e, 0xe9, 0x88, 0xc0, 0xe8, 0x55, 0xb8, 0x7a, 0x38, 0xec,
+	0x38, 0x83, 0x70, 0x84, 0x47, 0x47, 0x34, 0xd7, 0x73, 0xe6, 0x71, 0xb1, 0xa5, 0xbf, 0xe9, 0xc1,
+	0x38, 0x06, 0x8d, 0x1e, 0x41, 0x31, 0x50, 0x1b, 0xee, 0xdc, 0x27, 0x9c, 0x49, 0x88, 0x9f, 0x34,
+	0x80, 0xd1, 0xda, 0x70, 0x7d, 0x6c, 0x3e, 0x11, 0xfe, 0x6c, 0xc6, 0xf9, 0x33, 0x59, 0xac, 0xc7,
+	0xc8, 0x08, 0xf6, 0xfc, 0x58, 0x81, 0x4b, 0xe7, 0x29, 0xbf, 0x03, 0x05, 0xcc, 0x3a, 0x05, 0xf1,
+	0x5f, 0x92, 0xd1, 0x19, 0x23, 0xc0, 0x9a, 0xdf, 0x25, 0xf8, 0xef, 0x34, 0x94, 0xa8, 0x97, 0xf1,
+	0xc2, 0xb3, 0x64, 0xb1, 0x30, 0x39, 0x3b, 0x5b, 0x98, 0x9c, 0x9b, 0x29, 0x0d, 0x96, 0xbf, 0x38,
+	0x0d, 0x56, 0xb8, 0x38, 0x0d, 0x56, 0x1c, 0x8b, 0xee, 0xc2, 0x1c, 0x13, 0xc4, 0x72, 0x4c, 0xbf,
+	0x00, 0xe9, 0xb1, 0x30, 0x0d, 0xb6, 0x1c, 0x4d, 0x83, 0xfd, 0x3c, 0x03, 0x4b, 0x71, 0x65, 0x9d,
+	0x7b, 0xf7, 0xe7, 0x73, 0x06, 0xff, 0x5f, 0x44, 0xfe, 0xd7, 0x44, 0xe4, 0x15, 0x28, 0x89, 0x03,
+	0x38, 0x94, 0x13, 0xe1, 0xea, 0x9e, 0xd5, 0xba, 0x17, 0xa5, 0x52, 0xb7, 0xe1, 0x0a, 0x35, 0x1f,
+	0x35, 0xfb, 0x04, 0xdb, 0xbe, 0xe3, 0x9e, 0x71, 0x49, 0x0a, 0xe5, 0x43, 0xb9, 0xc0, 0x3a, 0xa4,
+	0x62, 0xe9, 0xa9, 0x9f, 0x2b, 0x3c, 0xac, 0x0f, 0x08, 0xed, 0xb8, 0xce, 0x68, 0x28, 0x5c, 0x11,
+	0x65, 0x96, 0x40, 0x34, 0x35, 0x43, 0x20, 0x1a, 0xc6, 0x67, 0xe9, 0xa4, 0xf1, 0x99, 0xf6, 0x47,
+	0x29, 0x50, 0x69, 0x4f, 0x13, 0xbb, 0x27, 0x56, 0x07, 0xef, 0x59, 0x47, 0xf8, 0x13, 0x5f, 0x00,
+	0xf5, 0x76, 0x3c, 0xdf, 0xec, 0xf7, 0x3d, 0x11, 0xaa, 0x89, 0x36, 0xfa, 0x2c, 0x2c, 0xf5, 0x4d,
+	0xcf, 0x0f, 0x2e, 0xa8, 0xb8, 0x85, 0x5e, 0x24, 0x9d, 0xe2, 0x22, 0x8d, 0x04, 0x7b, 0x0c, 0x88,
+	0x9c, 0xda, 0x3c, 0x01, 0x45, 0x01, 0xcc, 0x33, 0x8f, 0x38, 0xbc, 0xe6, 0x49, 0xaf, 0x3d, 0x56,
+	0xf6, 0x51, 0x32, 0x4f, 0x7a, 0x01, 0xfe, 0x75, 0x28, 0x50, 0x10, 0x82, 0xce, 0xd5, 0x8a, 0x0c,
+	0x93, 0x73, 0xff, 0xcf, 0x15, 0x28, 0xc7, 0x37, 0x19, 0xed, 0x42, 0x6e, 0x64, 0x8f, 0x3c, 0x7a,
+	0xb1, 0x43, 0xf8, 0x7d, 0x6f, 0xfa, 0x62, 0xe3, 0x12, 0x62, 0x70, 0x7c, 0x74, 0xc0, 0xca, 0xe7,
+	0xac, 0x0e, 0x6e, 0xf7, 0xad, 0x23, 0x51, 0xf8, 0x73, 0x67, 0x3a, 0xbd, 0xc8, 0x6e, 0x19, 0x25,
+	0x2f, 0x6c, 0x68, 0xbf, 0xa3, 0x00, 0x50, 0x88, 0x43, 0x9a, 0xf8, 0x1f, 0x77, 0xbf, 0x23, 0x49,
+	0x97, 0xd4, 0x4c, 0x49, 0x97, 0xf4, 0x58, 0xd2, 0x25, 0x12, 0xfb, 0x64, 0x62, 0xb1, 0x8f, 0xf6,
+	0x5b, 0x62, 0x26, 0x2c, 0xaf, 0x3f, 0x4f, 0x12, 0x61, 0x9e, 0xd4, 0xfe, 0xef, 0x8a, 0xfd, 0x0b,
+	0x73, 0xfb, 0x6f, 0x40, 0x96, 0x12, 0xe6, 0x02, 0x2e, 0x33, 0x13, 0x86, 0x30, 0x6f, 0x7a, 0xff,
+	0x90, 0xeb, 0x5a, 0x34, 0xbf, 0x5f, 0x89, 0x7b, 0x6a, 0xaf, 0x4e, 0x9f, 0xcc, 0xb9, 0x04, 0xff,
+	0x0f, 0xe8, 0x85, 0x2c, 0x3d, 0x98, 0x18, 0xaf, 0xe7, 0xf7, 0xd1, 0xe6, 0xe1, 0xf8, 0xef, 0x2b,
+	0xa0, 0x46, 0xa7, 0x43, 0x79, 0xfe, 0x35, 0xc8, 0x73, 0xe2, 0x9c, 0xeb, 0x72, 0x33, 0x12, 0x48,
+	0xf3, 0x72, 0xfe, 0x09, 0xa0, 0xe8, 0x94, 0x38, 0xef, 0x13, 0x45, 0x11, 0xe3, 0x4b, 0x12, 0xdc,
+	0xff, 0xaf, 0x14, 0x2c, 0xd5, 0xad, 0x3e, 0x36, 0x7b, 0xcf, 0xcd, 0x23, 0x89, 0xf9, 0x14, 0x99,
+	0xd9, 0x7c, 0x8a, 0xac, 0xb4, 0x4f, 0x11, 0x39, 0xe9, 0x73, 0x53, 0x4e, 0xfa, 0xfc, 0xf3, 0x3b,
+	0xe9, 0x0b, 0x13, 0x4e, 0xfa, 0xe2, 0xc5, 0xce, 0x20, 0x44, 0x0f, 0xf2, 0x0f, 0x53, 0x90, 0xe7,
+	0xac, 0x3f, 0x67, 0xe9, 0xa2, 0x46, 0x2b, 0x35, 0x66, 0xb4, 0x92, 0x16, 0x20, 0xcc, 0x92, 0x5b,
+	0x98, 0xbd, 0xf4, 0x2b, 0x48, 0xbf, 0xe4, 0x12, 0xa5, 0x5f, 0x48, 0xb8, 0xc6, 0x99, 0x11, 0x0f,
+	0xd7, 0x06, 0xac, 0x53, 0xce, 0x14, 0x70, 0x0a, 0x46, 0x80, 0x35, 0x7f, 0xb8, 0xf6, 0xd3, 0x34,
+	0xe4, 0xf9, 0x79, 0xf5, 0x7f, 0x2b, 0xe1, 0x38, 0x7b, 0xbe, 0x77, 0xe2, 0x3d, 0xc6, 0xf9, 0xa4,
+	0x61, 0x61, 0xce, 0x2b, 0x80, 0x62, 0x62, 0x41, 0xe2, 0xfb, 0x15, 0x17, 0x24, 0xee, 0x67, 0xc8,
+	0x09, 0x12, 0xa7, 0x60, 0x04, 0x58, 0xf3, 0x0b, 0xd2, 0x47, 0x69, 0x58, 0xe2, 0x64, 0x7f, 0x31,
+	0x2d, 0x6d, 0x34, 0x7a, 0xcb, 0x5d, 0x1c, 0xbd, 0xe5, 0x2f, 0x8e, 0xde, 0x0a, 0x17, 0x46, 0x6f,
+	0xc5, 0x8b, 0xa2, 0x37, 0x98, 0x62, 0xd3, 0x4b, 0xcf, 0xcf, 0xa6, 0x2f, 0x4e, 0xb0, 0xe9, 0x4b,
+	0x17, 0xdb, 0xf4, 0x72, 0xd4, 0xa6, 0xff, 0x30, 0x05, 0x99, 0x96, 0x6b, 0x0d, 0xa5, 0x2e, 0x79,
+	0xb8, 0xd6, 0xa6, 0x93, 0x05, 0x2a, 0x59, 0x9a, 0x10, 0xe5, 0xdb, 0x2d, 0x67, 0x0e, 0x19, 0x0a,
+	0xfa, 0x12, 0xa4, 0xb1, 0xdd, 0xe5, 0x3b, 0x2e, 0x87, 0x49, 0x10, 0xe6, 0xb9, 0x19, 0xaa, 0x41,
+	0x99, 0xb0, 0x23, 0x5e, 0x2d, 0xed, 0xbb, 0xd6, 0x50, 0xae, 0x42, 0x86, 0xe0, 0x1a, 0x0c, 0x5e,
+	0x7b, 0x08, 0x40, 0x9a, 0x93, 0xa3, 0x5d, 0x04, 0x19, 0x67, 0x88, 0x6d, 0x5e, 0x20, 0x4c, 0x7f,
+	0x6b, 0xdf, 0x53, 0xa0, 0x48, 0x30, 0x9b, 0x94, 0x09, 0x17, 0x23, 0x9e, 0xdb, 0x9f, 0x49, 0x91,
+	0x43, 0x42, 0x0b, 0xad, 0xf5, 0xd8, 0x1c, 0xaa, 0x7d, 0xc7, 0x7b, 0xa1, 0xa7, 0xbd, 0xf6, 0x51,
+	0x8e, 0xf1, 0xc9, 0xc0, 0xb4, 0xf0, 0xef, 0x01, 0x64, 0x08, 0xfb, 0xa4, 0x2a, 0x81, 0x28, 0x1a,
+	0x05, 0x9f, 0x38, 0xa3, 0x4f, 0x01, 0xd0, 0x22, 0x16, 0xa6, 0x19, 0xbc, 0x1e, 0xe6, 0x88, 0xd6,
+	0xc4, 0x13, 0xcd, 0x10, 0xc3, 0xac, 0x98, 0x2a, 0x13, 0x0e, 0xbf, 0x4b, 0x5f, 0x4e, 0xdc, 0x8c,
+	0x97, 0x10, 0xb3, 0x70, 0x37, 0xda, 0x85, 0xde, 0x09, 0xe8, 0x7b, 0xbe, 0x70, 0x23, 0x24, 0x4e,
+	0x0f, 0x3e, 0x05, 0xcf, 0xf7, 0x08, 0x05, 0xdf, 0xe9, 0x0b, 0x0a, 0x79, 0x69, 0x0a, 0x04, 0x89,
+	0x51, 0xd8, 0x86, 0xa5, 0xa1, 0xe9, 0x3e, 0xb3, 0xec, 0x1e, 0x27, 0x52, 0x90, 0x25, 0xb2, 0xc8,
+	0xf1, 0x18, 0x9d, 0x4d, 0x28, 0x39, 0xfe, 0x31, 0x76, 0x39, 0x95, 0xa2, 0x2c, 0x15, 0xa0, 0x58,
+	0xc1, 0x5c, 0x44, 0x98, 0xcd, 0xa8, 0x80, 0xf4, 0x5c, 0x3c, 0x71, 0x10, 0x12, 0x3a, 0x9f, 0x85,
+	0x25, 0x1e, 0x8f, 0xf0, 0xad, 0x63, 0x77, 0x67, 0x8b, 0x58, 0x64, 0xc9, 0xc9, 0xee, 0x7d, 0x36,
+	0xfa, 0x31, 0x02, 0xb4, 0xc8, 0x80, 0x02, 0x4a, 0x04, 0xe8, 0x21, 0x64, 0xd9, 0x4c, 0x96, 0x64,
+	0x67, 0xc2, 0xe0, 0xd1, 0x2f, 0xc3, 0x72, 0xe8, 0x1c, 0xf8, 0x8e, 0x6f, 0xf6, 0xa9, 0xf9, 0x94,
+	0x22, 0x11, 0xba, 0x15, 0x2d, 0x82, 0x48, 0x04, 0x69, 0x64, 0x07, 0x7d, 0x3c, 0x7f, 0x16, 0xed,
+	0x42, 0x9f, 0x26, 0xa2, 0xe6, 0xf9, 0xed, 0x21, 0x76, 0xdb, 0xcf, 0x06, 0x3c, 0x8b, 0x56, 0x24,
+	0x5d, 0x07, 0xd8, 0x7d, 0x34, 0x08, 0x4b, 0x68, 0x2e, 0x25, 0x2d, 0xa1, 0x79, 0x0c, 0xcb, 0x8d,
+	0xae, 0x33, 0xc0, 0x3e, 0x76, 0x45, 0x5d, 0xe0, 0x05, 0x76, 0x25, 0xe9, 0xbd, 0xc8, 0xcf, 0x52,
+	0xa0, 0x0a, 0xd2, 0xba, 0xe7, 0x5b, 0x03, 0xe2, 0xde, 0x09, 0x22, 0xca, 0x0c, 0xcf, 0x84, 0xc6,
+	0xb5, 0xb7, 0x0a, 0xb9, 0x01, 0xf6, 0x8f, 0x9d, 0x2e, 0xaf, 0xde, 0x9f, 0x12, 0xb2, 0xf3, 0xa9,
+	0xd4, 0x29, 0x8a, 0xc1, 0x51, 0xd1, 0x2d, 0x58, 0x36, 0x6d, 0x7b, 0x64, 0xf6, 0xc7, 0x13, 0x5b,
+	0x65, 0xd6, 0x1d, 0xa4, 0xa6, 0xde, 0xe0, 0x4f, 0x22, 0x92, 0x9c, 0x39, 0xec, 0xe1, 0x44, 0xb0,
+	0x39, 0xb9, 0xa4, 0x9b, 0xf3, 0x67, 0x0a, 0x40, 0x10, 0xd2, 0x9e, 0x12, 0x7b, 0xc4, 0xa3, 0x81,
+	0x70, 0x73, 0x8a, 0xbc, 0xa7, 0xd6, 0x45, 0x3a, 0xe4, 0x4c, 0xe6, 0x4a, 0xb0, 0x6c, 0xc1, 0x1d,
+	0x99, 0x39, 0x6e, 0x5b, 0xa7, 0x15, 0xe6, 0x4d, 0x70, 0xe4, 0x89, 0x67, 0x45, 0xec, 0xbd, 0x5a,
+	0x66, 0xec, 0xbd, 0xda, 0x3f, 0xa6, 0xa0, 0xcc, 0xa9, 0x56, 0x6c, 0x67, 0x60, 0xf6, 0xcf, 0xd0,
+	0xd7, 0x20, 0xcf, 0xe7, 0x27, 0x95, 0x6d, 0x10, 0x7c, 0x13, 0x48, 0xa8, 0x0a, 0x99, 0x67, 0x16,
+	0x7f, 0x25, 0x53, 0xde, 0xb8, 0x2b, 0x83, 0xcc, 0x3f, 0xfd, 0xc8, 0xb2, 0xbb, 0x06, 0x45, 0x26,
+	0x1e, 0xf3, 0xd0, 0xc5, 0x27, 0xb4, 0x56, 0x30, 0x9d, 0x60, 0x16, 0x01, 0x16, 0xd9, 0x7b, 0x1b,
+	0x9f, 0x26, 0xf3, 0x54, 0x28, 0x06, 0xfa, 0x2a, 0x64, 0x8f, 0xac, 0x53, 0xec, 0xad, 0x64, 0xa9,
+	0x7d, 0xb9, 0x25, 0xb9, 0x25, 0x06, 0xc3, 0xd2, 0x30, 0xac, 0xc4, 0x97, 0x15, 0x71, 0x3f, 0x6a,
+	0x50, 0x34, 0x69, 0x67, 0x58, 0x8c, 0xf2, 0x6a, 0x02, 0x06, 0x19, 0x21, 0xb6, 0xf6, 0x15, 0x28,
+	0x35, 0xcf, 0xec, 0x8e, 0x30, 0x00, 0xa1, 0x23, 0xa9, 0xc4, 0x1c, 0xc9, 0xc0, 0xcb, 0x4f, 0x45,
+	0xbc, 0x7c, 0xed, 0x6f, 0x32, 0x00, 0x04, 0xbb, 0x4a, 0xeb, 0xef, 0xd1, 0xdb, 0x90, 0xc3, 0xb6,
+	0x6f, 0xf9, 0x67, 0xfc, 0x79, 0xcf, 0xe4, 0x25, 0x13, 0x44, 0x9d, 0x82, 0x1b, 0x1c, 0x8d, 0xbb,
+	0x14, 0xa9, 0xc0, 0xa5, 0xa0, 0xaf, 0xba, 0xfa, 0x98, 0x58, 0xc6, 0xb4, 0x78, 0xd5, 0x45, 0x9b,
+	0xe8, 0x21, 0xaf, 0x0d, 0xce, 0x48, 0xd6, 0x06, 0xef, 0x2e, 0xf0, 0xea, 0xe0, 0x37, 0x45, 0xe2,
+	0x31, 0x2b, 0x9b, 0x78, 0xdc, 0x5d, 0x10, 0xa9, 0xc7, 0x77, 0xc2, 0x04, 0x5a, 0x4e, 0x3e, 0x81,
+	0xb6, 0xbb, 0x10, 0xa6, 0xd0, 0xde, 0x81, 0x3c, 0x3f, 0x83, 0x78, 0x79, 0x8b, 0x54, 0x00, 0x47,
+	0x28, 0x70, 0x34, 0x42, 0x41, 0xa8, 0x55, 0x41, 0x5e, 0x24, 0x09, 0x05, 0xa1, 0x58, 0x5f, 0x64,
+	0x0e, 0x7b, 0x51, 0xce, 0x61, 0xdf, 0x5d, 0x60, 0x2e, 0xfb, 0x0e, 0x89, 0x3d, 0xd9, 0xfb, 0x26,
+	0x5e, 0x7e, 0x30, 0xf9, 0x55, 0x47, 0xf4, 0x41, 0xd4, 0xee, 0x82, 0x11, 0x20, 0x6f, 0x16, 0x20,
+	0xe7, 0xe2, 0x8e, 0xe3, 0xd2, 0xa2, 0xf3, 0x02, 0x11, 0x82, 0x03, 0x32, 0xab, 0x0a, 0xe4, 0xd9,
+	0x2b, 0x0e, 0x21, 0xd0, 0xd3, 0x85, 0x87, 0x49, 0x9d, 0x21, 0xf0, 0x22, 0xb2, 0x9b, 0x8a, 0xc9,
+	0x6e, 0xf4, 0x71, 0x5a, 0x3a, 0xfe, 0x38, 0xed, 0x8f, 0x53, 0x00, 0x15, 0xdf, 0x37, 0x3b, 0xc7,
+	0x03, 0x6c, 0x9f, 0x2b, 0x8b, 0x8f, 0x08, 0x74, 0x6a, 0x36, 0x81, 0xbe, 0x41, 0xab, 0x13, 0x1c,
+	0xb7, 0x1b, 0xa9, 0x80, 0x63, 0x1d, 0x11, 0x6f, 0x3d, 0x13, 0x2f, 0x99, 0x1b, 0x58, 0x03, 0xcc,
+	0xde, 0x45, 0x65, 0x59, 0xa4, 0x49, 0x3a, 0x68, 0x99, 0x2b, 0x82, 0x8c, 0x67, 0x7d, 0x20, 0x6e,
+	0x4f, 0xe8, 0x6f, 0xe2, 0xea, 0x90, 0xc5, 0xf9, 0xc7, 0xa3, 0xc1, 0x53, 0xdb, 0xb4, 0x58, 0xa9,
+	0x4a, 0xc1, 0x58, 0x3c, 0x36, 0xbd, 0x96, 0xe8, 0x9b, 0xe3, 0x85, 0xac, 0x66, 0xc2, 0x95, 0x90,
+	0x41, 0x31, 0x13, 0x54, 0x32, 0x83, 0x7e, 0xb9, 0x3d, 0x0b, 0xe9, 0x18, 0x51, 0x5c, 0xed, 0x0f,
+	0x14, 0x50, 0xc3, 0xb1, 0xc3, 0x61, 0xdf, 0x31, 0xbb, 0xf3, 0xdb, 0x92, 0x18, 0xeb, 0x53, 0x17,
+	0xb0, 0x3e, 0x1d, 0x7f, 0x56, 0x4a, 0x5f, 0x17, 0x65, 0x22, 0xaf, 0x8b, 0x86, 0xd1, 0x99, 0xf1,
+	0xa0, 0xed, 0x85, 0xce, 0x4c, 0xab, 0xc0, 0xa5, 0x08, 0x9f, 0x3e, 0xfe, 0xb9, 0x06, 0x7a, 0x19,
+	0x8a, 0xe1, 0x86, 0xb3, 0x28, 0x31, 0xec, 0xd0, 0x7e, 0xa8, 0x44, 0x69, 0x54, 0x1d, 0xdb, 0x27,
+	0xb2, 0xbd, 0x03, 0x10, 0x32, 0x9d, 0x1f, 0xc9, 0xd2, 0xfb, 0x15, 0x41, 0x8d, 0x8b, 0x68, 0xea,
+	0xbc, 0x88, 0x52, 0x26, 0xa6, 0x23, 0x4c, 0xec, 0x42, 0xba, 0x65, 0xf6, 0x5e, 0xf4, 0x5f, 0x20,
+	0xe8, 0xb0, 0xd4, 0x32, 0x7b, 0xf1, 0xbf, 0x6e, 0xa0, 0x99, 0x37, 0x25, 0x51, 0xe6, 0xed, 0x37,
+	0x15, 0x00, 0x83, 0x6e, 0x46, 0xcb, 0xec, 0x79, 0x2f, 0x58, 0x0c, 0x5f, 0x82, 0x3c, 0xcb, 0xbf,
+	0xb0, 0x6b, 0xe3, 0xac, 0x91, 0xa3, 0x09, 0x18, 0x4f, 0xfb, 0x13, 0x05, 0xca, 0x2d, 0xb3, 0xc7,
+	0x68, 0xb1, 0x48, 0x61, 0xee, 0x99, 0x5c, 0x21, 0xf1, 0x0e, 0x09, 0x86, 0xf8, 0x11, 0xde, 0x89,
+	0x47, 0x41, 0xe9, 0x64, 0x51, 0x90, 0xf6, 0x17, 0x29, 0x80, 0x96, 0xd9, 0x6b, 0x8e, 0x06, 0x03,
+	0xd3, 0x3d, 0x43, 0x1b, 0x90, 0xf6, 0xcd, 0x9e, 0xd4, 0x7d, 0x35, 0x61, 0x36, 0x01, 0x26, 0x67,
+	0x0a, 0x9d, 0x1b, 0xf1, 0x62, 0x52, 0x12, 0x5e, 0x4c, 0x9c, 0x23, 0x46, 0x80, 0x3c, 0xf3, 0x22,
+	0x3e, 0x2e, 0x94, 0xcb, 0x3c, 0xa7, 0x50, 0x2e, 0x7b, 0x2e, 0x94, 0x5b, 0xbb, 0x0f, 0x8b, 0xd1,
+	0x77, 0xa7, 0x68, 0x19, 0x4a, 0x5b, 0xb5, 0x66, 0xab, 0xb2, 0x5f, 0xd5, 0xdb, 0x8f, 0xea, 0xea,
+	0x02, 0x42, 0x50, 0x0e, 0x3a, 0xea, 0xb5, 0x3d, 0xbd, 0xa9, 0x2a, 0x6b, 0x4d, 0x80, 0xf0, 0x65,
+	0x29, 0xba, 0x04, 0x4b, 0xef, 0x36, 0xf6, 0x0e, 0xeb, 0x7a, 0x7b, 0xaf, 0xd6, 0x32, 0xf4, 0xa6,
+	0xba, 0x80, 0xae, 0xc2, 0x25, 0xde, 0x75, 0xd8, 0x6c, 0xef, 0x54, 0xf6, 0xf6, 0x1a, 0xfb, 0x4d,
+	0x55, 0x41, 0x37, 0xe0, 0x25, 0xde, 0x5d, 0xab, 0x1f, 0xe8, 0x46, 0xad, 0xb2, 0x17, 0x0c, 0xa6,
+	0xd6, 0xde, 0x86, 0xe5, 0xb1, 0x27, 0xcd, 0xe8, 0x25, 0xb8, 0x5c, 0x6d, 0xec, 0x37, 0x0f, 0xeb,
+	0x07, 0xad, 0x5a, 0x63, 0xbf, 0x7d, 0xa0, 0x1b, 0xed, 0xd7, 0xef, 0xdd, 0x53, 0x17, 0xd0, 0x65,
+	0x58, 0x8e, 0x0e, 0xd4, 0x0f, 0x76, 0x54, 0x65, 0xed, 0x35, 0x58, 0x8a, 0x25, 0x24, 0xd1, 0x12,
+	0x14, 0x9b, 0x0d, 0xa3, 0xd5, 0xde, 0xd2, 0x9b, 0x55, 0x75, 0x01, 0x2d, 0x42, 0x81, 0x36, 0x2b,
+	0xcd, 0xaa, 0xaa, 0xac, 0xbd, 0x05, 0xc5, 0xe0, 0x86, 0x12, 0xad, 0xc0, 0x95, 0xcd, 0x4a, 0xab,
+	0xba, 0xdb, 0xae, 0xec, 0xed, 0xb5, 0x1b, 0x46, 0x7b, 0xbf, 0xd1, 0xda, 0xad, 0xed, 0xef, 0xb0,
+	0x95, 0xb0, 0x91, 0x4d, 0xbd, 0xd9, 0x6a, 0xeb, 0xdb, 0xdb, 0x0d, 0xa3, 0xa5, 0x2a, 0x6b, 0x27,
+	0x50, 0x8a, 0xd4, 0xfc, 0xa0, 0x22, 0x64, 0xf5, 0xfa, 0x41, 0xeb, 0x89, 0xba, 0x80, 0x00, 0x72,
+	0x3b, 0x15, 0xa3, 0xb2, 0xa3, 0xab, 0x0a, 0xe9, 0x6e, 0x35, 0x1a, 0x7b, 0x4d, 0x35, 0x85, 0xf2,
+	0x90, 0x6e, 0x55, 0x1e, 0xab, 0x69, 0x32, 0xa9, 0xda, 0x7e, 0xf3, 0xd0, 0x20, 0x0c, 0x55, 0x33,
+	0xa8, 0x00, 0x19, 0xa3, 0x51, 0xd9, 0x52, 0xb3, 0xa8, 0x04, 0xf9, 0xf7, 0x2a, 0x4d, 0xfa, 0xd9,
+	0x1c, 0x69, 0x1c, 0x54, 0x8c, 0x47, 0xa4, 0x91, 0x27, 0x64, 0x1a, 0xad, 0x5d, 0xdd, 0x50, 0x3b,
+	0x6b, 0x5b, 0x70, 0xf9, 0x63, 0x4a, 0x44, 0xd1, 0x35, 0x40, 0x86, 0x5e, 0x3d, 0x34, 0x0c, 0x9d,
+	0x6e, 0x53, 0x63, 0xbf, 0xb5, 0xbb, 0xf7, 0x84, 0xcd, 0x3e, 0xd2, 0xff, 0x44, 0xaf, 0x18, 0x7b,
+	0x4f, 0x54, 0x65, 0x6d, 0x08, 0xe5, 0x78, 0xfc, 0x89, 0xae, 0x80, 0xda, 0xd8, 0x6a, 0xd4, 0xf5,
+	0x96, 0x6e, 0xb4, 0x0f, 0xf7, 0x1f, 0xed, 0x37, 0xde, 0xdb, 0x67, 0x7b, 0x1f, 0xf4, 0xea, 0x8f,
+	0x2b, 0xd5, 0x96, 0xaa, 0xa0, 0xeb, 0x70, 0x35, 0xe8, 0xab, 0xed, 0xb7, 0x74, 0xe3, 0xa0, 0xb1,
+	0x57, 0x69, 0xe9, 0x5b, 0x6a, 0x2a, 0x36, 0xa4, 0x3f, 0x6e, 0x19, 0x15, 0x31, 0x94, 0x5e, 0xfb,
+	0x50, 0x01, 0x74, 0x3e, 0x22, 0x22, 0x02, 0x41, 0x64, 0xaa, 0xb2, 0xa3, 0xb7, 0x2b, 0xfb, 0x8d,
+	0x7a, 0x65, 0xef, 0x49, 0xe4, 0xeb, 0xd7, 0xe1, 0xaa, 0x18, 0xdc, 0x27, 0x9b, 0xdc, 0xd8, 0x6f,
+	0xb4, 0x1a, 0xfb, 0xb5, 0xaa, 0xaa, 0xa0, 0x9b, 0xf0, 0xb2, 0x18, 0xaa, 0xd5, 0x0f, 0xf6, 0x2a,
+	0x87, 0xcd, 0xda, 0xe6, 0x9e, 0xde, 0x16, 0x82, 0xaa, 0xa6, 0xd0, 0x2a, 0x5c, 0x13, 0x10, 0x5b,
+	0x87, 0x07, 0x7b, 0xb5, 0x6a, 0xa5, 0xa5, 0xb7, 0xb7, 0x2a, 0x2d, 0x5d, 0x4d, 0xaf, 0x9d, 0x82,
+	0x3a, 0x1e, 0x6e, 0x12, 0x51, 0x13, 0xf0, 0xdb, 0xb5, 0xc7, 0x91, 0x59, 0xbc, 0x0c, 0x2b, 0xd1,
+	0x81, 0xa6, 0xde, 0x0a, 0x3f, 0xa3, 0x10, 0x06, 0x47, 0x47, 0xeb, 0xba, 0xb1, 0x43, 0xbe, 0x7e,
+	0x0d, 0x50, 0xb4, 0x7b, 0x4b, 0xdf, 0xd3, 0xe9, 0x97, 0xbf, 0x00, 0x45, 0x7a, 0xff, 0x5c, 0x25,
+	0x42, 0x57, 0x80, 0x8c, 0x7e, 0xef, 0xde, 0xeb, 0xea, 0x02, 0xff, 0xb5, 0xa1, 0x2a, 0xfc, 0xd7,
+	0x7d, 0x35, 0xb5, 0xf6, 0x87, 0x0a, 0x8b, 0x63, 0x98, 0x75, 0x41, 0x2a, 0x2c, 0x36, 0x9f, 0xec,
+	0x57, 0x23, 0x13, 0x23, 0xd2, 0x4d, 0x7a, 0xb6, 0x0f, 0xf5, 0x3d, 0x55, 0x41, 0x65, 0x00, 0xda,
+	0x6c, 0x18, 0x5b, 0xba, 0xa1, 0xa6, 0x02, 0x04, 0xfd, 0xf1, 0x81, 0xbe, 0xdf, 0xd4, 0xd5, 0x74,
+	0xd0, 0xd3, 0xd4, 0x8d, 0x77, 0x6b, 0x54, 0xf8, 0x44, 0x0f, 0x9f, 0xab, 0x9a, 0xa5, 0x3a, 0x42,
+	0x7a, 0xaa, 0x15, 0x43, 0xcd, 0x91, 0x65, 0xb0, 0x8f, 0x36, 0x75, 0x83, 0xac, 0xbc, 0x55, 0xdb,
+	0xdf, 0x69, 0xaa, 0xf9, 0x8d, 0x7f, 0xce, 0x42, 0x99, 0x38, 0xd3, 0x06, 0x1e, 0x3a, 0x9e, 0x45,
+	0x8b, 0x5f, 0xea, 0x90, 0xdf, 0xc1, 0x7e, 0xd5, 0x74, 0x3d, 0x74, 0xed, 0xdc, 0xe1, 0xa8, 0x0f,
+	0x86, 0xfe, 0xd9, 0xea, 0xda, 0x34, 0xbf, 0x3e, 0x72, 0x50, 0x3e, 0x86, 0x25, 0x42, 0x2e, 0xf8,
+	0xbb, 0x88, 0x0b, 0x89, 0xde, 0x95, 0xfa, 0xab, 0x81, 0x08, 0xe5, 0x6f, 0x00, 0xda, 0xc1, 0xfe,
+	0xf8, 0x7f, 0x68, 0x5c, 0x44, 0x7e, 0x72, 0x85, 0xec, 0x38, 0x95, 0x16, 0x2c, 0xef, 0x60, 0x3f,
+	0xf6, 0xbf, 0x0b, 0x17, 0x11, 0x96, 0x8f, 0x54, 0xd0, 0x31, 0xa8, 0x4d, 0xf3, 0x04, 0xc7, 0xfa,
+	0xe4, 0xd1, 0x93, 0x7c, 0xe9, 0xbb, 0x70, 0x89, 0x7c, 0x29, 0xfa, 0x78, 0xdd, 0x43, 0xf7, 0xa5,
+	0x5f, 0xca, 0x87, 0x5c, 0x5e, 0x9d, 0x05, 0x09, 0x7d, 0x17, 0x2e, 0xb3, 0xb7, 0xf2, 0xf1, 0x09,
+	0xdc, 0x95, 0xa6, 0xc5, 0xb0, 0x57, 0x1f, 0x24, 0x44, 0x60, 0x65, 0x1f, 0x1b, 0x7f, 0x59, 0x60,
+	0xcf, 0x4a, 0x23, 0x52, 0xfd, 0x2d, 0x28, 0xec, 0x60, 0xfa, 0xb7, 0x0e, 0x1e, 0xba, 0x35, 0x35,
+	0xa0, 0x67, 0xce, 0xf8, 0xea, 0xf4, 0xb7, 0x92, 0x91, 0x35, 0x1f, 0x42, 0x61, 0xdb, 0xb2, 0xbb,
+	0xf4, 0x8f, 0x95, 0x26, 0xd7, 0xd3, 0x04, 0x2f, 0xa5, 0x57, 0xa7, 0xa7, 0x16, 0x50, 0x87, 0x4a,
+	0x79, 0xfc, 0xd1, 0xe8, 0xc5, 0xc2, 0xf8, 0x20, 0xc1, 0xd3, 0xd3, 0xc8, 0xdc, 0xdf, 0x83, 0x45,
+	0xce, 0x9d, 0x16, 0xfd, 0x9f, 0xaa, 0xd9, 0x74, 0xf4, 0x63, 0xfe, 0x2d, 0xeb, 0x00, 0x0a, 0x44,
+	0x0e, 0xe9, 0x4a, 0xa6, 0x2f, 0x56, 0x86, 0x1f, 0x47, 0x50, 0xa6, 0xa7, 0xbd, 0x20, 0xeb, 0x4d,
+	0x61, 0x76, 0xf0, 0x62, 0x7a, 0x8a, 0x05, 0x18, 0x7f, 0x96, 0xed, 0x50, 0xbb, 0x15, 0xbc, 0x2d,
+	0xf5, 0xd0, 0x6b, 0x72, 0x8f, 0x50, 0xb9, 0xe8, 0xdc, 0x93, 0x83, 0x8e, 0xb0, 0xea, 0x57, 0xa1,
+	0x5c, 0xa5, 0xf1, 0x46, 0xf0, 0xdf, 0x23, 0x72, 0x6f, 0x80, 0x57, 0xe5, 0xc0, 0x08, 0x7d, 0x03,
+	0x93, 0x38, 0xe8, 0x05, 0xd1, 0x7f, 0x1f, 0x16, 0xeb, 0xce, 0xc9, 0x8b, 0xa2, 0xde, 0x81, 0x32,
+	0xfd, 0xdb, 0xb3, 0x50, 0x46, 0xd7, 0xa4, 0x10, 0x29, 0x92, 0xe4, 0x47, 0x36, 0xfe, 0x63, 0x89,
+	0xbf, 0x8d, 0x8c, 0x18, 0x8e, 0x0e, 0x14, 0x77, 0xb0, 0xdf, 0x60, 0x75, 0x8a, 0xb7, 0xa7, 0x27,
+	0xf4, 0xf8, 0xfe, 0xbf, 0x36, 0x1d, 0x32, 0xa6, 0x7f, 0x45, 0x62, 0x3b, 0xd8, 0x63, 0x5f, 0x59,
+	0xe3, 0x21, 0x91, 0x5d, 0x44, 0x4f, 0xa8, 0x14, 0x07, 0xe5, 0xb8, 0x17, 0x6b, 0xf6, 0x3d, 0xb9,
+	0x7a, 0xde, 0xc8, 0x9c, 0x9b, 0x50, 0x24, 0x3a, 0xc8, 0xbe, 0x23, 0x31, 0x17, 0xa9, 0xf9, 0x5a,
+	0xb0, 0x1c, 0x68, 0x37, 0xe7, 0xf9, 0x2d, 0xc9, 0x82, 0xc9, 0xd5, 0x3b, 0x92, 0x80, 0x5c, 0xc1,
+	0x3d, 0xb8, 0x24, 0x58, 0x13, 0x56, 0xfe, 0xbe, 0x9e, 0xa0, 0xd2, 0x57, 0xea, 0x90, 0x18, 0xa3,
+	0xff, 0x3e, 0x2c, 0xd5, 0x4d, 0xf7, 0x19, 0x2f, 0xe0, 0xc5, 0x5d, 0x99, 0xd5, 0xd1, 0x42, 0x5f,
+	0x49, 0xee, 0x95, 0x76, 0xb0, 0xaf, 0x8b, 0x1a, 0xcf, 0x35, 0x99, 0x04, 0x32, 0x5f, 0xc5, 0xba,
+	0xd4, 0xbb, 0xb8, 0x70, 0xf7, 0xbf, 0x09, 0x25, 0x22, 0xb1, 0xe2, 0xa1, 0x94, 0xac, 0xcc, 0x4a,
+	0xe5, 0xb4, 0xd1, 0x13, 0x28, 0x31, 0xef, 0x85, 0x35, 0xa5, 0x90, 0x24, 0x49, 0x3b, 0x70, 0x29,
+	0x10, 0xb0, 0x80, 0x51, 0x5f, 0x90, 0xae, 0x0b, 0x5d, 0xbd, 0x2b, 0x0d, 0xca, 0xc5, 0xec, 0x08,
+	0xae, 0x84, 0x7b, 0x52, 0x0d, 0x5e, 0xe9, 0x5e, 0xa8, 0x88, 0x5f, 0x4a, 0xf2, 0x40, 0x31, 0xb2,
+	0x21, 0xdf, 0x81, 0xcb, 0x91, 0x35, 0x05, 0x2f, 0xa9, 0x13, 0xbd, 0x77, 0x5c, 0x4d, 0x04, 0x8d,
+	0x7a, 0x74, 0x69, 0xe3, 0x4f, 0xde, 0x2e, 0x5e, 0xda, 0xc3, 0x44, 0x4f, 0xe7, 0x22, 0x6b, 0xf3,
+	0xe1, 0x0a, 0x59, 0xdb, 0xb9, 0xb7, 0xa7, 0xc9, 0xde, 0xe2, 0xad, 0x26, 0x03, 0x47, 0x8f, 0xe1,
+	0xda, 0x16, 0xbd, 0x10, 0x3a, 0x37, 0x22, 0x2b, 0xed, 0x17, 0x30, 0x02, 0xfd, 0x86, 0x02, 0x97,
+	0x49, 0x78, 0x11, 0x7f, 0x96, 0xe7, 0xa1, 0x8d, 0x24, 0xaf, 0xf8, 0xb8, 0xe2, 0x7e, 0x29, 0x09,
+	0x4e, 0xc8, 0xd3, 0x8d, 0xdf, 0x03, 0xfa, 0x6f, 0xb4, 0x91, 0x93, 0x8e, 0x59, 0x8f, 0xa6, 0xa8,
+	0xe6, 0x5b, 0x93, 0xb9, 0x3c, 0x92, 0xb2, 0x1e, 0xe7, 0x6b, 0x0d, 0xb9, 0xf5, 0x10, 0x45, 0xa3,
+	0xcf, 0xc7, 0x7a, 0x08, 0x6a, 0xdc, 0x7a, 0x88, 0xa6, 0x14, 0x92, 0x24, 0x69, 0xc6, 0xa2, 0xba,
+	0xa8, 0x9c, 0x5d, 0x93, 0xbb, 0x75, 0x95, 0x60, 0xd1, 0xf9, 0xba, 0x5e, 0xbe, 0x0a, 0x51, 0xfd,
+	0x2c, 0x75, 0x11, 0xb7, 0x2a, 0x05, 0xc5, 0x63, 0xa1, 0x96, 0x6b, 0x0d, 0xa7, 0x9d, 0xae, 0x61,
+	0x35, 0xd9, 0x94, 0x63, 0x6e, 0xac, 0x82, 0xed, 0x5d, 0x28, 0xd2, 0x52, 0x32, 0x5a, 0xe7, 0xf7,
+	0xf9, 0xa9, 0x98, 0x14, 0x76, 0x75, 0x7a, 0xe5, 0x15, 0xa1, 0x4b, 0xcb, 0xc3, 0x24, 0xe9, 0x52,
+	0x58, 0x19, 0xba, 0x7b, 0x00, 0x4c, 0xd5, 0x25, 0x08, 0x4f, 0x57, 0xef, 0x6f, 0x51, 0xa7, 0x2b,
+	0x52, 0x61, 0x26, 0x4b, 0x70, 0xfa, 0x66, 0x70, 0x82, 0x0e, 0xa8, 0xa2, 0xf2, 0x45, 0xa4, 0xff,
+	0xa6, 0x58, 0xfa, 0xb1, 0x5a, 0x9c, 0x69, 0xce, 0xd2, 0x78, 0x79, 0x8d, 0x4f, 0x0d, 0x56, 0x2c,
+	0xef, 0x47, 0x0e, 0x31, 0xd9, 0x85, 0x3d, 0x48, 0x50, 0x3f, 0x10, 0x11, 0xa3, 0x3e, 0xc0, 0xb6,
+	0x75, 0x2a, 0xc4, 0x56, 0xb6, 0xc6, 0x61, 0xc6, 0xaf, 0x6d, 0x38, 0x50, 0x66, 0xc5, 0x0a, 0x81,
+	0x45, 0xfc, 0x15, 0x80, 0x1d, 0xec, 0x57, 0xf9, 0x0d, 0xf0, 0xed, 0xa9, 0x77, 0x22, 0x62, 0xb9,
+	0x9f, 0x9b, 0x0a, 0x79, 0x60, 0xf6, 0xf0, 0xc6, 0xcf, 0xd2, 0xd1, 0xeb, 0xcf, 0xc8, 0x77, 0x5d,
+	0x28, 0xef, 0x60, 0x3f, 0x1c, 0xf2, 0xa6, 0x9c, 0x74, 0xe3, 0xb7, 0x88, 0xab, 0xaf, 0x4b, 0x82,
+	0x47, 0x78, 0xfd, 0x6d, 0x50, 0xd9, 0xe5, 0x68, 0xe4, 0xc6, 0x5a, 0xf6, 0xab, 0x0c, 0x71, 0x55,
+	0xf6, 0xc2, 0x0f, 0xf9, 0x80, 0xb6, 0x9c, 0x5f, 0xb3, 0xc7, 0xbe, 0xb6, 0x2e, 0x7b, 0x5f, 0xc8,
+	0xb9, 0xbc, 0x2e, 0xbd, 0x48, 0x76, 0x47, 0x69, 0x80, 0xca, 0x94, 0x3c, 0xf2, 0xcd, 0x39, 0x55,
+	0x7d, 0xe3, 0x6f, 0xd3, 0xf4, 0x62, 0xf0, 0x5c, 0xfa, 0x94, 0x5e, 0xef, 0xcd, 0x96, 0x3e, 0x8d,
+	0xdf, 0x33, 0xd6, 0x21, 0x4f, 0x8e, 0x81, 0x96, 0xd9, 0x43, 0x53, 0xef, 0xbd, 0x56, 0xa7, 0x42,
+	0xa0, 0x47, 0x50, 0xe4, 0x86, 0xce, 0xec, 0xcd, 0x6d, 0xe7, 0xba, 0xb0, 0xd4, 0xa4, 0xfe, 0x9f,
+	0xb8, 0xcf, 0xbc, 0x35, 0xcd, 0xc1, 0xe2, 0x80, 0x89, 0x38, 0xc0, 0xad, 0x69, 0x78, 0x19, 0xf8,
+	0x9c, 0xac, 0x69, 0x40, 0x70, 0xb3, 0xf5, 0x8d, 0x5b, 0x21, 0xe4, 0x5d, 0x02, 0x79, 0x87, 0x82,
+	0xde, 0x65, 0xa0, 0x77, 0xdd, 0x61, 0x87, 0xff, 0xfc, 0xbb, 0x94, 0x5a, 0x19, 0xf9, 0xce, 0x3e,
+	0x19, 0x7d, 0xbf, 0x49, 0xbb, 0x3e, 0x4a, 0x5d, 0x1d, 0xef, 0x7a, 0xbf, 0x8e, 0x7d, 0xf3, 0x69,
+	0x8e, 0x72, 0xeb, 0xfe, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x20, 0x39, 0x50, 0xd9, 0x40, 0x61,
+	0x00, 0x00,
 }
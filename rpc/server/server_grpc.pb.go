@@ -0,0 +1,2678 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: server.proto
+
+package server
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserRepository_GetCars_FullMethodName             = "/xelbot.com.autonotes.server.UserRepository/GetCars"
+	UserRepository_GetCurrencies_FullMethodName       = "/xelbot.com.autonotes.server.UserRepository/GetCurrencies"
+	UserRepository_GetDefaultCurrency_FullMethodName  = "/xelbot.com.autonotes.server.UserRepository/GetDefaultCurrency"
+	UserRepository_GetUserSettings_FullMethodName     = "/xelbot.com.autonotes.server.UserRepository/GetUserSettings"
+	UserRepository_SaveUserSettings_FullMethodName    = "/xelbot.com.autonotes.server.UserRepository/SaveUserSettings"
+	UserRepository_SaveExchangeRates_FullMethodName   = "/xelbot.com.autonotes.server.UserRepository/SaveExchangeRates"
+	UserRepository_ImportExchangeRates_FullMethodName = "/xelbot.com.autonotes.server.UserRepository/ImportExchangeRates"
+)
+
+// UserRepositoryClient is the client API for UserRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserRepositoryClient interface {
+	GetCars(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CarCollection, error)
+	GetCurrencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CurrencyCollection, error)
+	GetDefaultCurrency(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DefaultCurrency, error)
+	GetUserSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserSettings, error)
+	SaveUserSettings(ctx context.Context, in *UserSettings, opts ...grpc.CallOption) (*UserSettings, error)
+	// the rates are shared by every user, allowed for admins only
+	SaveExchangeRates(ctx context.Context, in *ExchangeRateCollection, opts ...grpc.CallOption) (*ExchangeRateCollection, error)
+	ImportExchangeRates(ctx context.Context, in *ExchangeRateImport, opts ...grpc.CallOption) (*ExchangeRateImportResult, error)
+}
+
+type userRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserRepositoryClient(cc grpc.ClientConnInterface) UserRepositoryClient {
+	return &userRepositoryClient{cc}
+}
+
+func (c *userRepositoryClient) GetCars(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CarCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CarCollection)
+	err := c.cc.Invoke(ctx, UserRepository_GetCars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRepositoryClient) GetCurrencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CurrencyCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CurrencyCollection)
+	err := c.cc.Invoke(ctx, UserRepository_GetCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRepositoryClient) GetDefaultCurrency(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DefaultCurrency, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultCurrency)
+	err := c.cc.Invoke(ctx, UserRepository_GetDefaultCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRepositoryClient) GetUserSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSettings)
+	err := c.cc.Invoke(ctx, UserRepository_GetUserSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRepositoryClient) SaveUserSettings(ctx context.Context, in *UserSettings, opts ...grpc.CallOption) (*UserSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSettings)
+	err := c.cc.Invoke(ctx, UserRepository_SaveUserSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRepositoryClient) SaveExchangeRates(ctx context.Context, in *ExchangeRateCollection, opts ...grpc.CallOption) (*ExchangeRateCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRateCollection)
+	err := c.cc.Invoke(ctx, UserRepository_SaveExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRepositoryClient) ImportExchangeRates(ctx context.Context, in *ExchangeRateImport, opts ...grpc.CallOption) (*ExchangeRateImportResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRateImportResult)
+	err := c.cc.Invoke(ctx, UserRepository_ImportExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserRepositoryServer is the server API for UserRepository service.
+// All implementations should embed UnimplementedUserRepositoryServer
+// for forward compatibility.
+type UserRepositoryServer interface {
+	GetCars(context.Context, *emptypb.Empty) (*CarCollection, error)
+	GetCurrencies(context.Context, *emptypb.Empty) (*CurrencyCollection, error)
+	GetDefaultCurrency(context.Context, *emptypb.Empty) (*DefaultCurrency, error)
+	GetUserSettings(context.Context, *emptypb.Empty) (*UserSettings, error)
+	SaveUserSettings(context.Context, *UserSettings) (*UserSettings, error)
+	// the rates are shared by every user, allowed for admins only
+	SaveExchangeRates(context.Context, *ExchangeRateCollection) (*ExchangeRateCollection, error)
+	ImportExchangeRates(context.Context, *ExchangeRateImport) (*ExchangeRateImportResult, error)
+}
+
+// UnimplementedUserRepositoryServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserRepositoryServer struct{}
+
+func (UnimplementedUserRepositoryServer) GetCars(context.Context, *emptypb.Empty) (*CarCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCars not implemented")
+}
+func (UnimplementedUserRepositoryServer) GetCurrencies(context.Context, *emptypb.Empty) (*CurrencyCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencies not implemented")
+}
+func (UnimplementedUserRepositoryServer) GetDefaultCurrency(context.Context, *emptypb.Empty) (*DefaultCurrency, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultCurrency not implemented")
+}
+func (UnimplementedUserRepositoryServer) GetUserSettings(context.Context, *emptypb.Empty) (*UserSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSettings not implemented")
+}
+func (UnimplementedUserRepositoryServer) SaveUserSettings(context.Context, *UserSettings) (*UserSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveUserSettings not implemented")
+}
+func (UnimplementedUserRepositoryServer) SaveExchangeRates(context.Context, *ExchangeRateCollection) (*ExchangeRateCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveExchangeRates not implemented")
+}
+func (UnimplementedUserRepositoryServer) ImportExchangeRates(context.Context, *ExchangeRateImport) (*ExchangeRateImportResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedUserRepositoryServer) testEmbeddedByValue() {}
+
+// UnsafeUserRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserRepositoryServer will
+// result in compilation errors.
+type UnsafeUserRepositoryServer interface {
+	mustEmbedUnimplementedUserRepositoryServer()
+}
+
+func RegisterUserRepositoryServer(s grpc.ServiceRegistrar, srv UserRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedUserRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserRepository_ServiceDesc, srv)
+}
+
+func _UserRepository_GetCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRepositoryServer).GetCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRepository_GetCars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRepositoryServer).GetCars(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRepository_GetCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRepositoryServer).GetCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRepository_GetCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRepositoryServer).GetCurrencies(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRepository_GetDefaultCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRepositoryServer).GetDefaultCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRepository_GetDefaultCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRepositoryServer).GetDefaultCurrency(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRepository_GetUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRepositoryServer).GetUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRepository_GetUserSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRepositoryServer).GetUserSettings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRepository_SaveUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRepositoryServer).SaveUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRepository_SaveUserSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRepositoryServer).SaveUserSettings(ctx, req.(*UserSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRepository_SaveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRateCollection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRepositoryServer).SaveExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRepository_SaveExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRepositoryServer).SaveExchangeRates(ctx, req.(*ExchangeRateCollection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRepository_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRateImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRepositoryServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRepository_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRepositoryServer).ImportExchangeRates(ctx, req.(*ExchangeRateImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserRepository_ServiceDesc is the grpc.ServiceDesc for UserRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xelbot.com.autonotes.server.UserRepository",
+	HandlerType: (*UserRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCars",
+			Handler:    _UserRepository_GetCars_Handler,
+		},
+		{
+			MethodName: "GetCurrencies",
+			Handler:    _UserRepository_GetCurrencies_Handler,
+		},
+		{
+			MethodName: "GetDefaultCurrency",
+			Handler:    _UserRepository_GetDefaultCurrency_Handler,
+		},
+		{
+			MethodName: "GetUserSettings",
+			Handler:    _UserRepository_GetUserSettings_Handler,
+		},
+		{
+			MethodName: "SaveUserSettings",
+			Handler:    _UserRepository_SaveUserSettings_Handler,
+		},
+		{
+			MethodName: "SaveExchangeRates",
+			Handler:    _UserRepository_SaveExchangeRates_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _UserRepository_ImportExchangeRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server.proto",
+}
+
+const (
+	FuelRepository_GetFuels_FullMethodName           = "/xelbot.com.autonotes.server.FuelRepository/GetFuels"
+	FuelRepository_FindFuel_FullMethodName           = "/xelbot.com.autonotes.server.FuelRepository/FindFuel"
+	FuelRepository_GetFillingStations_FullMethodName = "/xelbot.com.autonotes.server.FuelRepository/GetFillingStations"
+	FuelRepository_GetFuelTypes_FullMethodName       = "/xelbot.com.autonotes.server.FuelRepository/GetFuelTypes"
+	FuelRepository_SaveFuel_FullMethodName           = "/xelbot.com.autonotes.server.FuelRepository/SaveFuel"
+	FuelRepository_BatchSaveFuels_FullMethodName     = "/xelbot.com.autonotes.server.FuelRepository/BatchSaveFuels"
+	FuelRepository_GetFuelPrices_FullMethodName      = "/xelbot.com.autonotes.server.FuelRepository/GetFuelPrices"
+	FuelRepository_CreateFuelType_FullMethodName     = "/xelbot.com.autonotes.server.FuelRepository/CreateFuelType"
+	FuelRepository_RenameFuelType_FullMethodName     = "/xelbot.com.autonotes.server.FuelRepository/RenameFuelType"
+	FuelRepository_MoveFuelType_FullMethodName       = "/xelbot.com.autonotes.server.FuelRepository/MoveFuelType"
+	FuelRepository_MergeFuelTypes_FullMethodName     = "/xelbot.com.autonotes.server.FuelRepository/MergeFuelTypes"
+)
+
+// FuelRepositoryClient is the client API for FuelRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FuelRepositoryClient interface {
+	GetFuels(ctx context.Context, in *FuelFilter, opts ...grpc.CallOption) (*FuelCollection, error)
+	FindFuel(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Fuel, error)
+	GetFillingStations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FillingStationCollection, error)
+	GetFuelTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FuelTypeCollection, error)
+	SaveFuel(ctx context.Context, in *Fuel, opts ...grpc.CallOption) (*Fuel, error)
+	BatchSaveFuels(ctx context.Context, in *FuelBatch, opts ...grpc.CallOption) (*FuelBatchResult, error)
+	GetFuelPrices(ctx context.Context, in *FuelPriceFilter, opts ...grpc.CallOption) (*FuelPriceCollection, error)
+	// management of fuel types, allowed for admins only
+	CreateFuelType(ctx context.Context, in *FuelType, opts ...grpc.CallOption) (*FuelType, error)
+	RenameFuelType(ctx context.Context, in *FuelType, opts ...grpc.CallOption) (*FuelType, error)
+	MoveFuelType(ctx context.Context, in *FuelType, opts ...grpc.CallOption) (*FuelType, error)
+	MergeFuelTypes(ctx context.Context, in *FuelTypeMerge, opts ...grpc.CallOption) (*FuelType, error)
+}
+
+type fuelRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFuelRepositoryClient(cc grpc.ClientConnInterface) FuelRepositoryClient {
+	return &fuelRepositoryClient{cc}
+}
+
+func (c *fuelRepositoryClient) GetFuels(ctx context.Context, in *FuelFilter, opts ...grpc.CallOption) (*FuelCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelCollection)
+	err := c.cc.Invoke(ctx, FuelRepository_GetFuels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) FindFuel(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Fuel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Fuel)
+	err := c.cc.Invoke(ctx, FuelRepository_FindFuel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) GetFillingStations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FillingStationCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FillingStationCollection)
+	err := c.cc.Invoke(ctx, FuelRepository_GetFillingStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) GetFuelTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FuelTypeCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelTypeCollection)
+	err := c.cc.Invoke(ctx, FuelRepository_GetFuelTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) SaveFuel(ctx context.Context, in *Fuel, opts ...grpc.CallOption) (*Fuel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Fuel)
+	err := c.cc.Invoke(ctx, FuelRepository_SaveFuel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) BatchSaveFuels(ctx context.Context, in *FuelBatch, opts ...grpc.CallOption) (*FuelBatchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelBatchResult)
+	err := c.cc.Invoke(ctx, FuelRepository_BatchSaveFuels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) GetFuelPrices(ctx context.Context, in *FuelPriceFilter, opts ...grpc.CallOption) (*FuelPriceCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelPriceCollection)
+	err := c.cc.Invoke(ctx, FuelRepository_GetFuelPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) CreateFuelType(ctx context.Context, in *FuelType, opts ...grpc.CallOption) (*FuelType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelType)
+	err := c.cc.Invoke(ctx, FuelRepository_CreateFuelType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) RenameFuelType(ctx context.Context, in *FuelType, opts ...grpc.CallOption) (*FuelType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelType)
+	err := c.cc.Invoke(ctx, FuelRepository_RenameFuelType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) MoveFuelType(ctx context.Context, in *FuelType, opts ...grpc.CallOption) (*FuelType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelType)
+	err := c.cc.Invoke(ctx, FuelRepository_MoveFuelType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelRepositoryClient) MergeFuelTypes(ctx context.Context, in *FuelTypeMerge, opts ...grpc.CallOption) (*FuelType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelType)
+	err := c.cc.Invoke(ctx, FuelRepository_MergeFuelTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FuelRepositoryServer is the server API for FuelRepository service.
+// All implementations should embed UnimplementedFuelRepositoryServer
+// for forward compatibility.
+type FuelRepositoryServer interface {
+	GetFuels(context.Context, *FuelFilter) (*FuelCollection, error)
+	FindFuel(context.Context, *IdRequest) (*Fuel, error)
+	GetFillingStations(context.Context, *emptypb.Empty) (*FillingStationCollection, error)
+	GetFuelTypes(context.Context, *emptypb.Empty) (*FuelTypeCollection, error)
+	SaveFuel(context.Context, *Fuel) (*Fuel, error)
+	BatchSaveFuels(context.Context, *FuelBatch) (*FuelBatchResult, error)
+	GetFuelPrices(context.Context, *FuelPriceFilter) (*FuelPriceCollection, error)
+	// management of fuel types, allowed for admins only
+	CreateFuelType(context.Context, *FuelType) (*FuelType, error)
+	RenameFuelType(context.Context, *FuelType) (*FuelType, error)
+	MoveFuelType(context.Context, *FuelType) (*FuelType, error)
+	MergeFuelTypes(context.Context, *FuelTypeMerge) (*FuelType, error)
+}
+
+// UnimplementedFuelRepositoryServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFuelRepositoryServer struct{}
+
+func (UnimplementedFuelRepositoryServer) GetFuels(context.Context, *FuelFilter) (*FuelCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFuels not implemented")
+}
+func (UnimplementedFuelRepositoryServer) FindFuel(context.Context, *IdRequest) (*Fuel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFuel not implemented")
+}
+func (UnimplementedFuelRepositoryServer) GetFillingStations(context.Context, *emptypb.Empty) (*FillingStationCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFillingStations not implemented")
+}
+func (UnimplementedFuelRepositoryServer) GetFuelTypes(context.Context, *emptypb.Empty) (*FuelTypeCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFuelTypes not implemented")
+}
+func (UnimplementedFuelRepositoryServer) SaveFuel(context.Context, *Fuel) (*Fuel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveFuel not implemented")
+}
+func (UnimplementedFuelRepositoryServer) BatchSaveFuels(context.Context, *FuelBatch) (*FuelBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSaveFuels not implemented")
+}
+func (UnimplementedFuelRepositoryServer) GetFuelPrices(context.Context, *FuelPriceFilter) (*FuelPriceCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFuelPrices not implemented")
+}
+func (UnimplementedFuelRepositoryServer) CreateFuelType(context.Context, *FuelType) (*FuelType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFuelType not implemented")
+}
+func (UnimplementedFuelRepositoryServer) RenameFuelType(context.Context, *FuelType) (*FuelType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFuelType not implemented")
+}
+func (UnimplementedFuelRepositoryServer) MoveFuelType(context.Context, *FuelType) (*FuelType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFuelType not implemented")
+}
+func (UnimplementedFuelRepositoryServer) MergeFuelTypes(context.Context, *FuelTypeMerge) (*FuelType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeFuelTypes not implemented")
+}
+func (UnimplementedFuelRepositoryServer) testEmbeddedByValue() {}
+
+// UnsafeFuelRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FuelRepositoryServer will
+// result in compilation errors.
+type UnsafeFuelRepositoryServer interface {
+	mustEmbedUnimplementedFuelRepositoryServer()
+}
+
+func RegisterFuelRepositoryServer(s grpc.ServiceRegistrar, srv FuelRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedFuelRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FuelRepository_ServiceDesc, srv)
+}
+
+func _FuelRepository_GetFuels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuelFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).GetFuels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_GetFuels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).GetFuels(ctx, req.(*FuelFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_FindFuel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).FindFuel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_FindFuel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).FindFuel(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_GetFillingStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).GetFillingStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_GetFillingStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).GetFillingStations(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_GetFuelTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).GetFuelTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_GetFuelTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).GetFuelTypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_SaveFuel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Fuel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).SaveFuel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_SaveFuel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).SaveFuel(ctx, req.(*Fuel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_BatchSaveFuels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuelBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).BatchSaveFuels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_BatchSaveFuels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).BatchSaveFuels(ctx, req.(*FuelBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_GetFuelPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuelPriceFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).GetFuelPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_GetFuelPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).GetFuelPrices(ctx, req.(*FuelPriceFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_CreateFuelType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuelType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).CreateFuelType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_CreateFuelType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).CreateFuelType(ctx, req.(*FuelType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_RenameFuelType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuelType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).RenameFuelType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_RenameFuelType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).RenameFuelType(ctx, req.(*FuelType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_MoveFuelType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuelType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).MoveFuelType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_MoveFuelType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).MoveFuelType(ctx, req.(*FuelType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelRepository_MergeFuelTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuelTypeMerge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelRepositoryServer).MergeFuelTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelRepository_MergeFuelTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelRepositoryServer).MergeFuelTypes(ctx, req.(*FuelTypeMerge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FuelRepository_ServiceDesc is the grpc.ServiceDesc for FuelRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FuelRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xelbot.com.autonotes.server.FuelRepository",
+	HandlerType: (*FuelRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFuels",
+			Handler:    _FuelRepository_GetFuels_Handler,
+		},
+		{
+			MethodName: "FindFuel",
+			Handler:    _FuelRepository_FindFuel_Handler,
+		},
+		{
+			MethodName: "GetFillingStations",
+			Handler:    _FuelRepository_GetFillingStations_Handler,
+		},
+		{
+			MethodName: "GetFuelTypes",
+			Handler:    _FuelRepository_GetFuelTypes_Handler,
+		},
+		{
+			MethodName: "SaveFuel",
+			Handler:    _FuelRepository_SaveFuel_Handler,
+		},
+		{
+			MethodName: "BatchSaveFuels",
+			Handler:    _FuelRepository_BatchSaveFuels_Handler,
+		},
+		{
+			MethodName: "GetFuelPrices",
+			Handler:    _FuelRepository_GetFuelPrices_Handler,
+		},
+		{
+			MethodName: "CreateFuelType",
+			Handler:    _FuelRepository_CreateFuelType_Handler,
+		},
+		{
+			MethodName: "RenameFuelType",
+			Handler:    _FuelRepository_RenameFuelType_Handler,
+		},
+		{
+			MethodName: "MoveFuelType",
+			Handler:    _FuelRepository_MoveFuelType_Handler,
+		},
+		{
+			MethodName: "MergeFuelTypes",
+			Handler:    _FuelRepository_MergeFuelTypes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server.proto",
+}
+
+const (
+	OrderRepository_GetOrders_FullMethodName              = "/xelbot.com.autonotes.server.OrderRepository/GetOrders"
+	OrderRepository_FindOrder_FullMethodName              = "/xelbot.com.autonotes.server.OrderRepository/FindOrder"
+	OrderRepository_GetOrderTypes_FullMethodName          = "/xelbot.com.autonotes.server.OrderRepository/GetOrderTypes"
+	OrderRepository_SaveOrder_FullMethodName              = "/xelbot.com.autonotes.server.OrderRepository/SaveOrder"
+	OrderRepository_BatchSaveOrders_FullMethodName        = "/xelbot.com.autonotes.server.OrderRepository/BatchSaveOrders"
+	OrderRepository_GetOrderInventory_FullMethodName      = "/xelbot.com.autonotes.server.OrderRepository/GetOrderInventory"
+	OrderRepository_MarkOrderUsed_FullMethodName          = "/xelbot.com.autonotes.server.OrderRepository/MarkOrderUsed"
+	OrderRepository_GetExpenses_FullMethodName            = "/xelbot.com.autonotes.server.OrderRepository/GetExpenses"
+	OrderRepository_FindExpense_FullMethodName            = "/xelbot.com.autonotes.server.OrderRepository/FindExpense"
+	OrderRepository_SaveExpense_FullMethodName            = "/xelbot.com.autonotes.server.OrderRepository/SaveExpense"
+	OrderRepository_BatchSaveExpenses_FullMethodName      = "/xelbot.com.autonotes.server.OrderRepository/BatchSaveExpenses"
+	OrderRepository_GetExpenseCategories_FullMethodName   = "/xelbot.com.autonotes.server.OrderRepository/GetExpenseCategories"
+	OrderRepository_SaveExpenseCategory_FullMethodName    = "/xelbot.com.autonotes.server.OrderRepository/SaveExpenseCategory"
+	OrderRepository_GetRecurringExpenses_FullMethodName   = "/xelbot.com.autonotes.server.OrderRepository/GetRecurringExpenses"
+	OrderRepository_SaveRecurringExpense_FullMethodName   = "/xelbot.com.autonotes.server.OrderRepository/SaveRecurringExpense"
+	OrderRepository_DeleteRecurringExpense_FullMethodName = "/xelbot.com.autonotes.server.OrderRepository/DeleteRecurringExpense"
+	OrderRepository_GetUpcomingExpenses_FullMethodName    = "/xelbot.com.autonotes.server.OrderRepository/GetUpcomingExpenses"
+)
+
+// OrderRepositoryClient is the client API for OrderRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrderRepositoryClient interface {
+	GetOrders(ctx context.Context, in *OrderFilter, opts ...grpc.CallOption) (*OrderCollection, error)
+	FindOrder(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Order, error)
+	GetOrderTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OrderTypeCollection, error)
+	SaveOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*Order, error)
+	BatchSaveOrders(ctx context.Context, in *OrderBatch, opts ...grpc.CallOption) (*OrderBatchResult, error)
+	GetOrderInventory(ctx context.Context, in *OrderInventoryFilter, opts ...grpc.CallOption) (*OrderInventory, error)
+	MarkOrderUsed(ctx context.Context, in *OrderUsage, opts ...grpc.CallOption) (*Order, error)
+	GetExpenses(ctx context.Context, in *ExpenseFilter, opts ...grpc.CallOption) (*ExpenseCollection, error)
+	FindExpense(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Expense, error)
+	SaveExpense(ctx context.Context, in *Expense, opts ...grpc.CallOption) (*Expense, error)
+	BatchSaveExpenses(ctx context.Context, in *ExpenseBatch, opts ...grpc.CallOption) (*ExpenseBatchResult, error)
+	// built-in categories and the categories of the user
+	GetExpenseCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExpenseCategoryCollection, error)
+	SaveExpenseCategory(ctx context.Context, in *ExpenseCategory, opts ...grpc.CallOption) (*ExpenseCategory, error)
+	GetRecurringExpenses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RecurringExpenseCollection, error)
+	SaveRecurringExpense(ctx context.Context, in *RecurringExpense, opts ...grpc.CallOption) (*RecurringExpense, error)
+	// created expenses are kept
+	DeleteRecurringExpense(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUpcomingExpenses(ctx context.Context, in *UpcomingExpenseFilter, opts ...grpc.CallOption) (*UpcomingExpenseCollection, error)
+}
+
+type orderRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderRepositoryClient(cc grpc.ClientConnInterface) OrderRepositoryClient {
+	return &orderRepositoryClient{cc}
+}
+
+func (c *orderRepositoryClient) GetOrders(ctx context.Context, in *OrderFilter, opts ...grpc.CallOption) (*OrderCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderCollection)
+	err := c.cc.Invoke(ctx, OrderRepository_GetOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) FindOrder(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, OrderRepository_FindOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) GetOrderTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OrderTypeCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderTypeCollection)
+	err := c.cc.Invoke(ctx, OrderRepository_GetOrderTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) SaveOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, OrderRepository_SaveOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) BatchSaveOrders(ctx context.Context, in *OrderBatch, opts ...grpc.CallOption) (*OrderBatchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderBatchResult)
+	err := c.cc.Invoke(ctx, OrderRepository_BatchSaveOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) GetOrderInventory(ctx context.Context, in *OrderInventoryFilter, opts ...grpc.CallOption) (*OrderInventory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderInventory)
+	err := c.cc.Invoke(ctx, OrderRepository_GetOrderInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) MarkOrderUsed(ctx context.Context, in *OrderUsage, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, OrderRepository_MarkOrderUsed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) GetExpenses(ctx context.Context, in *ExpenseFilter, opts ...grpc.CallOption) (*ExpenseCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseCollection)
+	err := c.cc.Invoke(ctx, OrderRepository_GetExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) FindExpense(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Expense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Expense)
+	err := c.cc.Invoke(ctx, OrderRepository_FindExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) SaveExpense(ctx context.Context, in *Expense, opts ...grpc.CallOption) (*Expense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Expense)
+	err := c.cc.Invoke(ctx, OrderRepository_SaveExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) BatchSaveExpenses(ctx context.Context, in *ExpenseBatch, opts ...grpc.CallOption) (*ExpenseBatchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseBatchResult)
+	err := c.cc.Invoke(ctx, OrderRepository_BatchSaveExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) GetExpenseCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExpenseCategoryCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseCategoryCollection)
+	err := c.cc.Invoke(ctx, OrderRepository_GetExpenseCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) SaveExpenseCategory(ctx context.Context, in *ExpenseCategory, opts ...grpc.CallOption) (*ExpenseCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseCategory)
+	err := c.cc.Invoke(ctx, OrderRepository_SaveExpenseCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) GetRecurringExpenses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RecurringExpenseCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringExpenseCollection)
+	err := c.cc.Invoke(ctx, OrderRepository_GetRecurringExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) SaveRecurringExpense(ctx context.Context, in *RecurringExpense, opts ...grpc.CallOption) (*RecurringExpense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringExpense)
+	err := c.cc.Invoke(ctx, OrderRepository_SaveRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) DeleteRecurringExpense(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderRepository_DeleteRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRepositoryClient) GetUpcomingExpenses(ctx context.Context, in *UpcomingExpenseFilter, opts ...grpc.CallOption) (*UpcomingExpenseCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpcomingExpenseCollection)
+	err := c.cc.Invoke(ctx, OrderRepository_GetUpcomingExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderRepositoryServer is the server API for OrderRepository service.
+// All implementations should embed UnimplementedOrderRepositoryServer
+// for forward compatibility.
+type OrderRepositoryServer interface {
+	GetOrders(context.Context, *OrderFilter) (*OrderCollection, error)
+	FindOrder(context.Context, *IdRequest) (*Order, error)
+	GetOrderTypes(context.Context, *emptypb.Empty) (*OrderTypeCollection, error)
+	SaveOrder(context.Context, *Order) (*Order, error)
+	BatchSaveOrders(context.Context, *OrderBatch) (*OrderBatchResult, error)
+	GetOrderInventory(context.Context, *OrderInventoryFilter) (*OrderInventory, error)
+	MarkOrderUsed(context.Context, *OrderUsage) (*Order, error)
+	GetExpenses(context.Context, *ExpenseFilter) (*ExpenseCollection, error)
+	FindExpense(context.Context, *IdRequest) (*Expense, error)
+	SaveExpense(context.Context, *Expense) (*Expense, error)
+	BatchSaveExpenses(context.Context, *ExpenseBatch) (*ExpenseBatchResult, error)
+	// built-in categories and the categories of the user
+	GetExpenseCategories(context.Context, *emptypb.Empty) (*ExpenseCategoryCollection, error)
+	SaveExpenseCategory(context.Context, *ExpenseCategory) (*ExpenseCategory, error)
+	GetRecurringExpenses(context.Context, *emptypb.Empty) (*RecurringExpenseCollection, error)
+	SaveRecurringExpense(context.Context, *RecurringExpense) (*RecurringExpense, error)
+	// created expenses are kept
+	DeleteRecurringExpense(context.Context, *IdRequest) (*emptypb.Empty, error)
+	GetUpcomingExpenses(context.Context, *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error)
+}
+
+// UnimplementedOrderRepositoryServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrderRepositoryServer struct{}
+
+func (UnimplementedOrderRepositoryServer) GetOrders(context.Context, *OrderFilter) (*OrderCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+}
+func (UnimplementedOrderRepositoryServer) FindOrder(context.Context, *IdRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOrder not implemented")
+}
+func (UnimplementedOrderRepositoryServer) GetOrderTypes(context.Context, *emptypb.Empty) (*OrderTypeCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderTypes not implemented")
+}
+func (UnimplementedOrderRepositoryServer) SaveOrder(context.Context, *Order) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveOrder not implemented")
+}
+func (UnimplementedOrderRepositoryServer) BatchSaveOrders(context.Context, *OrderBatch) (*OrderBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSaveOrders not implemented")
+}
+func (UnimplementedOrderRepositoryServer) GetOrderInventory(context.Context, *OrderInventoryFilter) (*OrderInventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderInventory not implemented")
+}
+func (UnimplementedOrderRepositoryServer) MarkOrderUsed(context.Context, *OrderUsage) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkOrderUsed not implemented")
+}
+func (UnimplementedOrderRepositoryServer) GetExpenses(context.Context, *ExpenseFilter) (*ExpenseCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpenses not implemented")
+}
+func (UnimplementedOrderRepositoryServer) FindExpense(context.Context, *IdRequest) (*Expense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExpense not implemented")
+}
+func (UnimplementedOrderRepositoryServer) SaveExpense(context.Context, *Expense) (*Expense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveExpense not implemented")
+}
+func (UnimplementedOrderRepositoryServer) BatchSaveExpenses(context.Context, *ExpenseBatch) (*ExpenseBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSaveExpenses not implemented")
+}
+func (UnimplementedOrderRepositoryServer) GetExpenseCategories(context.Context, *emptypb.Empty) (*ExpenseCategoryCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpenseCategories not implemented")
+}
+func (UnimplementedOrderRepositoryServer) SaveExpenseCategory(context.Context, *ExpenseCategory) (*ExpenseCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveExpenseCategory not implemented")
+}
+func (UnimplementedOrderRepositoryServer) GetRecurringExpenses(context.Context, *emptypb.Empty) (*RecurringExpenseCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringExpenses not implemented")
+}
+func (UnimplementedOrderRepositoryServer) SaveRecurringExpense(context.Context, *RecurringExpense) (*RecurringExpense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRecurringExpense not implemented")
+}
+func (UnimplementedOrderRepositoryServer) DeleteRecurringExpense(context.Context, *IdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringExpense not implemented")
+}
+func (UnimplementedOrderRepositoryServer) GetUpcomingExpenses(context.Context, *UpcomingExpenseFilter) (*UpcomingExpenseCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingExpenses not implemented")
+}
+func (UnimplementedOrderRepositoryServer) testEmbeddedByValue() {}
+
+// UnsafeOrderRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderRepositoryServer will
+// result in compilation errors.
+type UnsafeOrderRepositoryServer interface {
+	mustEmbedUnimplementedOrderRepositoryServer()
+}
+
+func RegisterOrderRepositoryServer(s grpc.ServiceRegistrar, srv OrderRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedOrderRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrderRepository_ServiceDesc, srv)
+}
+
+func _OrderRepository_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_GetOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).GetOrders(ctx, req.(*OrderFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_FindOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).FindOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_FindOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).FindOrder(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_GetOrderTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).GetOrderTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_GetOrderTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).GetOrderTypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_SaveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Order)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).SaveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_SaveOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).SaveOrder(ctx, req.(*Order))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_BatchSaveOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).BatchSaveOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_BatchSaveOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).BatchSaveOrders(ctx, req.(*OrderBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_GetOrderInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderInventoryFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).GetOrderInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_GetOrderInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).GetOrderInventory(ctx, req.(*OrderInventoryFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_MarkOrderUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).MarkOrderUsed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_MarkOrderUsed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).MarkOrderUsed(ctx, req.(*OrderUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_GetExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).GetExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_GetExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).GetExpenses(ctx, req.(*ExpenseFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_FindExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).FindExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_FindExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).FindExpense(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_SaveExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Expense)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).SaveExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_SaveExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).SaveExpense(ctx, req.(*Expense))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_BatchSaveExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).BatchSaveExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_BatchSaveExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).BatchSaveExpenses(ctx, req.(*ExpenseBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_GetExpenseCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).GetExpenseCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_GetExpenseCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).GetExpenseCategories(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_SaveExpenseCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).SaveExpenseCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_SaveExpenseCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).SaveExpenseCategory(ctx, req.(*ExpenseCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_GetRecurringExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).GetRecurringExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_GetRecurringExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).GetRecurringExpenses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_SaveRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringExpense)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).SaveRecurringExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_SaveRecurringExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).SaveRecurringExpense(ctx, req.(*RecurringExpense))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_DeleteRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).DeleteRecurringExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_DeleteRecurringExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).DeleteRecurringExpense(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRepository_GetUpcomingExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpcomingExpenseFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRepositoryServer).GetUpcomingExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRepository_GetUpcomingExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRepositoryServer).GetUpcomingExpenses(ctx, req.(*UpcomingExpenseFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderRepository_ServiceDesc is the grpc.ServiceDesc for OrderRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xelbot.com.autonotes.server.OrderRepository",
+	HandlerType: (*OrderRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOrders",
+			Handler:    _OrderRepository_GetOrders_Handler,
+		},
+		{
+			MethodName: "FindOrder",
+			Handler:    _OrderRepository_FindOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderTypes",
+			Handler:    _OrderRepository_GetOrderTypes_Handler,
+		},
+		{
+			MethodName: "SaveOrder",
+			Handler:    _OrderRepository_SaveOrder_Handler,
+		},
+		{
+			MethodName: "BatchSaveOrders",
+			Handler:    _OrderRepository_BatchSaveOrders_Handler,
+		},
+		{
+			MethodName: "GetOrderInventory",
+			Handler:    _OrderRepository_GetOrderInventory_Handler,
+		},
+		{
+			MethodName: "MarkOrderUsed",
+			Handler:    _OrderRepository_MarkOrderUsed_Handler,
+		},
+		{
+			MethodName: "GetExpenses",
+			Handler:    _OrderRepository_GetExpenses_Handler,
+		},
+		{
+			MethodName: "FindExpense",
+			Handler:    _OrderRepository_FindExpense_Handler,
+		},
+		{
+			MethodName: "SaveExpense",
+			Handler:    _OrderRepository_SaveExpense_Handler,
+		},
+		{
+			MethodName: "BatchSaveExpenses",
+			Handler:    _OrderRepository_BatchSaveExpenses_Handler,
+		},
+		{
+			MethodName: "GetExpenseCategories",
+			Handler:    _OrderRepository_GetExpenseCategories_Handler,
+		},
+		{
+			MethodName: "SaveExpenseCategory",
+			Handler:    _OrderRepository_SaveExpenseCategory_Handler,
+		},
+		{
+			MethodName: "GetRecurringExpenses",
+			Handler:    _OrderRepository_GetRecurringExpenses_Handler,
+		},
+		{
+			MethodName: "SaveRecurringExpense",
+			Handler:    _OrderRepository_SaveRecurringExpense_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringExpense",
+			Handler:    _OrderRepository_DeleteRecurringExpense_Handler,
+		},
+		{
+			MethodName: "GetUpcomingExpenses",
+			Handler:    _OrderRepository_GetUpcomingExpenses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server.proto",
+}
+
+const (
+	CarRepository_GetServices_FullMethodName         = "/xelbot.com.autonotes.server.CarRepository/GetServices"
+	CarRepository_FindService_FullMethodName         = "/xelbot.com.autonotes.server.CarRepository/FindService"
+	CarRepository_SaveService_FullMethodName         = "/xelbot.com.autonotes.server.CarRepository/SaveService"
+	CarRepository_GetMileages_FullMethodName         = "/xelbot.com.autonotes.server.CarRepository/GetMileages"
+	CarRepository_SaveMileage_FullMethodName         = "/xelbot.com.autonotes.server.CarRepository/SaveMileage"
+	CarRepository_GetTrips_FullMethodName            = "/xelbot.com.autonotes.server.CarRepository/GetTrips"
+	CarRepository_StartTrip_FullMethodName           = "/xelbot.com.autonotes.server.CarRepository/StartTrip"
+	CarRepository_CloseTrip_FullMethodName           = "/xelbot.com.autonotes.server.CarRepository/CloseTrip"
+	CarRepository_DeleteTrip_FullMethodName          = "/xelbot.com.autonotes.server.CarRepository/DeleteTrip"
+	CarRepository_GetTripReport_FullMethodName       = "/xelbot.com.autonotes.server.CarRepository/GetTripReport"
+	CarRepository_EstimateOdometer_FullMethodName    = "/xelbot.com.autonotes.server.CarRepository/EstimateOdometer"
+	CarRepository_GetMileageAnomalies_FullMethodName = "/xelbot.com.autonotes.server.CarRepository/GetMileageAnomalies"
+	CarRepository_FixMileage_FullMethodName          = "/xelbot.com.autonotes.server.CarRepository/FixMileage"
+)
+
+// CarRepositoryClient is the client API for CarRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CarRepositoryClient interface {
+	GetServices(ctx context.Context, in *ServiceFilter, opts ...grpc.CallOption) (*ServiceCollection, error)
+	FindService(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Service, error)
+	SaveService(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Service, error)
+	GetMileages(ctx context.Context, in *MileageFilter, opts ...grpc.CallOption) (*MileageCollection, error)
+	SaveMileage(ctx context.Context, in *Mileage, opts ...grpc.CallOption) (*Mileage, error)
+	GetTrips(ctx context.Context, in *TripFilter, opts ...grpc.CallOption) (*TripCollection, error)
+	StartTrip(ctx context.Context, in *TripStart, opts ...grpc.CallOption) (*Trip, error)
+	CloseTrip(ctx context.Context, in *TripClose, opts ...grpc.CallOption) (*Trip, error)
+	// removes the trip, the mileages are kept
+	DeleteTrip(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// fuels, expenses and services of the car within the mileage or the dates of the trip
+	GetTripReport(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*TripReport, error)
+	EstimateOdometer(ctx context.Context, in *OdometerRequest, opts ...grpc.CallOption) (*OdometerEstimate, error)
+	// scans the mileages of the car by its id
+	GetMileageAnomalies(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*MileageAnomalyCollection, error)
+	// applies the fix and returns the remaining anomalies of the car
+	FixMileage(ctx context.Context, in *MileageFix, opts ...grpc.CallOption) (*MileageAnomalyCollection, error)
+}
+
+type carRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCarRepositoryClient(cc grpc.ClientConnInterface) CarRepositoryClient {
+	return &carRepositoryClient{cc}
+}
+
+func (c *carRepositoryClient) GetServices(ctx context.Context, in *ServiceFilter, opts ...grpc.CallOption) (*ServiceCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceCollection)
+	err := c.cc.Invoke(ctx, CarRepository_GetServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) FindService(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Service, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Service)
+	err := c.cc.Invoke(ctx, CarRepository_FindService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) SaveService(ctx context.Context, in *Service, opts ...grpc.CallOption) (*Service, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Service)
+	err := c.cc.Invoke(ctx, CarRepository_SaveService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) GetMileages(ctx context.Context, in *MileageFilter, opts ...grpc.CallOption) (*MileageCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MileageCollection)
+	err := c.cc.Invoke(ctx, CarRepository_GetMileages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) SaveMileage(ctx context.Context, in *Mileage, opts ...grpc.CallOption) (*Mileage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mileage)
+	err := c.cc.Invoke(ctx, CarRepository_SaveMileage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) GetTrips(ctx context.Context, in *TripFilter, opts ...grpc.CallOption) (*TripCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TripCollection)
+	err := c.cc.Invoke(ctx, CarRepository_GetTrips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) StartTrip(ctx context.Context, in *TripStart, opts ...grpc.CallOption) (*Trip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trip)
+	err := c.cc.Invoke(ctx, CarRepository_StartTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) CloseTrip(ctx context.Context, in *TripClose, opts ...grpc.CallOption) (*Trip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trip)
+	err := c.cc.Invoke(ctx, CarRepository_CloseTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) DeleteTrip(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CarRepository_DeleteTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) GetTripReport(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*TripReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TripReport)
+	err := c.cc.Invoke(ctx, CarRepository_GetTripReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) EstimateOdometer(ctx context.Context, in *OdometerRequest, opts ...grpc.CallOption) (*OdometerEstimate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OdometerEstimate)
+	err := c.cc.Invoke(ctx, CarRepository_EstimateOdometer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) GetMileageAnomalies(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*MileageAnomalyCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MileageAnomalyCollection)
+	err := c.cc.Invoke(ctx, CarRepository_GetMileageAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carRepositoryClient) FixMileage(ctx context.Context, in *MileageFix, opts ...grpc.CallOption) (*MileageAnomalyCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MileageAnomalyCollection)
+	err := c.cc.Invoke(ctx, CarRepository_FixMileage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CarRepositoryServer is the server API for CarRepository service.
+// All implementations should embed UnimplementedCarRepositoryServer
+// for forward compatibility.
+type CarRepositoryServer interface {
+	GetServices(context.Context, *ServiceFilter) (*ServiceCollection, error)
+	FindService(context.Context, *IdRequest) (*Service, error)
+	SaveService(context.Context, *Service) (*Service, error)
+	GetMileages(context.Context, *MileageFilter) (*MileageCollection, error)
+	SaveMileage(context.Context, *Mileage) (*Mileage, error)
+	GetTrips(context.Context, *TripFilter) (*TripCollection, error)
+	StartTrip(context.Context, *TripStart) (*Trip, error)
+	CloseTrip(context.Context, *TripClose) (*Trip, error)
+	// removes the trip, the mileages are kept
+	DeleteTrip(context.Context, *IdRequest) (*emptypb.Empty, error)
+	// fuels, expenses and services of the car within the mileage or the dates of the trip
+	GetTripReport(context.Context, *IdRequest) (*TripReport, error)
+	EstimateOdometer(context.Context, *OdometerRequest) (*OdometerEstimate, error)
+	// scans the mileages of the car by its id
+	GetMileageAnomalies(context.Context, *IdRequest) (*MileageAnomalyCollection, error)
+	// applies the fix and returns the remaining anomalies of the car
+	FixMileage(context.Context, *MileageFix) (*MileageAnomalyCollection, error)
+}
+
+// UnimplementedCarRepositoryServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCarRepositoryServer struct{}
+
+func (UnimplementedCarRepositoryServer) GetServices(context.Context, *ServiceFilter) (*ServiceCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServices not implemented")
+}
+func (UnimplementedCarRepositoryServer) FindService(context.Context, *IdRequest) (*Service, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindService not implemented")
+}
+func (UnimplementedCarRepositoryServer) SaveService(context.Context, *Service) (*Service, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveService not implemented")
+}
+func (UnimplementedCarRepositoryServer) GetMileages(context.Context, *MileageFilter) (*MileageCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMileages not implemented")
+}
+func (UnimplementedCarRepositoryServer) SaveMileage(context.Context, *Mileage) (*Mileage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveMileage not implemented")
+}
+func (UnimplementedCarRepositoryServer) GetTrips(context.Context, *TripFilter) (*TripCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrips not implemented")
+}
+func (UnimplementedCarRepositoryServer) StartTrip(context.Context, *TripStart) (*Trip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTrip not implemented")
+}
+func (UnimplementedCarRepositoryServer) CloseTrip(context.Context, *TripClose) (*Trip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseTrip not implemented")
+}
+func (UnimplementedCarRepositoryServer) DeleteTrip(context.Context, *IdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrip not implemented")
+}
+func (UnimplementedCarRepositoryServer) GetTripReport(context.Context, *IdRequest) (*TripReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripReport not implemented")
+}
+func (UnimplementedCarRepositoryServer) EstimateOdometer(context.Context, *OdometerRequest) (*OdometerEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateOdometer not implemented")
+}
+func (UnimplementedCarRepositoryServer) GetMileageAnomalies(context.Context, *IdRequest) (*MileageAnomalyCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMileageAnomalies not implemented")
+}
+func (UnimplementedCarRepositoryServer) FixMileage(context.Context, *MileageFix) (*MileageAnomalyCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixMileage not implemented")
+}
+func (UnimplementedCarRepositoryServer) testEmbeddedByValue() {}
+
+// UnsafeCarRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CarRepositoryServer will
+// result in compilation errors.
+type UnsafeCarRepositoryServer interface {
+	mustEmbedUnimplementedCarRepositoryServer()
+}
+
+func RegisterCarRepositoryServer(s grpc.ServiceRegistrar, srv CarRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedCarRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CarRepository_ServiceDesc, srv)
+}
+
+func _CarRepository_GetServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).GetServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_GetServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).GetServices(ctx, req.(*ServiceFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_FindService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).FindService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_FindService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).FindService(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_SaveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Service)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).SaveService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_SaveService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).SaveService(ctx, req.(*Service))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_GetMileages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MileageFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).GetMileages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_GetMileages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).GetMileages(ctx, req.(*MileageFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_SaveMileage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Mileage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).SaveMileage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_SaveMileage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).SaveMileage(ctx, req.(*Mileage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_GetTrips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).GetTrips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_GetTrips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).GetTrips(ctx, req.(*TripFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_StartTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripStart)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).StartTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_StartTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).StartTrip(ctx, req.(*TripStart))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_CloseTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripClose)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).CloseTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_CloseTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).CloseTrip(ctx, req.(*TripClose))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_DeleteTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).DeleteTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_DeleteTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).DeleteTrip(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_GetTripReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).GetTripReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_GetTripReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).GetTripReport(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_EstimateOdometer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OdometerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).EstimateOdometer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_EstimateOdometer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).EstimateOdometer(ctx, req.(*OdometerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_GetMileageAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).GetMileageAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_GetMileageAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).GetMileageAnomalies(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarRepository_FixMileage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MileageFix)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarRepositoryServer).FixMileage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarRepository_FixMileage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarRepositoryServer).FixMileage(ctx, req.(*MileageFix))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CarRepository_ServiceDesc is the grpc.ServiceDesc for CarRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CarRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xelbot.com.autonotes.server.CarRepository",
+	HandlerType: (*CarRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServices",
+			Handler:    _CarRepository_GetServices_Handler,
+		},
+		{
+			MethodName: "FindService",
+			Handler:    _CarRepository_FindService_Handler,
+		},
+		{
+			MethodName: "SaveService",
+			Handler:    _CarRepository_SaveService_Handler,
+		},
+		{
+			MethodName: "GetMileages",
+			Handler:    _CarRepository_GetMileages_Handler,
+		},
+		{
+			MethodName: "SaveMileage",
+			Handler:    _CarRepository_SaveMileage_Handler,
+		},
+		{
+			MethodName: "GetTrips",
+			Handler:    _CarRepository_GetTrips_Handler,
+		},
+		{
+			MethodName: "StartTrip",
+			Handler:    _CarRepository_StartTrip_Handler,
+		},
+		{
+			MethodName: "CloseTrip",
+			Handler:    _CarRepository_CloseTrip_Handler,
+		},
+		{
+			MethodName: "DeleteTrip",
+			Handler:    _CarRepository_DeleteTrip_Handler,
+		},
+		{
+			MethodName: "GetTripReport",
+			Handler:    _CarRepository_GetTripReport_Handler,
+		},
+		{
+			MethodName: "EstimateOdometer",
+			Handler:    _CarRepository_EstimateOdometer_Handler,
+		},
+		{
+			MethodName: "GetMileageAnomalies",
+			Handler:    _CarRepository_GetMileageAnomalies_Handler,
+		},
+		{
+			MethodName: "FixMileage",
+			Handler:    _CarRepository_FixMileage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server.proto",
+}
+
+const (
+	SyncRepository_GetChanges_FullMethodName = "/xelbot.com.autonotes.server.SyncRepository/GetChanges"
+)
+
+// SyncRepositoryClient is the client API for SyncRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SyncRepositoryClient interface {
+	GetChanges(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncPage, error)
+}
+
+type syncRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyncRepositoryClient(cc grpc.ClientConnInterface) SyncRepositoryClient {
+	return &syncRepositoryClient{cc}
+}
+
+func (c *syncRepositoryClient) GetChanges(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncPage)
+	err := c.cc.Invoke(ctx, SyncRepository_GetChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SyncRepositoryServer is the server API for SyncRepository service.
+// All implementations should embed UnimplementedSyncRepositoryServer
+// for forward compatibility.
+type SyncRepositoryServer interface {
+	GetChanges(context.Context, *SyncRequest) (*SyncPage, error)
+}
+
+// UnimplementedSyncRepositoryServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSyncRepositoryServer struct{}
+
+func (UnimplementedSyncRepositoryServer) GetChanges(context.Context, *SyncRequest) (*SyncPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChanges not implemented")
+}
+func (UnimplementedSyncRepositoryServer) testEmbeddedByValue() {}
+
+// UnsafeSyncRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyncRepositoryServer will
+// result in compilation errors.
+type UnsafeSyncRepositoryServer interface {
+	mustEmbedUnimplementedSyncRepositoryServer()
+}
+
+func RegisterSyncRepositoryServer(s grpc.ServiceRegistrar, srv SyncRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedSyncRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SyncRepository_ServiceDesc, srv)
+}
+
+func _SyncRepository_GetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncRepositoryServer).GetChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncRepository_GetChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncRepositoryServer).GetChanges(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SyncRepository_ServiceDesc is the grpc.ServiceDesc for SyncRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SyncRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xelbot.com.autonotes.server.SyncRepository",
+	HandlerType: (*SyncRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChanges",
+			Handler:    _SyncRepository_GetChanges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server.proto",
+}
+
+const (
+	AttachmentRepository_GetAttachments_FullMethodName     = "/xelbot.com.autonotes.server.AttachmentRepository/GetAttachments"
+	AttachmentRepository_UploadAttachment_FullMethodName   = "/xelbot.com.autonotes.server.AttachmentRepository/UploadAttachment"
+	AttachmentRepository_DownloadAttachment_FullMethodName = "/xelbot.com.autonotes.server.AttachmentRepository/DownloadAttachment"
+	AttachmentRepository_DeleteAttachment_FullMethodName   = "/xelbot.com.autonotes.server.AttachmentRepository/DeleteAttachment"
+)
+
+// AttachmentRepositoryClient is the client API for AttachmentRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttachmentRepositoryClient interface {
+	GetAttachments(ctx context.Context, in *AttachmentFilter, opts ...grpc.CallOption) (*AttachmentCollection, error)
+	UploadAttachment(ctx context.Context, in *AttachmentUpload, opts ...grpc.CallOption) (*Attachment, error)
+	DownloadAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*AttachmentContent, error)
+	DeleteAttachment(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type attachmentRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentRepositoryClient(cc grpc.ClientConnInterface) AttachmentRepositoryClient {
+	return &attachmentRepositoryClient{cc}
+}
+
+func (c *attachmentRepositoryClient) GetAttachments(ctx context.Context, in *AttachmentFilter, opts ...grpc.CallOption) (*AttachmentCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentCollection)
+	err := c.cc.Invoke(ctx, AttachmentRepository_GetAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentRepositoryClient) UploadAttachment(ctx context.Context, in *AttachmentUpload, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentRepository_UploadAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentRepositoryClient) DownloadAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*AttachmentContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentContent)
+	err := c.cc.Invoke(ctx, AttachmentRepository_DownloadAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentRepositoryClient) DeleteAttachment(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttachmentRepository_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttachmentRepositoryServer is the server API for AttachmentRepository service.
+// All implementations should embed UnimplementedAttachmentRepositoryServer
+// for forward compatibility.
+type AttachmentRepositoryServer interface {
+	GetAttachments(context.Context, *AttachmentFilter) (*AttachmentCollection, error)
+	UploadAttachment(context.Context, *AttachmentUpload) (*Attachment, error)
+	DownloadAttachment(context.Context, *AttachmentRequest) (*AttachmentContent, error)
+	DeleteAttachment(context.Context, *IdRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedAttachmentRepositoryServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttachmentRepositoryServer struct{}
+
+func (UnimplementedAttachmentRepositoryServer) GetAttachments(context.Context, *AttachmentFilter) (*AttachmentCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachments not implemented")
+}
+func (UnimplementedAttachmentRepositoryServer) UploadAttachment(context.Context, *AttachmentUpload) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedAttachmentRepositoryServer) DownloadAttachment(context.Context, *AttachmentRequest) (*AttachmentContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedAttachmentRepositoryServer) DeleteAttachment(context.Context, *IdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedAttachmentRepositoryServer) testEmbeddedByValue() {}
+
+// UnsafeAttachmentRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentRepositoryServer will
+// result in compilation errors.
+type UnsafeAttachmentRepositoryServer interface {
+	mustEmbedUnimplementedAttachmentRepositoryServer()
+}
+
+func RegisterAttachmentRepositoryServer(s grpc.ServiceRegistrar, srv AttachmentRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedAttachmentRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttachmentRepository_ServiceDesc, srv)
+}
+
+func _AttachmentRepository_GetAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentRepositoryServer).GetAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentRepository_GetAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentRepositoryServer).GetAttachments(ctx, req.(*AttachmentFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentRepository_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentRepositoryServer).UploadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentRepository_UploadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentRepositoryServer).UploadAttachment(ctx, req.(*AttachmentUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentRepository_DownloadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentRepositoryServer).DownloadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentRepository_DownloadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentRepositoryServer).DownloadAttachment(ctx, req.(*AttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentRepository_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentRepositoryServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentRepository_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentRepositoryServer).DeleteAttachment(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttachmentRepository_ServiceDesc is the grpc.ServiceDesc for AttachmentRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttachmentRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xelbot.com.autonotes.server.AttachmentRepository",
+	HandlerType: (*AttachmentRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttachments",
+			Handler:    _AttachmentRepository_GetAttachments_Handler,
+		},
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _AttachmentRepository_UploadAttachment_Handler,
+		},
+		{
+			MethodName: "DownloadAttachment",
+			Handler:    _AttachmentRepository_DownloadAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _AttachmentRepository_DeleteAttachment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server.proto",
+}
+
+const (
+	TagRepository_GetTags_FullMethodName       = "/xelbot.com.autonotes.server.TagRepository/GetTags"
+	TagRepository_SaveTag_FullMethodName       = "/xelbot.com.autonotes.server.TagRepository/SaveTag"
+	TagRepository_DeleteTag_FullMethodName     = "/xelbot.com.autonotes.server.TagRepository/DeleteTag"
+	TagRepository_SetRecordTags_FullMethodName = "/xelbot.com.autonotes.server.TagRepository/SetRecordTags"
+	TagRepository_GetTagSummary_FullMethodName = "/xelbot.com.autonotes.server.TagRepository/GetTagSummary"
+)
+
+// TagRepositoryClient is the client API for TagRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TagRepositoryClient interface {
+	GetTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TagCollection, error)
+	// creates a tag without id, renames it otherwise
+	SaveTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*Tag, error)
+	// detaches the tag from the records, the records are kept
+	DeleteTag(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetRecordTags(ctx context.Context, in *RecordTags, opts ...grpc.CallOption) (*TagCollection, error)
+	GetTagSummary(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*TagSummary, error)
+}
+
+type tagRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagRepositoryClient(cc grpc.ClientConnInterface) TagRepositoryClient {
+	return &tagRepositoryClient{cc}
+}
+
+func (c *tagRepositoryClient) GetTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TagCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagCollection)
+	err := c.cc.Invoke(ctx, TagRepository_GetTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagRepositoryClient) SaveTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, TagRepository_SaveTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagRepositoryClient) DeleteTag(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TagRepository_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagRepositoryClient) SetRecordTags(ctx context.Context, in *RecordTags, opts ...grpc.CallOption) (*TagCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagCollection)
+	err := c.cc.Invoke(ctx, TagRepository_SetRecordTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagRepositoryClient) GetTagSummary(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*TagSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagSummary)
+	err := c.cc.Invoke(ctx, TagRepository_GetTagSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagRepositoryServer is the server API for TagRepository service.
+// All implementations should embed UnimplementedTagRepositoryServer
+// for forward compatibility.
+type TagRepositoryServer interface {
+	GetTags(context.Context, *emptypb.Empty) (*TagCollection, error)
+	// creates a tag without id, renames it otherwise
+	SaveTag(context.Context, *Tag) (*Tag, error)
+	// detaches the tag from the records, the records are kept
+	DeleteTag(context.Context, *IdRequest) (*emptypb.Empty, error)
+	SetRecordTags(context.Context, *RecordTags) (*TagCollection, error)
+	GetTagSummary(context.Context, *IdRequest) (*TagSummary, error)
+}
+
+// UnimplementedTagRepositoryServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagRepositoryServer struct{}
+
+func (UnimplementedTagRepositoryServer) GetTags(context.Context, *emptypb.Empty) (*TagCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedTagRepositoryServer) SaveTag(context.Context, *Tag) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveTag not implemented")
+}
+func (UnimplementedTagRepositoryServer) DeleteTag(context.Context, *IdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedTagRepositoryServer) SetRecordTags(context.Context, *RecordTags) (*TagCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecordTags not implemented")
+}
+func (UnimplementedTagRepositoryServer) GetTagSummary(context.Context, *IdRequest) (*TagSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagSummary not implemented")
+}
+func (UnimplementedTagRepositoryServer) testEmbeddedByValue() {}
+
+// UnsafeTagRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagRepositoryServer will
+// result in compilation errors.
+type UnsafeTagRepositoryServer interface {
+	mustEmbedUnimplementedTagRepositoryServer()
+}
+
+func RegisterTagRepositoryServer(s grpc.ServiceRegistrar, srv TagRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedTagRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagRepository_ServiceDesc, srv)
+}
+
+func _TagRepository_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagRepositoryServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagRepository_GetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagRepositoryServer).GetTags(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagRepository_SaveTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagRepositoryServer).SaveTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagRepository_SaveTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagRepositoryServer).SaveTag(ctx, req.(*Tag))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagRepository_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagRepositoryServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagRepository_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagRepositoryServer).DeleteTag(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagRepository_SetRecordTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTags)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagRepositoryServer).SetRecordTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagRepository_SetRecordTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagRepositoryServer).SetRecordTags(ctx, req.(*RecordTags))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagRepository_GetTagSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagRepositoryServer).GetTagSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagRepository_GetTagSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagRepositoryServer).GetTagSummary(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagRepository_ServiceDesc is the grpc.ServiceDesc for TagRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xelbot.com.autonotes.server.TagRepository",
+	HandlerType: (*TagRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTags",
+			Handler:    _TagRepository_GetTags_Handler,
+		},
+		{
+			MethodName: "SaveTag",
+			Handler:    _TagRepository_SaveTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _TagRepository_DeleteTag_Handler,
+		},
+		{
+			MethodName: "SetRecordTags",
+			Handler:    _TagRepository_SetRecordTags_Handler,
+		},
+		{
+			MethodName: "GetTagSummary",
+			Handler:    _TagRepository_GetTagSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server.proto",
+}
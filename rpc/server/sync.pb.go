@@ -0,0 +1,69 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: sync.proto
+
+package server
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_sync_proto protoreflect.FileDescriptor
+
+const file_sync_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"sync.proto\x12\x1bxelbot.com.autonotes.server\x1a\fserver.proto2g\n" +
+	"\n" +
+	"SyncStream\x12Y\n" +
+	"\x04Sync\x12(.xelbot.com.autonotes.server.SyncRequest\x1a%.xelbot.com.autonotes.server.SyncPage0\x01BTZ'xelbot.com/auto-notes/server/rpc/server\xca\x02\x10AutoNotes\\Server\xe2\x02\x15AutoNotes\\Server\\Metab\x06proto3"
+
+var file_sync_proto_goTypes = []any{
+	(*SyncRequest)(nil), // 0: xelbot.com.autonotes.server.SyncRequest
+	(*SyncPage)(nil),    // 1: xelbot.com.autonotes.server.SyncPage
+}
+var file_sync_proto_depIdxs = []int32{
+	0, // 0: xelbot.com.autonotes.server.SyncStream.Sync:input_type -> xelbot.com.autonotes.server.SyncRequest
+	1, // 1: xelbot.com.autonotes.server.SyncStream.Sync:output_type -> xelbot.com.autonotes.server.SyncPage
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sync_proto_init() }
+func file_sync_proto_init() {
+	if File_sync_proto != nil {
+		return
+	}
+	file_server_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sync_proto_rawDesc), len(file_sync_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sync_proto_goTypes,
+		DependencyIndexes: file_sync_proto_depIdxs,
+	}.Build()
+	File_sync_proto = out.File
+	file_sync_proto_goTypes = nil
+	file_sync_proto_depIdxs = nil
+}
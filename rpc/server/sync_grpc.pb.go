@@ -0,0 +1,128 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: sync.proto
+
+package server
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SyncStream_Sync_FullMethodName = "/xelbot.com.autonotes.server.SyncStream/Sync"
+)
+
+// SyncStreamClient is the client API for SyncStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Streaming services are served over native gRPC only,
+// Twirp clients use SyncRepository.GetChanges
+type SyncStreamClient interface {
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncPage], error)
+}
+
+type syncStreamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyncStreamClient(cc grpc.ClientConnInterface) SyncStreamClient {
+	return &syncStreamClient{cc}
+}
+
+func (c *syncStreamClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncPage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SyncStream_ServiceDesc.Streams[0], SyncStream_Sync_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SyncRequest, SyncPage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SyncStream_SyncClient = grpc.ServerStreamingClient[SyncPage]
+
+// SyncStreamServer is the server API for SyncStream service.
+// All implementations should embed UnimplementedSyncStreamServer
+// for forward compatibility.
+//
+// Streaming services are served over native gRPC only,
+// Twirp clients use SyncRepository.GetChanges
+type SyncStreamServer interface {
+	Sync(*SyncRequest, grpc.ServerStreamingServer[SyncPage]) error
+}
+
+// UnimplementedSyncStreamServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSyncStreamServer struct{}
+
+func (UnimplementedSyncStreamServer) Sync(*SyncRequest, grpc.ServerStreamingServer[SyncPage]) error {
+	return status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (UnimplementedSyncStreamServer) testEmbeddedByValue() {}
+
+// UnsafeSyncStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyncStreamServer will
+// result in compilation errors.
+type UnsafeSyncStreamServer interface {
+	mustEmbedUnimplementedSyncStreamServer()
+}
+
+func RegisterSyncStreamServer(s grpc.ServiceRegistrar, srv SyncStreamServer) {
+	// If the following call pancis, it indicates UnimplementedSyncStreamServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SyncStream_ServiceDesc, srv)
+}
+
+func _SyncStream_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SyncStreamServer).Sync(m, &grpc.GenericServerStream[SyncRequest, SyncPage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SyncStream_SyncServer = grpc.ServerStreamingServer[SyncPage]
+
+// SyncStream_ServiceDesc is the grpc.ServiceDesc for SyncStream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SyncStream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xelbot.com.autonotes.server.SyncStream",
+	HandlerType: (*SyncStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Sync",
+			Handler:       _SyncStream_Sync_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sync.proto",
+}